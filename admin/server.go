@@ -0,0 +1,179 @@
+// Package admin implements the AdminService, the demo server's runtime
+// control plane: it changes the log level and fault injection, flushes the
+// response cache, reloads the greeting templates, starts a drain and
+// reports the configuration, the worker pool's load, the calls handled and
+// the goroutines' stacks.
+// Register it only behind an auth.Authenticator requiring auth.AdminRole.
+package admin
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/auth"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/diagnostics"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	adminpb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/admin"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/stats"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/templates"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/workers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Controls are the parts of a running server the AdminService acts on
+type Controls struct {
+	// Chaos is the fault injection SetChaos changes
+	Chaos *interceptors.ChaosSwitch
+	// Drain starts a graceful shutdown without waiting for it and returns
+	// the number of calls still in flight
+	Drain func() int64
+	// Settings returns the server's configuration by flag name
+	Settings func() map[string]string
+	// Cache is the response cache FlushCache empties; nil when caching
+	// is off
+	Cache *interceptors.ResponseCache
+	// Workers is the pool GetWorkerPool reports on; nil when streamed
+	// greetings are generated without one
+	Workers *workers.Pool
+	// Templates are the greeting templates ReloadTemplates reloads
+	Templates *templates.Engine
+	// Stats are the call counts GetServerStats reports
+	Stats *stats.Registry
+}
+
+// Server implements adminpb.AdminServiceServer
+type Server struct {
+	adminpb.UnimplementedAdminServiceServer
+	controls Controls
+}
+
+// NewServer creates an AdminService acting on controls
+func NewServer(controls Controls) *Server {
+	return &Server{controls: controls}
+}
+
+// SetLogLevel implements the SetLogLevel RPC method
+func (s *Server) SetLogLevel(ctx context.Context, req *adminpb.SetLogLevelRequest) (*adminpb.SetLogLevelResponse, error) {
+	previous := levelName(logging.Level())
+	if err := logging.SetLevel(req.GetLevel()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	slog.WarnContext(ctx, "Log level changed", "from", previous, "to", req.GetLevel(), "by", caller(ctx))
+	return &adminpb.SetLogLevelResponse{PreviousLevel: previous}, nil
+}
+
+// SetChaos implements the SetChaos RPC method
+func (s *Server) SetChaos(ctx context.Context, req *adminpb.SetChaosRequest) (*adminpb.SetChaosResponse, error) {
+	if req.GetSpec() == "" {
+		s.controls.Chaos.Set(nil)
+		slog.WarnContext(ctx, "🐒 Chaos mode off", "by", caller(ctx))
+		return &adminpb.SetChaosResponse{}, nil
+	}
+
+	chaos, err := interceptors.ParseChaos(req.GetSpec())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.controls.Chaos.Set(&chaos)
+	slog.WarnContext(ctx, "🐒 Chaos mode", "faults", chaos.String(), "by", caller(ctx))
+	return &adminpb.SetChaosResponse{Chaos: chaos.String()}, nil
+}
+
+// Drain implements the Drain RPC method
+func (s *Server) Drain(ctx context.Context, req *adminpb.DrainRequest) (*adminpb.DrainResponse, error) {
+	slog.WarnContext(ctx, "Drain requested", "by", caller(ctx))
+	return &adminpb.DrainResponse{ActiveStreams: s.controls.Drain()}, nil
+}
+
+// GetConfig implements the GetConfig RPC method. The log level and chaos
+// settings reflect changes made through this service.
+func (s *Server) GetConfig(ctx context.Context, req *adminpb.GetConfigRequest) (*adminpb.GetConfigResponse, error) {
+	settings := s.controls.Settings()
+	settings["log-level"] = levelName(logging.Level())
+	settings["chaos"] = ""
+	if chaos := s.controls.Chaos.Current(); chaos != nil {
+		settings["chaos"] = chaos.String()
+	}
+	return &adminpb.GetConfigResponse{Settings: settings}, nil
+}
+
+// FlushCache implements the FlushCache RPC method
+func (s *Server) FlushCache(ctx context.Context, req *adminpb.FlushCacheRequest) (*adminpb.FlushCacheResponse, error) {
+	if s.controls.Cache == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the response cache is off; start the server with -cache-size")
+	}
+	flushed := s.controls.Cache.Flush()
+	slog.WarnContext(ctx, "🗑️ Response cache flushed", "responses", flushed, "by", caller(ctx))
+	return &adminpb.FlushCacheResponse{Flushed: int64(flushed)}, nil
+}
+
+// GetWorkerPool implements the GetWorkerPool RPC method
+func (s *Server) GetWorkerPool(ctx context.Context, req *adminpb.GetWorkerPoolRequest) (*adminpb.GetWorkerPoolResponse, error) {
+	if s.controls.Workers == nil {
+		return nil, status.Error(codes.FailedPrecondition, "there is no worker pool; start the server with -workers")
+	}
+	stats := s.controls.Workers.Stats()
+	return &adminpb.GetWorkerPoolResponse{
+		Workers:       int32(stats.Workers),
+		Busy:          int32(stats.Busy),
+		Queued:        int32(stats.Queued),
+		QueueCapacity: int32(stats.QueueCapacity),
+		Completed:     stats.Completed,
+		Rejected:      stats.Rejected,
+	}, nil
+}
+
+// ReloadTemplates implements the ReloadTemplates RPC method
+func (s *Server) ReloadTemplates(ctx context.Context, req *adminpb.ReloadTemplatesRequest) (*adminpb.ReloadTemplatesResponse, error) {
+	if s.controls.Templates == nil || s.controls.Templates.Dir() == "" {
+		return nil, status.Error(codes.FailedPrecondition, "the templates are built in; start the server with -templates-dir")
+	}
+	loaded, err := s.controls.Templates.Reload()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "keeping the templates in use: %v", err)
+	}
+	slog.WarnContext(ctx, "🎨 Templates reloaded", "dir", s.controls.Templates.Dir(), "templates", loaded, "by", caller(ctx))
+	return &adminpb.ReloadTemplatesResponse{Dir: s.controls.Templates.Dir(), Templates: loaded}, nil
+}
+
+// levelName spells level the way -log-level takes it, e.g. "info"
+// GetServerStats implements the GetServerStats RPC method
+func (s *Server) GetServerStats(ctx context.Context, req *adminpb.GetServerStatsRequest) (*adminpb.GetServerStatsResponse, error) {
+	if s.controls.Stats == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the server keeps no call statistics")
+	}
+	resp := &adminpb.GetServerStatsResponse{Uptime: durationpb.New(s.controls.Stats.Uptime())}
+	for _, m := range s.controls.Stats.Snapshot() {
+		resp.Methods = append(resp.Methods, &adminpb.MethodStats{
+			Method:         m.Method,
+			Calls:          m.Calls,
+			Errors:         m.Errors,
+			AverageLatency: durationpb.New(m.AvgLatency),
+			ActiveStreams:  m.ActiveStreams,
+		})
+	}
+	return resp, nil
+}
+
+// DumpGoroutines implements the DumpGoroutines RPC method
+func (s *Server) DumpGoroutines(ctx context.Context, req *adminpb.DumpGoroutinesRequest) (*adminpb.DumpGoroutinesResponse, error) {
+	n, dump := diagnostics.Goroutines(req.GetGrouped())
+	slog.InfoContext(ctx, "🧵 Goroutines dumped", "goroutines", n, "by", caller(ctx))
+	return &adminpb.DumpGoroutinesResponse{Goroutines: int32(n), Dump: string(dump)}, nil
+}
+
+func levelName(level slog.Level) string {
+	return strings.ToLower(level.String())
+}
+
+// caller names the authenticated caller for the log
+func caller(ctx context.Context) string {
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return claims.Subject
+	}
+	return "unknown"
+}
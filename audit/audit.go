@@ -0,0 +1,220 @@
+// Package audit keeps an audit trail of every call the server handles: one
+// JSON record per call naming when it was made, the method, who made it,
+// what it asked for and how it ended. Request fields can be removed,
+// masked or hashed before they are written, so the trail can be kept in
+// environments where it must not hold personal data in the clear.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Anonymous is the caller of calls without a valid token
+const Anonymous = "anonymous"
+
+// maxSummaryString is the longest string kept whole in a request summary;
+// longer ones, such as payloads, are cut and their length noted
+const maxSummaryString = 128
+
+// Record is one call in the audit trail
+type Record struct {
+	Time      time.Time `json:"time"`
+	Method    string    `json:"method"`
+	Type      string    `json:"type"`
+	Caller    string    `json:"caller"`
+	Peer      string    `json:"peer"`
+	RequestID string    `json:"request_id,omitempty"`
+	// Request is the request, or a streaming call's first message, with
+	// the redactions applied
+	Request map[string]any `json:"request,omitempty"`
+	// Messages counts the messages a streaming call received
+	Messages   int     `json:"messages,omitempty"`
+	Code       string  `json:"code"`
+	Error      string  `json:"error,omitempty"`
+	DurationMS float64 `json:"duration_ms"`
+}
+
+// Logger writes a Record for every call its interceptors see
+type Logger struct {
+	redactions Redactions
+	identify   func(context.Context) (string, bool)
+
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// Option configures a Logger
+type Option func(*Logger)
+
+// WithRedactions redacts request fields as r says before writing them
+func WithRedactions(r Redactions) Option {
+	return func(l *Logger) {
+		l.redactions = r
+	}
+}
+
+// WithIdentity names callers with identify, e.g. auth.Authenticator's
+// Identify; callers it doesn't know are Anonymous
+func WithIdentity(identify func(context.Context) (string, bool)) Option {
+	return func(l *Logger) {
+		l.identify = identify
+	}
+}
+
+// New creates a Logger writing one JSON record per line to w
+func New(w io.Writer, opts ...Option) *Logger {
+	l := &Logger{enc: json.NewEncoder(w)}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// UnaryServerInterceptor records every unary call. Install it before
+// authentication and panic recovery so refused and failed calls are
+// recorded too, with the status the client gets.
+func (l *Logger) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		record := l.record(ctx, "unary", info.FullMethod, start, err)
+		record.Request = l.summarize(req)
+		l.write(ctx, record)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records every streaming call once it finishes,
+// with its first message as the request
+func (l *Logger) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		stream := &recordingStream{ServerStream: ss}
+		err := handler(srv, stream)
+		record := l.record(ss.Context(), "stream", info.FullMethod, start, err)
+		record.Request = l.summarize(stream.first)
+		record.Messages = stream.received
+		l.write(ss.Context(), record)
+		return err
+	}
+}
+
+func (l *Logger) record(ctx context.Context, kind, method string, start time.Time, err error) Record {
+	st := status.Convert(err)
+	caller := Anonymous
+	if l.identify != nil {
+		if subject, ok := l.identify(ctx); ok {
+			caller = subject
+		}
+	}
+	addr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	return Record{
+		Time:       start.UTC(),
+		Method:     method,
+		Type:       kind,
+		Caller:     caller,
+		Peer:       addr,
+		RequestID:  logging.RequestID(ctx),
+		Code:       st.Code().String(),
+		Error:      st.Message(),
+		DurationMS: float64(time.Since(start).Microseconds()) / 1000,
+	}
+}
+
+// summarize returns req as JSON fields, redacted and with long strings cut
+func (l *Logger) summarize(req any) map[string]any {
+	return Summarize(req, l.redactions)
+}
+
+// Summarize returns msg as JSON fields keyed by proto field name, redacted
+// as r says and with long strings, such as payloads, cut. It returns nil
+// for empty messages and values that aren't proto messages.
+func Summarize(msg any, r Redactions) map[string]any {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil || len(fields) == 0 {
+		return nil
+	}
+	r.apply(fields)
+	shorten(fields)
+	return fields
+}
+
+func (l *Logger) write(ctx context.Context, record Record) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.enc.Encode(record); err != nil {
+		slog.ErrorContext(ctx, "Failed to write the audit record", "method", record.Method, logging.Err(err))
+	}
+}
+
+// shorten cuts every long string in fields, nested ones included
+func shorten(fields map[string]any) {
+	for key, value := range fields {
+		fields[key] = shortenValue(value)
+	}
+}
+
+func shortenValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		if len(v) <= maxSummaryString {
+			return v
+		}
+		cut := maxSummaryString
+		for cut > 0 && !utf8.RuneStart(v[cut]) {
+			cut--
+		}
+		return fmt.Sprintf("%s… (%d bytes)", v[:cut], len(v))
+	case map[string]any:
+		shorten(v)
+	case []any:
+		for i, elem := range v {
+			v[i] = shortenValue(elem)
+		}
+	}
+	return value
+}
+
+// recordingStream keeps the first message a stream receives and counts
+// them all
+type recordingStream struct {
+	grpc.ServerStream
+	first    any
+	received int
+}
+
+func (s *recordingStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		if s.received == 0 {
+			s.first = m
+		}
+		s.received++
+	}
+	return err
+}
@@ -0,0 +1,100 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Action is what a Redaction does to a field
+type Action string
+
+const (
+	// Remove leaves the field out of the record
+	Remove Action = "remove"
+	// Mask replaces the field's value with "***"
+	Mask Action = "mask"
+	// Hash replaces the field's value with a short SHA-256 of it, so
+	// records about the same value can still be matched up
+	Hash Action = "hash"
+)
+
+// maskedValue replaces masked fields
+const maskedValue = "***"
+
+// Redactions map request fields, as dotted paths of proto field names
+// such as "name" or "user.email", to what to do with them. A path applies
+// to the requests of every method having that field.
+type Redactions map[string]Action
+
+// ParseRedactions parses comma separated path=action rules, e.g.
+// "name=mask,user.email=hash,payload=remove". A path without an action is
+// masked.
+func ParseRedactions(spec string) (Redactions, error) {
+	r := make(Redactions)
+	for _, rule := range strings.Split(spec, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		path, action, found := strings.Cut(rule, "=")
+		if !found {
+			action = string(Mask)
+		}
+		switch a := Action(strings.ToLower(strings.TrimSpace(action))); a {
+		case Remove, Mask, Hash:
+			r[strings.TrimSpace(path)] = a
+		default:
+			return nil, fmt.Errorf("audit: redaction %q: unknown action %q (want remove, mask or hash)", rule, action)
+		}
+	}
+	return r, nil
+}
+
+// String formats r as ParseRedactions takes it
+func (r Redactions) String() string {
+	rules := make([]string, 0, len(r))
+	for path, action := range r {
+		rules = append(rules, path+"="+string(action))
+	}
+	sort.Strings(rules)
+	return strings.Join(rules, ",")
+}
+
+// apply redacts fields, a request decoded from JSON, in place
+func (r Redactions) apply(fields map[string]any) {
+	for path, action := range r {
+		redact(fields, strings.Split(path, "."), action)
+	}
+}
+
+func redact(fields map[string]any, path []string, action Action) {
+	value, ok := fields[path[0]]
+	if !ok {
+		return
+	}
+	if len(path) > 1 {
+		switch v := value.(type) {
+		case map[string]any:
+			redact(v, path[1:], action)
+		case []any:
+			for _, elem := range v {
+				if m, ok := elem.(map[string]any); ok {
+					redact(m, path[1:], action)
+				}
+			}
+		}
+		return
+	}
+	switch action {
+	case Remove:
+		delete(fields, path[0])
+	case Mask:
+		fields[path[0]] = maskedValue
+	case Hash:
+		sum := sha256.Sum256(fmt.Append(nil, value))
+		fields[path[0]] = "sha256:" + hex.EncodeToString(sum[:6])
+	}
+}
@@ -0,0 +1,21 @@
+package auth
+
+import "context"
+
+// TokenCredentials attaches a bearer token to every call. It implements
+// credentials.PerRPCCredentials; pass it to grpc.WithPerRPCCredentials.
+type TokenCredentials struct {
+	Token string
+	// RequireTLS refuses to send the token over an insecure connection
+	RequireTLS bool
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials
+func (c TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authorizationHeader: bearerPrefix + c.Token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials
+func (c TokenCredentials) RequireTransportSecurity() bool {
+	return c.RequireTLS
+}
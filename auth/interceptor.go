@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// DefaultExemptions are methods reachable without a token: health checks
+// for probes and reflection for API exploration tools. An entry ending in
+// "/" exempts every method of that service.
+var DefaultExemptions = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// AdminRole is the role a token needs to call the AdminService
+const AdminRole = "admin"
+
+type claimsKey struct{}
+
+// ClaimsFromContext returns the claims of the authenticated caller
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(*Claims)
+	return c, ok
+}
+
+// Authenticator validates bearer tokens on incoming calls
+type Authenticator struct {
+	issuer *Issuer
+	exempt []string
+	// roles maps method patterns to the role a caller needs for them
+	roles map[string]string
+}
+
+// NewAuthenticator verifies tokens with issuer, letting calls to the
+// exempt methods through without one
+func NewAuthenticator(issuer *Issuer, exempt ...string) *Authenticator {
+	return &Authenticator{issuer: issuer, exempt: exempt}
+}
+
+// RequireRole lets only callers whose token grants role call the methods
+// matching pattern: a full method name, or a service name ending in "/"
+// for all of its methods. Others get PermissionDenied. It returns a so
+// calls can be chained.
+func (a *Authenticator) RequireRole(pattern, role string) *Authenticator {
+	if a.roles == nil {
+		a.roles = make(map[string]string)
+	}
+	a.roles[pattern] = role
+	return a
+}
+
+func (a *Authenticator) isExempt(method string) bool {
+	for _, e := range a.exempt {
+		if matches(e, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize checks that claims grant every role method requires
+func (a *Authenticator) authorize(method string, claims *Claims) error {
+	for pattern, role := range a.roles {
+		if matches(pattern, method) && !claims.HasRole(role) {
+			return status.Errorf(codes.PermissionDenied, "%s requires the %q role", method, role)
+		}
+	}
+	return nil
+}
+
+// matches reports whether method is pattern, or belongs to the service
+// pattern names with a trailing "/"
+func matches(pattern, method string) bool {
+	return method == pattern || (strings.HasSuffix(pattern, "/") && strings.HasPrefix(method, pattern))
+}
+
+// authenticate returns ctx carrying the caller's claims, or an
+// Unauthenticated error, or PermissionDenied when the caller lacks a role
+// method requires
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	claims, err := a.verify(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorize(method, claims); err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, claimsKey{}, claims), nil
+}
+
+// verify returns the claims of the call's bearer token, or an
+// Unauthenticated error
+func (a *Authenticator) verify(ctx context.Context) (*Claims, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token, ok := strings.CutPrefix(values[0], bearerPrefix)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization header must be a bearer token")
+	}
+
+	claims, err := a.issuer.Verify(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+	return claims, nil
+}
+
+// Identify returns the subject of the call's bearer token, without
+// enforcing anything, for interceptors running before authentication such
+// as the audit log. ok is false when the call has no valid token.
+func (a *Authenticator) Identify(ctx context.Context) (subject string, ok bool) {
+	claims, err := a.verify(ctx)
+	if err != nil {
+		return "", false
+	}
+	return claims.Subject, true
+}
+
+// UnaryServerInterceptor rejects unary calls without a valid token, or
+// without a role their method requires
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if a.isExempt(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streaming calls without a valid token
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a.isExempt(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream exposes the context carrying the caller's claims
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
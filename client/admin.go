@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	adminpb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/admin"
+	"google.golang.org/grpc"
+)
+
+// adminCommand calls the AdminService; it needs a token with the admin
+// role, e.g. -auth-secret s3cret -auth-roles admin
+type adminCommand struct {
+	logLevel   string
+	chaos      string
+	chaosOff   bool
+	flushCache bool
+	workerPool bool
+	reload     bool
+	stats      bool
+	goroutines bool
+	drain      bool
+}
+
+func (c *adminCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.logLevel, "set-log-level", "", "change the server's log level to debug, info, warn or error")
+	fs.StringVar(&c.chaos, "chaos", "", "inject these faults, in the server's -chaos format")
+	fs.BoolVar(&c.chaosOff, "chaos-off", false, "stop injecting faults")
+	fs.BoolVar(&c.flushCache, "flush-cache", false, "empty the server's response cache")
+	fs.BoolVar(&c.workerPool, "worker-pool", false, "show the load on the server's worker pool")
+	fs.BoolVar(&c.reload, "reload-templates", false, "read the server's greeting templates from its -templates-dir again")
+	fs.BoolVar(&c.stats, "stats", false, "show the calls the server handled, by method")
+	fs.BoolVar(&c.goroutines, "goroutines", false, "dump the server's goroutine stacks, identical ones grouped")
+	fs.BoolVar(&c.drain, "drain", false, "start a graceful shutdown of the server")
+}
+
+// run makes the requested changes, then prints the server's config
+func (c *adminCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+	client := adminpb.NewAdminServiceClient(conn)
+
+	if c.logLevel != "" {
+		resp, err := client.SetLogLevel(ctx, &adminpb.SetLogLevelRequest{Level: c.logLevel})
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Log level %s -> %s\n", resp.GetPreviousLevel(), c.logLevel)
+	}
+	if c.chaos != "" || c.chaosOff {
+		resp, err := client.SetChaos(ctx, &adminpb.SetChaosRequest{Spec: c.chaos})
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		if resp.GetChaos() == "" {
+			fmt.Println("✅ Chaos off")
+		} else {
+			fmt.Printf("🐒 Chaos on: %s\n", resp.GetChaos())
+		}
+	}
+	if c.flushCache {
+		resp, err := client.FlushCache(ctx, &adminpb.FlushCacheRequest{})
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Flushed %d cached response(s)\n", resp.GetFlushed())
+	}
+	if c.reload {
+		resp, err := client.ReloadTemplates(ctx, &adminpb.ReloadTemplatesRequest{})
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		fmt.Printf("🎨 Reloaded the templates in %s; from files: %v\n", resp.GetDir(), resp.GetTemplates())
+	}
+	if c.workerPool {
+		resp, err := client.GetWorkerPool(ctx, &adminpb.GetWorkerPoolRequest{})
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		fmt.Printf("👷 %d of %d worker(s) busy, %d of %d queued; %d completed, %d rejected\n",
+			resp.GetBusy(), resp.GetWorkers(), resp.GetQueued(), resp.GetQueueCapacity(), resp.GetCompleted(), resp.GetRejected())
+		return
+	}
+	if c.stats {
+		resp, err := client.GetServerStats(ctx, &adminpb.GetServerStatsRequest{})
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		fmt.Printf("📊 Up %s\n", resp.GetUptime().AsDuration().Round(time.Second))
+		fmt.Printf("%-52s %8s %8s %12s %8s\n", "METHOD", "CALLS", "ERRORS", "AVG LATENCY", "ACTIVE")
+		for _, m := range resp.GetMethods() {
+			fmt.Printf("%-52s %8d %8d %12s %8d\n", m.GetMethod(), m.GetCalls(), m.GetErrors(),
+				m.GetAverageLatency().AsDuration().Round(time.Microsecond), m.GetActiveStreams())
+		}
+		return
+	}
+	if c.goroutines {
+		resp, err := client.DumpGoroutines(ctx, &adminpb.DumpGoroutinesRequest{Grouped: true})
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		fmt.Print(resp.GetDump())
+		fmt.Printf("🧵 %d goroutine(s)\n", resp.GetGoroutines())
+		return
+	}
+	if c.drain {
+		resp, err := client.Drain(ctx, &adminpb.DrainRequest{})
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Draining; %d call(s) still in flight\n", resp.GetActiveStreams())
+		return
+	}
+
+	resp, err := client.GetConfig(ctx, &adminpb.GetConfigRequest{})
+	if err != nil {
+		printStatusDetails(err)
+		os.Exit(1)
+	}
+	names := make([]string, 0, len(resp.GetSettings()))
+	for name := range resp.GetSettings() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("%-32s %s\n", name, resp.GetSettings()[name])
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/auth"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+)
+
+// authToken returns the bearer token to send, minting a short-lived one
+// from the shared secret when no token was given
+func authToken(cfg *config.Client) string {
+	if cfg.AuthToken != "" || cfg.AuthSecret == "" {
+		return cfg.AuthToken
+	}
+
+	token, err := auth.NewIssuer(cfg.AuthSecret).Issue("demo-client", time.Hour, cfg.AuthRoles...)
+	if err != nil {
+		fatal("Failed to issue token", logging.Err(err))
+	}
+	return token
+}
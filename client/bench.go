@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// benchRPCs are the calls bench can load the server with, each making one
+// complete call
+var benchRPCs = map[string]func(ctx context.Context, conn *grpc.ClientConn, c *benchCommand) error{
+	"hello": func(ctx context.Context, conn *grpc.ClientConn, c *benchCommand) error {
+		_, err := pb.NewGreetingServiceClient(conn).SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Bench"}})
+		return err
+	},
+	"hello-v2": func(ctx context.Context, conn *grpc.ClientConn, c *benchCommand) error {
+		_, err := pbv2.NewGreetingServiceV2Client(conn).SayHello(ctx, &pbv2.SayHelloRequest{Identity: &pbv2.SayHelloRequest_Name{Name: "Bench"}})
+		return err
+	},
+	"languages": func(ctx context.Context, conn *grpc.ClientConn, c *benchCommand) error {
+		_, err := pb.NewGreetingServiceClient(conn).ListSupportedLanguages(ctx, &pb.ListSupportedLanguagesRequest{})
+		return err
+	},
+	// stream reads a whole SayHelloMultiple stream, so the latency is the
+	// time to the last greeting
+	"stream": func(ctx context.Context, conn *grpc.ClientConn, c *benchCommand) error {
+		stream, err := pb.NewGreetingServiceClient(conn).SayHelloMultiple(ctx, &pb.HelloRequest{
+			Identity:   &pb.HelloRequest_Name{Name: "Bench"},
+			Count:      int32(c.streamCount),
+			IntervalMs: 1,
+		})
+		if err != nil {
+			return err
+		}
+		for {
+			if _, err := stream.Recv(); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+		}
+	},
+}
+
+// benchCommand loads the server with concurrent calls for a while and
+// reports throughput and latency percentiles, to compare settings such as
+// -tls, -compress, -codec or -keepalive-time
+type benchCommand struct {
+	rpc         string
+	concurrency int
+	duration    time.Duration
+	streamCount int
+	csvPath     string
+}
+
+func (c *benchCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.rpc, "rpc", "hello", "call to make: hello, hello-v2, languages or stream")
+	fs.IntVar(&c.concurrency, "concurrency", 50, "calls in flight at once")
+	fs.DurationVar(&c.duration, "duration", 10*time.Second, "how long to keep calling")
+	fs.IntVar(&c.streamCount, "stream-count", 10, "greetings asked for per call with -rpc stream")
+	fs.StringVar(&c.csvPath, "csv", "", "append the summary as a CSV row to this file, writing a header when it is new")
+}
+
+// benchResult is what one bench run measured
+type benchResult struct {
+	calls     int
+	codes     map[codes.Code]int
+	elapsed   time.Duration
+	latencies []time.Duration // of successful calls, sorted
+}
+
+func (c *benchCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	call, ok := benchRPCs[c.rpc]
+	if !ok {
+		fatal("Unknown -rpc", "rpc", c.rpc)
+	}
+
+	fmt.Printf("🏋️ Benchmarking %s with %d concurrent calls for %s...\n", c.rpc, c.concurrency, c.duration)
+	result := c.load(ctx, cfg, conn, call)
+	c.print(result)
+
+	if c.csvPath != "" {
+		if err := c.appendCSV(cfg, result); err != nil {
+			fatal("Error writing CSV", "path", c.csvPath, logging.Err(err))
+		}
+		fmt.Printf("💾 Appended the summary to %s\n", c.csvPath)
+	}
+}
+
+// load runs c.concurrency workers making calls back to back until
+// c.duration is up
+func (c *benchCommand) load(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn, call func(context.Context, *grpc.ClientConn, *benchCommand) error) benchResult {
+	ctx, cancel := context.WithTimeout(ctx, c.duration)
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		result = benchResult{codes: make(map[codes.Code]int)}
+		wg     sync.WaitGroup
+	)
+	start := time.Now()
+	for i := 0; i < c.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var latencies []time.Duration
+			codeCounts := make(map[codes.Code]int)
+			for ctx.Err() == nil {
+				callCtx, cancelCall := context.WithTimeout(ctx, cfg.Timeout)
+				callStart := time.Now()
+				err := call(callCtx, conn, c)
+				took := time.Since(callStart)
+				cancelCall()
+
+				// Calls cut short by the end of the run don't count
+				if err != nil && ctx.Err() != nil {
+					break
+				}
+				codeCounts[status.Code(err)]++
+				if err == nil {
+					latencies = append(latencies, took)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			result.latencies = append(result.latencies, latencies...)
+			for code, n := range codeCounts {
+				result.codes[code] += n
+				result.calls += n
+			}
+		}()
+	}
+	wg.Wait()
+	result.elapsed = time.Since(start)
+	slices.Sort(result.latencies)
+	return result
+}
+
+// percentile returns the latency p percent of successful calls stayed
+// within, by the nearest-rank method
+func (r benchResult) percentile(p float64) time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	rank := int(p/100*float64(len(r.latencies))+0.5) - 1
+	return r.latencies[min(max(rank, 0), len(r.latencies)-1)]
+}
+
+// throughput returns the successful calls per second
+func (r benchResult) throughput() float64 {
+	return float64(len(r.latencies)) / r.elapsed.Seconds()
+}
+
+func (r benchResult) errors() int {
+	return r.calls - len(r.latencies)
+}
+
+func (r benchResult) max() time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	return r.latencies[len(r.latencies)-1]
+}
+
+func (c *benchCommand) print(r benchResult) {
+	round := func(d time.Duration) time.Duration { return d.Round(10 * time.Microsecond) }
+	fmt.Printf("\n   %-10s %8s %7s %10s %10s %10s %10s %10s\n", "RPC", "CALLS", "ERRORS", "CALLS/S", "P50", "P95", "P99", "MAX")
+	fmt.Printf("   %-10s %8d %7d %10.1f %10s %10s %10s %10s\n", c.rpc, r.calls, r.errors(), r.throughput(),
+		round(r.percentile(50)), round(r.percentile(95)), round(r.percentile(99)), round(r.max()))
+
+	if r.errors() == 0 {
+		return
+	}
+	seen := make([]codes.Code, 0, len(r.codes))
+	for code := range r.codes {
+		if code != codes.OK {
+			seen = append(seen, code)
+		}
+	}
+	sort.Slice(seen, func(i, j int) bool { return seen[i] < seen[j] })
+	fmt.Println("\n   Errors:")
+	for _, code := range seen {
+		fmt.Printf("   %-18s %d\n", code, r.codes[code])
+	}
+}
+
+// benchCSVHeader names the columns of the CSV summary: the settings being
+// compared, then the measurements, with latencies in microseconds
+var benchCSVHeader = []string{
+	"time", "rpc", "concurrency", "duration", "tls", "compress", "keepalive_time", "codec",
+	"calls", "errors", "calls_per_sec", "p50_us", "p95_us", "p99_us", "max_us",
+}
+
+func (c *benchCommand) appendCSV(cfg *config.Client, r benchResult) error {
+	f, err := os.OpenFile(c.csvPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(f)
+	if info.Size() == 0 {
+		w.Write(benchCSVHeader)
+	}
+	us := func(d time.Duration) string { return strconv.FormatInt(d.Microseconds(), 10) }
+	w.Write([]string{
+		time.Now().Format(time.RFC3339), c.rpc, strconv.Itoa(c.concurrency), c.duration.String(),
+		strconv.FormatBool(cfg.TLS.Enabled), cfg.Compress, cfg.Keepalive.Time.String(), cfg.Codec,
+		strconv.Itoa(r.calls), strconv.Itoa(r.errors()), strconv.FormatFloat(r.throughput(), 'f', 1, 64),
+		us(r.percentile(50)), us(r.percentile(95)), us(r.percentile(99)), us(r.max()),
+	})
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+type cachedGreeting struct {
+	hash string
+	resp *pb.HelloResponse
+}
+
+// greetingCache remembers the last SayHello response per request and
+// revalidates it with the server, like an HTTP conditional GET: the
+// server answers not_modified instead of resending an unchanged greeting.
+type greetingCache struct {
+	client pb.GreetingServiceClient
+
+	mu      sync.Mutex
+	entries map[string]cachedGreeting
+}
+
+func newGreetingCache(client pb.GreetingServiceClient) *greetingCache {
+	return &greetingCache{
+		client:  client,
+		entries: make(map[string]cachedGreeting),
+	}
+}
+
+// SayHello returns the greeting for req and whether it was served from the
+// cache after the server confirmed it was unchanged
+func (c *greetingCache) SayHello(ctx context.Context, req *pb.HelloRequest) (*pb.HelloResponse, bool, error) {
+	key := cacheKey(req)
+
+	c.mu.Lock()
+	entry, cached := c.entries[key]
+	c.mu.Unlock()
+
+	if cached {
+		ctx = metadata.AppendToOutgoingContext(ctx, service.IfNoneMatchHeader, entry.hash)
+	}
+
+	var header metadata.MD
+	resp, err := c.client.SayHello(ctx, req, grpc.Header(&header))
+	if err != nil {
+		return nil, false, err
+	}
+	// The hash leaves the count out, so take the current one from the reply
+	if cached && resp.GetNotModified() {
+		fresh := proto.Clone(entry.resp).(*pb.HelloResponse)
+		fresh.Count = resp.GetCount()
+		return fresh, true, nil
+	}
+
+	if hashes := header.Get(service.GreetingHashHeader); len(hashes) > 0 {
+		c.mu.Lock()
+		c.entries[key] = cachedGreeting{hash: hashes[0], resp: resp}
+		c.mu.Unlock()
+	}
+	return resp, false, nil
+}
+
+func cacheKey(req *pb.HelloRequest) string {
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	return string(b)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGreetingCacheRevalidates(t *testing.T) {
+	// Record the raw replies the cache gets
+	var replies []*pb.HelloResponse
+	record := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if resp, ok := reply.(*pb.HelloResponse); ok && err == nil {
+			replies = append(replies, resp)
+		}
+		return err
+	}
+	ts := greetertest.StartTestServer(t, greetertest.WithDialOptions(grpc.WithUnaryInterceptor(record)))
+	cache := newGreetingCache(ts.Client)
+	req := &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Dave"}}
+
+	first, fromCache, err := cache.SayHello(context.Background(), req)
+	if err != nil {
+		t.Fatalf("first SayHello: %v", err)
+	}
+	if fromCache || first.GetNotModified() {
+		t.Fatalf("first SayHello came from the cache (%t) or was not_modified (%t)", fromCache, first.GetNotModified())
+	}
+
+	second, fromCache, err := cache.SayHello(context.Background(), req)
+	if err != nil {
+		t.Fatalf("second SayHello: %v", err)
+	}
+	if !replies[1].GetNotModified() {
+		t.Errorf("second reply = %v, want not_modified", replies[1])
+	}
+	if !fromCache {
+		t.Error("second SayHello wasn't served from the cache")
+	}
+	if second.GetMessage() != first.GetMessage() {
+		t.Errorf("cached message = %q, want %q", second.GetMessage(), first.GetMessage())
+	}
+}
+
+func TestGreetingCacheRefreshesCount(t *testing.T) {
+	ts := greetertest.StartTestServer(t)
+	cache := newGreetingCache(ts.Client)
+	req := &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Erin"}}
+
+	first, _, err := cache.SayHello(context.Background(), req)
+	if err != nil {
+		t.Fatalf("first SayHello: %v", err)
+	}
+
+	// Greeting Erin elsewhere moves the server's count on, but the greeting
+	// hash leaves the count out, so the cached greeting is still current
+	// and only its count is refreshed
+	if _, err := ts.Client.SayHello(context.Background(), req); err != nil {
+		t.Fatalf("SayHello bypassing the cache: %v", err)
+	}
+	var header metadata.MD
+	direct, err := ts.Client.SayHello(context.Background(), req, grpc.Header(&header))
+	if err != nil {
+		t.Fatalf("SayHello bypassing the cache: %v", err)
+	}
+	if direct.GetCount() == first.GetCount() {
+		t.Fatalf("count didn't change between calls: %d", direct.GetCount())
+	}
+
+	second, fromCache, err := cache.SayHello(context.Background(), req)
+	if err != nil {
+		t.Fatalf("second SayHello: %v", err)
+	}
+	if !fromCache {
+		t.Error("SayHello after the count changed wasn't served from the cache")
+	}
+	if second.GetMessage() != first.GetMessage() {
+		t.Errorf("cached message = %q, want %q", second.GetMessage(), first.GetMessage())
+	}
+	if want := direct.GetCount() + 1; second.GetCount() != want {
+		t.Errorf("cached count = %d, want the server's current %d", second.GetCount(), want)
+	}
+	if got := header.Get(service.GreetingHashHeader); len(got) != 1 || got[0] != cache.entries[cacheKey(req)].hash {
+		t.Errorf("hash after the count changed = %v, want the cached %s", got, cache.entries[cacheKey(req)].hash)
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/pbjson"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	protocodec "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// codecsCommand compares the codecs messages can travel in: what encoding
+// and decoding them costs in this process, and what a SayHello round trip
+// costs over the wire with each
+type codecsCommand struct {
+	benchtime time.Duration
+	calls     int
+}
+
+func (c *codecsCommand) register(fs *flag.FlagSet) {
+	fs.DurationVar(&c.benchtime, "benchtime", 200*time.Millisecond, "how long to keep encoding, or decoding, each message with each codec")
+	fs.IntVar(&c.calls, "calls", 200, "SayHello round trips to time with each codec (0 skips the server)")
+}
+
+// measure calls f over and over for at least d and returns the mean time
+// and allocations a call took
+func measure(d time.Duration, f func()) (time.Duration, float64) {
+	allocs := testing.AllocsPerRun(100, f)
+	n := 0
+	start := time.Now()
+	for time.Since(start) < d {
+		for range 100 {
+			f()
+		}
+		n += 100
+	}
+	return time.Since(start) / time.Duration(n), allocs
+}
+
+// codecSample is a message to encode, named for the table
+type codecSample struct {
+	name string
+	msg  proto.Message
+}
+
+func codecSamples() []codecSample {
+	languages := &pb.ListSupportedLanguagesResponse{}
+	for _, code := range []string{"en", "de", "fr", "es", "ja", "pt", "it", "nl"} {
+		languages.Languages = append(languages.Languages, &pb.Language{Code: code, Name: strings.ToUpper(code), NativeName: code + "-native"})
+	}
+	return []codecSample{
+		{"HelloRequest", &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}, Language: "fr-CA, de;q=0.8"}},
+		{"HelloResponse", &pb.HelloResponse{Message: "Hello, Alice!", Count: 42, Salutation: "Hello", Subject: "Alice", Punctuation: "!", Language: "en"}},
+		{"ListSupportedLanguagesResponse", languages},
+		{"SayHelloLargeResponse 64KiB", &pb.SayHelloLargeResponse{Message: "Hello, Alice!", Payload: make([]byte, 64<<10)}},
+	}
+}
+
+func (c *codecsCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	codecs := []encoding.CodecV2{encoding.GetCodecV2(protocodec.Name), pbjson.Codec{}}
+
+	fmt.Println("🧮 Encoding cost in this process")
+	fmt.Printf("%-32s %-6s %9s %12s %12s %8s\n", "MESSAGE", "CODEC", "BYTES", "MARSHAL", "UNMARSHAL", "ALLOCS")
+	for _, sample := range codecSamples() {
+		for _, codec := range codecs {
+			data, err := codec.Marshal(sample.msg)
+			if err != nil {
+				fatal("Failed to marshal the sample", "message", sample.name, "codec", codec.Name(), "error", err)
+			}
+			marshal, marshalAllocs := measure(c.benchtime, func() {
+				out, _ := codec.Marshal(sample.msg)
+				out.Free()
+			})
+			unmarshal, unmarshalAllocs := measure(c.benchtime, func() {
+				codec.Unmarshal(data, sample.msg.ProtoReflect().New().Interface())
+			})
+			fmt.Printf("%-32s %-6s %9d %12s %12s %8.0f\n", sample.name, codec.Name(), data.Len(),
+				marshal, unmarshal, marshalAllocs+unmarshalAllocs)
+		}
+	}
+
+	if c.calls == 0 {
+		return
+	}
+	fmt.Printf("\n📡 %d SayHello round trips with each codec\n", c.calls)
+	fmt.Printf("%-6s %12s %12s\n", "CODEC", "P50", "P99")
+	client := pb.NewGreetingServiceClient(conn)
+	req := &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}
+	for _, codec := range codecs {
+		latencies := make([]time.Duration, 0, c.calls)
+		for range c.calls {
+			callCtx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+			start := time.Now()
+			_, err := client.SayHello(callCtx, req, grpc.CallContentSubtype(codec.Name()))
+			cancel()
+			if err != nil {
+				printStatusDetails(err)
+				os.Exit(1)
+			}
+			latencies = append(latencies, time.Since(start))
+		}
+		slices.Sort(latencies)
+		fmt.Printf("%-6s %12s %12s\n", codec.Name(),
+			latencies[len(latencies)/2].Round(time.Microsecond), latencies[len(latencies)*99/100].Round(time.Microsecond))
+	}
+}
@@ -0,0 +1,397 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetingclient"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/pbjson"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// helloCommand sends a single SayHello
+type helloCommand struct {
+	name        string
+	userID      int64
+	language    string
+	requestFile string
+}
+
+func (c *helloCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.name, "name", "World", "name to greet")
+	fs.Int64Var(&c.userID, "user-id", 0, "greet the directory user with this id instead of -name")
+	fs.StringVar(&c.language, "language", "", "language to be greeted in, e.g. de or \"fr-CA, de;q=0.8\" (see `client languages`)")
+	fs.StringVar(&c.requestFile, "request-file", "", "send the HelloRequest in this JSON file, or - for stdin, instead of one built from the flags")
+}
+
+func (c *helloCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	req := helloRequest(c.name, c.userID)
+	req.Language = c.language
+	if c.requestFile != "" {
+		req = readHelloRequest(c.requestFile)
+	}
+	var md metadata.Response
+	resp, err := greetingClient(cfg, conn).SayHello(ctx, req, md.CallOptions()...)
+	if err != nil {
+		printStatusDetails(err)
+		os.Exit(1)
+	}
+	fmt.Printf("✅ %s (Count: %d)\n", resp.GetMessage(), resp.GetCount())
+	if via := md.Via(); len(via) > 0 {
+		fmt.Printf("🔀 Via %s → %s\n", strings.Join(via, " → "), md.Backend())
+	}
+}
+
+// batchCommand greets several names with one SayHelloBatch and prints how
+// each fared
+type batchCommand struct {
+	names    string
+	language string
+}
+
+func (c *batchCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.names, "names", "Alice,Bob,Carol", "comma separated names to greet in one call; an empty name shows one failing on its own")
+	fs.StringVar(&c.language, "language", "", "language to be greeted in, as for hello")
+}
+
+func (c *batchCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	resp, err := greetingClient(cfg, conn).SayHelloBatch(ctx, strings.Split(c.names, ","), c.language)
+	if err != nil {
+		printStatusDetails(err)
+		os.Exit(1)
+	}
+	for _, r := range resp.GetResults() {
+		if r.GetError() != nil {
+			printStatusDetails(status.ErrorProto(r.GetError()))
+			fmt.Printf("   name %q\n", r.GetName())
+			continue
+		}
+		fmt.Printf("✅ %s (Count: %d)\n", r.GetResponse().GetMessage(), r.GetResponse().GetCount())
+	}
+	fmt.Printf("📦 Greeted %d of %d names\n", len(resp.GetResults())-int(resp.GetFailed()), len(resp.GetResults()))
+}
+
+// converseCommand holds a conversation: it starts one, or picks up the one
+// -session names, and greets once per turn, switching languages as
+// -languages says
+type converseCommand struct {
+	name      string
+	turns     int
+	languages string
+	session   string
+	keep      bool
+}
+
+func (c *converseCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.name, "name", "World", "name to greet")
+	fs.IntVar(&c.turns, "turns", 3, "greetings to exchange, the first included")
+	fs.StringVar(&c.languages, "languages", "", "comma separated languages for successive turns, e.g. \"en,fr,de\"; an empty entry, or running out, keeps the conversation's language")
+	fs.StringVar(&c.session, "session", "", "continue the conversation with this session token, as printed by an earlier -keep run, instead of starting one")
+	fs.BoolVar(&c.keep, "keep", false, "leave the conversation open at the end, printing its session token, instead of ending it")
+}
+
+func (c *converseCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	client := greetingClient(cfg, conn)
+	var languages []string
+	if c.languages != "" {
+		languages = strings.Split(c.languages, ",")
+	}
+	language := func(turn int) string {
+		if turn < len(languages) {
+			return strings.TrimSpace(languages[turn])
+		}
+		return ""
+	}
+
+	var conversation *greetingclient.Conversation
+	for turn := range c.turns {
+		var resp *pb.ConversationResponse
+		var err error
+		if turn == 0 && c.session == "" {
+			conversation, resp, err = client.StartConversation(ctx, c.name, language(turn))
+		} else {
+			if conversation == nil {
+				conversation = client.Conversation(c.session)
+			}
+			resp, err = conversation.Continue(ctx, language(turn))
+		}
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		fmt.Printf("💬 Turn %d: %s\n", resp.GetTurn(), resp.GetMessage())
+		if turn == c.turns-1 {
+			fmt.Printf("   History: %s\n", strings.Join(resp.GetHistory(), " | "))
+			fmt.Printf("   Session expires at %s unless used again\n", resp.GetExpiresAt().AsTime().Local().Format(time.TimeOnly))
+		}
+	}
+	if conversation == nil {
+		return
+	}
+	if c.keep {
+		fmt.Printf("📌 Conversation kept; continue it with -session %s\n", conversation.Token())
+		return
+	}
+	resp, err := conversation.End(ctx)
+	if err != nil {
+		printStatusDetails(err)
+		os.Exit(1)
+	}
+	fmt.Printf("👋 Conversation ended after %d turn(s)\n", resp.GetTurns())
+}
+
+// cachedCommand says hello every -interval through a greetingclient.Cache,
+// showing which greetings came from the server, which from the cache, and
+// which were stale; stop the server part way through to see the cache keep
+// greeting
+type cachedCommand struct {
+	name     string
+	userID   int64
+	language string
+	count    int
+	interval time.Duration
+	size     int
+	policy   greetingclient.CachePolicy
+}
+
+func (c *cachedCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.name, "name", "World", "name to greet")
+	fs.Int64Var(&c.userID, "user-id", 0, "greet the directory user with this id instead of -name")
+	fs.StringVar(&c.language, "language", "", "language to be greeted in, as for hello")
+	fs.IntVar(&c.count, "count", 30, "number of greetings to ask for")
+	fs.DurationVar(&c.interval, "interval", time.Second, "pause between greetings")
+	fs.IntVar(&c.size, "size", 100, "maximum number of responses to cache")
+	fs.DurationVar(&c.policy.Fresh, "fresh", 3*time.Second, "how long a response is served from the cache without calling the server")
+	fs.DurationVar(&c.policy.StaleWhileRevalidate, "stale-while-revalidate", 10*time.Second, "how long past -fresh a response is served stale while a fresh one is fetched in the background")
+	fs.DurationVar(&c.policy.StaleIfError, "stale-if-error", 5*time.Minute, "how long past -fresh a response is served stale while the server can't be reached")
+}
+
+func (c *cachedCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	cache := greetingclient.NewCache(greetingClient(cfg, conn), c.size, c.policy)
+	req := helloRequest(c.name, c.userID)
+	req.Language = c.language
+	for i := range c.count {
+		if i > 0 {
+			time.Sleep(c.interval)
+		}
+		resp, err := cache.SayHello(ctx, req)
+		switch {
+		case err != nil:
+			printStatusDetails(err)
+		case resp.Err != nil:
+			fmt.Printf("🧊 %s (Count: %d) stale, %s old; server unreachable: %s\n", resp.GetMessage(), resp.GetCount(), resp.Age.Round(time.Millisecond), status.Code(resp.Err))
+		case resp.Stale:
+			fmt.Printf("⏳ %s (Count: %d) stale, %s old; refreshing in the background\n", resp.GetMessage(), resp.GetCount(), resp.Age.Round(time.Millisecond))
+		case resp.Age > 0:
+			fmt.Printf("🗄️ %s (Count: %d) cached, %s old\n", resp.GetMessage(), resp.GetCount(), resp.Age.Round(time.Millisecond))
+		default:
+			fmt.Printf("✅ %s (Count: %d)\n", resp.GetMessage(), resp.GetCount())
+		}
+	}
+}
+
+// streamCommand prints SayHelloMultiple greetings as they arrive, resuming
+// the stream if the server restarts part way through. -resume continues a
+// stream an earlier run didn't finish.
+type streamCommand struct {
+	name        string
+	userID      int64
+	count       int
+	interval    time.Duration
+	resume      string
+	requestFile string
+}
+
+func (c *streamCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.name, "name", "World", "name to greet")
+	fs.Int64Var(&c.userID, "user-id", 0, "greet the directory user with this id instead of -name")
+	fs.IntVar(&c.count, "count", 0, "number of greetings to ask for (0 uses the server default)")
+	fs.DurationVar(&c.interval, "interval", 0, "pause between greetings to ask for (0 uses the server default)")
+	fs.StringVar(&c.resume, "resume", "", "continue the stream after the greeting with this resume token, as printed when a stream fails, instead of starting one")
+	fs.StringVar(&c.requestFile, "request-file", "", "start the stream with the HelloRequest in this JSON file, or - for stdin, instead of one built from the flags")
+}
+
+func (c *streamCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	var token string
+	print := func(resp *pb.HelloResponse) error {
+		fmt.Printf("📨 %s\n", resp.GetMessage())
+		token = resp.GetResumeToken()
+		return nil
+	}
+
+	client := greetingClient(cfg, conn)
+	var err error
+	if c.resume != "" {
+		err = client.ResumeGreetings(ctx, c.resume, print)
+	} else {
+		req := helloRequest(c.name, c.userID)
+		req.Count = int32(c.count)
+		req.IntervalMs = int32(c.interval.Milliseconds())
+		if c.requestFile != "" {
+			req = readHelloRequest(c.requestFile)
+		}
+		err = client.StreamGreetings(ctx, req, print)
+	}
+	if err != nil {
+		printStatusDetails(err)
+		if token != "" {
+			fmt.Printf("🔖 Continue with: stream -resume %s\n", token)
+		}
+		os.Exit(1)
+	}
+}
+
+// runLanguages lists the languages the server greets in
+func runLanguages(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	resp, err := pb.NewGreetingServiceClient(conn).ListSupportedLanguages(ctx, &pb.ListSupportedLanguagesRequest{})
+	if err != nil {
+		printStatusDetails(err)
+		os.Exit(1)
+	}
+	for _, lang := range resp.GetLanguages() {
+		fmt.Printf("%-4s %-10s %s\n", lang.GetCode(), lang.GetName(), lang.GetNativeName())
+	}
+}
+
+// historyCommand pages through the greetings the server has recorded
+type historyCommand struct {
+	name     string
+	pageSize int
+}
+
+func (c *historyCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.name, "name", "", "only list greetings to this name (everyone when empty)")
+	fs.IntVar(&c.pageSize, "page-size", 10, "greetings fetched per ListGreetings call")
+}
+
+func (c *historyCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	client := pb.NewGreetingServiceClient(conn)
+	req := &pb.ListGreetingsRequest{Name: c.name, PageSize: int32(c.pageSize)}
+	for page := 1; ; page++ {
+		stream, err := client.ListGreetings(ctx, req)
+		if err != nil {
+			fatal("Error calling ListGreetings", logging.Err(err))
+		}
+
+		fmt.Printf("📄 Page %d\n", page)
+		req.PageToken = ""
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				printStatusDetails(err)
+				os.Exit(1)
+			}
+			g := resp.GetGreeting()
+			fmt.Printf("  #%d %s %s: %s\n", g.GetId(), g.GetGreetedAt().AsTime().Local().Format(time.DateTime), g.GetName(), g.GetMessage())
+			req.PageToken = resp.GetNextPageToken()
+		}
+		if req.PageToken == "" {
+			return
+		}
+	}
+}
+
+// subscribeCommand prints greetings the server pushes as SayHello sends them
+type subscribeCommand struct {
+	name string
+}
+
+func (c *subscribeCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.name, "name", "", "only show greetings to this name (everyone when empty)")
+}
+
+func (c *subscribeCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	events, err := pb.NewGreetingServiceClient(conn).SubscribeGreetings(ctx, &pb.SubscribeGreetingsRequest{Name: c.name})
+	if err != nil {
+		fatal("Error calling SubscribeGreetings", logging.Err(err))
+	}
+	fmt.Fprintln(os.Stderr, "Waiting for greetings; stop with Ctrl-C.")
+	for {
+		event, err := events.Recv()
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		if event.GetMissed() > 0 {
+			fmt.Printf("⚠️  Missed %d greeting(s) while falling behind\n", event.GetMissed())
+		}
+		fmt.Printf("🔔 %s %s (Count: %d)\n", event.GetGreetedAt().AsTime().Local().Format(time.TimeOnly), event.GetMessage(), event.GetCount())
+	}
+}
+
+// runChat greets every name typed on stdin over one GreetEveryone stream,
+// printing the server's replies as they arrive
+func runChat(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	chat, err := pb.NewGreetingServiceClient(conn).GreetEveryone(ctx)
+	if err != nil {
+		fatal("Error calling GreetEveryone", logging.Err(err))
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			resp, err := chat.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				printStatusDetails(err)
+				os.Exit(1)
+			}
+			fmt.Printf("📨 %s\n", resp.GetMessage())
+		}
+	}()
+
+	fmt.Fprintln(os.Stderr, "Type a name per line; end with Ctrl-D.")
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" {
+			continue
+		}
+		if err := chat.Send(&pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: name}}); err != nil {
+			// The receiving goroutine reports the stream's status
+			break
+		}
+	}
+	if err := chat.CloseSend(); err != nil {
+		fatal("Error closing GreetEveryone", logging.Err(err))
+	}
+	<-done
+}
+
+// readHelloRequest reads a HelloRequest from the JSON file at path, e.g.
+// {"name": "Alice", "language": "fr"}, or exits if it can't
+func readHelloRequest(path string) *pb.HelloRequest {
+	req := &pb.HelloRequest{}
+	if err := pbjson.ReadMessage(path, req); err != nil {
+		fatal("Failed to read the request", "file", path, logging.Err(err))
+	}
+	return req
+}
+
+// helloRequest identifies the caller by userID when set, otherwise by name
+func helloRequest(name string, userID int64) *pb.HelloRequest {
+	if userID != 0 {
+		return greetingclient.UserID(userID)
+	}
+	return greetingclient.Name(name)
+}
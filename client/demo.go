@@ -0,0 +1,314 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetingclient"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+	userpb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/user"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// runDemo walks through every kind of call the service offers, one example
+// after another. It is what the client runs without a subcommand.
+func runDemo(baseCtx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	// Create a client
+	client := pb.NewGreetingServiceClient(conn)
+
+	slog.Info("🚀 gRPC Client started...")
+	fmt.Println("=" + string(make([]byte, 50)) + "=")
+
+	if cfg.ListServices {
+		fmt.Println("\n🔎 Listing services through server reflection...")
+		listServices(baseCtx, conn)
+	}
+
+	// Example 0: Check the server's health before calling it
+	fmt.Println("\n🩺 Checking server health...")
+	checkHealth(baseCtx, healthpb.NewHealthClient(conn), pb.GreetingService_ServiceDesc.ServiceName)
+
+	// Example 1: Simple unary RPC call
+	fmt.Println("\n📞 Making simple SayHello call...")
+	ctx, cancel := context.WithTimeout(baseCtx, cfg.Timeout)
+	defer cancel()
+
+	response, err := client.SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}})
+	if err != nil {
+		fatal("Error calling SayHello", logging.Err(err))
+	}
+
+	fmt.Printf("✅ Response: %s\n", response.GetMessage())
+	fmt.Printf("   Count: %d\n", response.GetCount())
+	fmt.Printf("   Parts: salutation=%q subject=%q punctuation=%q\n", response.GetSalutation(), response.GetSubject(), response.GetPunctuation())
+
+	// Example 1a: Ask the server how often it has greeted Alice
+	stats, err := client.GetNameStats(ctx, &pb.NameStatsRequest{Name: "Alice"})
+	if err != nil {
+		fatal("Error calling GetNameStats", logging.Err(err))
+	}
+	fmt.Printf("   Alice greeted %d time(s), first at %s, last at %s\n",
+		stats.GetGreetCount(), stats.GetFirstGreetedAt().AsTime().Format(time.RFC3339), stats.GetLastGreetedAt().AsTime().Format(time.RFC3339))
+
+	greetings, err := client.GetGreetingCount(ctx, &pb.GetGreetingCountRequest{Name: "Alice"})
+	if err != nil {
+		fatal("Error calling GetGreetingCount", logging.Err(err))
+	}
+	fmt.Printf("   GetGreetingCount agrees: %d (run `client history` to list them)\n", greetings.GetCount())
+
+	// Example 1b: Greet by user id instead of by name (proto oneof)
+	fmt.Println("\n🆔 Making SayHello call by user id...")
+	response, err = client.SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_UserId{UserId: 3}})
+	if err != nil {
+		fatal("Error calling SayHello by id", logging.Err(err))
+	}
+
+	fmt.Printf("✅ Response: %s\n", response.GetMessage())
+
+	// Example 1c: The same call through the v2 API served by the same server
+	fmt.Println("\n🆕 Making SayHello call with the v2 API...")
+	v2Response, err := pbv2.NewGreetingServiceV2Client(conn).SayHello(ctx, &pbv2.SayHelloRequest{Identity: &pbv2.SayHelloRequest_UserId{UserId: 3}})
+	if err != nil {
+		fatal("Error calling v2 SayHello", logging.Err(err))
+	}
+
+	fmt.Printf("✅ Response: %s\n", v2Response.GetGreeting().GetMessage())
+	fmt.Printf("   Recipient: %s (%s)\n", v2Response.GetRecipient().GetName(), v2Response.GetRecipient().GetSource())
+
+	// Example 1d: Repeat a call through the conditional cache; the server
+	// only confirms the cached greeting is still current
+	fmt.Println("\n🗃️  Making cached SayHello calls...")
+	cache := newGreetingCache(client)
+	for i := 1; i <= 2; i++ {
+		response, fromCache, err := cache.SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Dave"}})
+		if err != nil {
+			fatal("Error calling cached SayHello", logging.Err(err))
+		}
+		fmt.Printf("✅ Response #%d: %s (from cache: %t)\n", i, response.GetMessage(), fromCache)
+	}
+
+	// Example 1e: Send custom headers and read the server's response
+	// headers and trailers
+	fmt.Println("\n🏷️  Making SayHello call with custom metadata...")
+	var md metadata.Response
+	mdCtx := metadata.WithLocale(metadata.WithRequestID(ctx, "demo-1"), "en-GB")
+	if _, err := client.SayHello(mdCtx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}, md.CallOptions()...); err != nil {
+		fatal("Error calling SayHello with metadata", logging.Err(err))
+	}
+	fmt.Printf("✅ Headers: request-id=%s server-version=%s\n", md.RequestID(), md.ServerVersion())
+	fmt.Printf("   Trailers: processing-time=%s\n", md.ProcessingTime())
+
+	// Example 1f: Invalid requests fail with InvalidArgument and say which
+	// fields were wrong
+	fmt.Println("\n🚫 Making invalid SayHello calls...")
+	for _, req := range []*pb.HelloRequest{
+		{Identity: &pb.HelloRequest_Name{Name: ""}},
+		{Identity: &pb.HelloRequest_Name{Name: strings.Repeat("x", service.MaxNameLength+1)}},
+	} {
+		if _, err := client.SayHello(ctx, req); err != nil {
+			printStatusDetails(err)
+		} else {
+			fatal("Expected SayHello to reject the request", "request", req.String())
+		}
+	}
+
+	// Example 1g: Greet in the caller's language, picked from the request's
+	// language field or the locale header and falling back to English
+	fmt.Println("\n🌍 Making SayHello calls in other languages...")
+	languages, err := client.ListSupportedLanguages(ctx, &pb.ListSupportedLanguagesRequest{})
+	if err != nil {
+		fatal("Error calling ListSupportedLanguages", logging.Err(err))
+	}
+	for _, lang := range append(languages.GetLanguages(), &pb.Language{Code: "pt-BR", Name: "Portuguese"}) {
+		resp, err := client.SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}, Language: lang.GetCode()})
+		if err != nil {
+			fatal("Error calling SayHello", "language", lang.GetName(), logging.Err(err))
+		}
+		fmt.Printf("✅ %-11s %s (%s)\n", lang.GetName()+":", resp.GetMessage(), resp.GetLanguage())
+	}
+
+	// Example 1h: Repeat a call with the same idempotency key; the server
+	// replays the first response instead of greeting Erin twice
+	fmt.Println("\n🔂 Repeating SayHello with one idempotency key...")
+	keyCtx := interceptors.WithIdempotencyKey(ctx, "demo-"+logging.NewRequestID())
+	for i := 1; i <= 2; i++ {
+		var md metadata.Response
+		response, err := client.SayHello(keyCtx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Erin"}}, md.CallOptions()...)
+		if err != nil {
+			fatal("Error calling SayHello with an idempotency key", logging.Err(err))
+		}
+		fmt.Printf("✅ Attempt #%d: %s (Count: %d, x-cache: %s)\n", i, response.GetMessage(), response.GetCount(), md.Cache())
+	}
+
+	// Example 2: Server streaming RPC call
+	fmt.Println("\n📡 Making streaming SayHelloMultiple call...")
+	// Receive streaming responses, picking the stream up again if the
+	// server restarts part way through
+	bob := greetingclient.Name("Bob")
+	bob.Count = 5
+	err = greetingClient(cfg, conn).StreamGreetings(baseCtx, bob, func(response *pb.HelloResponse) error {
+		fmt.Printf("📨 Received: %s (Count: %d)\n", response.GetMessage(), response.GetCount())
+		return nil
+	})
+	if err != nil {
+		fatal("Error receiving stream", logging.Err(err))
+	}
+	fmt.Println("\n✅ Streaming complete!")
+
+	// Example 2a: Cancel a stream part way through; the server notices and
+	// stops sending instead of finishing all five greetings
+	fmt.Println("\n✂️  Canceling SayHelloMultiple after two messages...")
+	cancelCtx, cancelStream := context.WithCancel(baseCtx)
+	stream, err := client.SayHelloMultiple(cancelCtx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Bob"}})
+	if err != nil {
+		fatal("Error calling SayHelloMultiple", logging.Err(err))
+	}
+	for received := 0; ; {
+		response, err := stream.Recv()
+		if err != nil {
+			// Canceled is expected here; anything else is a real failure
+			if status.Code(err) != codes.Canceled {
+				fatal("Error receiving stream", logging.Err(err))
+			}
+			fmt.Printf("✅ Stream ended with %s after %d message(s)\n", status.Code(err), received)
+			break
+		}
+		received++
+		fmt.Printf("📨 Received: %s\n", response.GetMessage())
+		if received == 2 {
+			cancelStream()
+		}
+	}
+	cancelStream()
+
+	// Example 2b: Subscribe to greetings, then watch the server push the
+	// ones SayHello sends
+	fmt.Println("\n🔔 Subscribing to greetings for Dana...")
+	subCtx, unsubscribe := context.WithTimeout(baseCtx, cfg.Timeout)
+	events, err := client.SubscribeGreetings(subCtx, &pb.SubscribeGreetingsRequest{Name: "Dana"})
+	if err != nil {
+		fatal("Error calling SubscribeGreetings", logging.Err(err))
+	}
+	// The server sends its headers once the subscription is registered
+	if _, err := events.Header(); err != nil {
+		fatal("Error subscribing to greetings", logging.Err(err))
+	}
+	for range 2 {
+		if _, err := client.SayHello(subCtx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Dana"}}); err != nil {
+			fatal("Error calling SayHello", logging.Err(err))
+		}
+		event, err := events.Recv()
+		if err != nil {
+			fatal("Error receiving greeting event", logging.Err(err))
+		}
+		fmt.Printf("🔔 Pushed: %s (Count: %d)\n", event.GetMessage(), event.GetCount())
+	}
+	unsubscribe()
+
+	// Example 2c: A payload too large for one message fails with
+	// ResourceExhausted; streamed in chunks it arrives whole
+	fmt.Println("\n📦 Receiving a large payload...")
+	largeCtx, cancelLarge := context.WithTimeout(baseCtx, cfg.Timeout)
+	demoLarge(largeCtx, cfg, client)
+	cancelLarge()
+
+	// Example 3: Client streaming RPC call
+	fmt.Println("\n📤 Making client streaming SayHelloToEveryone call...")
+	everyone, err := client.SayHelloToEveryone(baseCtx)
+	if err != nil {
+		fatal("Error calling SayHelloToEveryone", logging.Err(err))
+	}
+
+	// Send several requests, then close our side and wait for the reply
+	for _, name := range []string{"Alice", "Bob", "Carol"} {
+		fmt.Printf("📤 Sending: %s\n", name)
+		if err := everyone.Send(&pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: name}}); err != nil {
+			fatal("Error sending to stream", logging.Err(err))
+		}
+	}
+
+	response, err = everyone.CloseAndRecv()
+	if err != nil {
+		fatal("Error receiving SayHelloToEveryone response", logging.Err(err))
+	}
+	fmt.Printf("✅ Response: %s (Count: %d)\n", response.GetMessage(), response.GetCount())
+
+	// Example 4: Bidirectional streaming RPC call
+	fmt.Println("\n🔁 Making bidirectional GreetEveryone call...")
+	chat, err := client.GreetEveryone(baseCtx)
+	if err != nil {
+		fatal("Error calling GreetEveryone", logging.Err(err))
+	}
+
+	// Send on one goroutine while receiving on this one, then close our
+	// side so the server knows we're done
+	sendErr := make(chan error, 1)
+	go func() {
+		for _, name := range []string{"Dan", "Eve", "Frank"} {
+			fmt.Printf("📤 Sending: %s\n", name)
+			if err := chat.Send(&pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: name}}); err != nil {
+				sendErr <- err
+				return
+			}
+			time.Sleep(300 * time.Millisecond)
+		}
+		sendErr <- chat.CloseSend()
+	}()
+
+	for {
+		response, err := chat.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fatal("Error receiving from GreetEveryone", logging.Err(err))
+		}
+		fmt.Printf("📨 Received: %s (Count: %d)\n", response.GetMessage(), response.GetCount())
+	}
+	if err := <-sendErr; err != nil {
+		fatal("Error sending to GreetEveryone", logging.Err(err))
+	}
+	fmt.Println("✅ Bidirectional streaming complete!")
+
+	// Example 5: Stream greetings straight into a writer
+	if cfg.StreamOut != "" {
+		fmt.Printf("\n💾 Streaming SayHelloMultiple into %s...\n", cfg.StreamOut)
+		var out io.Writer = os.Stdout
+		if cfg.StreamOut != "-" {
+			f, err := os.Create(cfg.StreamOut)
+			if err != nil {
+				fatal("Error creating stream output", "path", cfg.StreamOut, logging.Err(err))
+			}
+			defer f.Close()
+			out = f
+		}
+
+		n, err := greetingClient(cfg, conn).StreamTo(baseCtx, greetingclient.Name("Carol"), out)
+		if err != nil {
+			fatal("Error streaming to writer", logging.Err(err))
+		}
+		fmt.Printf("✅ Wrote %d messages\n", n)
+	}
+
+	// Example 6: Create, update, list and delete a user on the UserService
+	// registered on the same server
+	fmt.Println("\n👤 Managing a user through UserService...")
+	demoUsers(baseCtx, cfg, userpb.NewUserServiceClient(conn))
+
+	fmt.Println("\n" + string(make([]byte, 50)))
+	slog.Info("✅ Client finished successfully!")
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/auth"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/certs"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/connmgr"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetingclient"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metrics"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/pbjson"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/pool"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/record"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/tracing"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/transport"
+	"go.opentelemetry.io/otel/baggage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	_ "google.golang.org/grpc/encoding/gzip" // Register the gzip compressor
+)
+
+// connect dials the server described by cfg. The returned function closes
+// the connection and the recording, and flushes metrics and traces.
+func connect(cfg *config.Client) (*grpc.ClientConn, func()) {
+	// Connect to the gRPC server. Unary calls are retried with exponential
+	// backoff, reusing one idempotency key per logical call.
+	creds := insecure.NewCredentials()
+	if cfg.TLS.Enabled {
+		tlsConfig, err := certs.ClientConfig(cfg.TLS.CAFile, cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.ServerName)
+		if err != nil {
+			fatal("Failed to set up TLS", logging.Err(err))
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	retryPolicy := interceptors.DefaultRetryPolicy
+	retryPolicy.MaxAttempts = cfg.RetryMaxAttempts
+	retryPolicy.InitialBackoff = cfg.RetryInitialBackoff
+	retryPolicy.MaxBackoff = cfg.RetryMaxBackoff
+
+	// Export traces when OTEL_* variables ask for it; no-op otherwise
+	shutdownTracing, err := tracing.Setup(context.Background(), "greeter-client")
+	if err != nil {
+		fatal("Failed to set up tracing", logging.Err(err))
+	}
+
+	// Metrics wrap the retry interceptor so each logical call is counted
+	// once, with its final status
+	registry := metrics.NewRegistry()
+	clientMetrics := metrics.NewClientMetrics(registry)
+	stopMetrics := func(context.Context) error { return nil }
+	if cfg.MetricsAddr != "" {
+		stopMetrics = metrics.Serve(cfg.MetricsAddr, registry)
+		slog.Info("📈 Client metrics available", "url", "http://"+cfg.MetricsAddr+"/metrics")
+	}
+
+	// Every call gets its request id first, so retries and hedged copies
+	// share it. Hedging sits between metrics and retries: each copy of a
+	// call is retried on its own, and the logical call is counted once.
+	unary := []grpc.UnaryClientInterceptor{
+		interceptors.UnaryClientRequestID(),
+		requestcontext.UnaryClientInterceptor(),
+		clientMetrics.UnaryClientInterceptor(),
+	}
+	stream := []grpc.StreamClientInterceptor{
+		interceptors.StreamClientRequestID(),
+		requestcontext.StreamClientInterceptor(),
+		clientMetrics.StreamClientInterceptor(),
+	}
+	if cfg.DebugJSON {
+		dumper := pbjson.New(os.Stderr)
+		unary = append(unary, dumper.UnaryClientInterceptor())
+		stream = append(stream, dumper.StreamClientInterceptor())
+	}
+	// Record each logical call once, whatever retries it takes
+	closeRecording := func() error { return nil }
+	if cfg.RecordFile != "" {
+		f, err := os.Create(cfg.RecordFile)
+		if err != nil {
+			fatal("Failed to create the recording", logging.Err(err))
+		}
+		recorder := record.New(f)
+		unary = append(unary, recorder.UnaryClientInterceptor())
+		stream = append(stream, recorder.StreamClientInterceptor())
+		closeRecording = f.Close
+		slog.Info("⏺️ Recording calls", "file", cfg.RecordFile)
+	}
+
+	// The circuit breaker sees each logical call once, and its short
+	// circuits are counted by the metrics as Unavailable calls
+	if cfg.BreakerFailureRate > 0 {
+		breaker := interceptors.NewCircuitBreaker(interceptors.BreakerPolicy{
+			FailureRate: cfg.BreakerFailureRate,
+			Window:      cfg.BreakerWindow,
+			OpenFor:     cfg.BreakerOpenFor,
+			Probes:      cfg.BreakerProbes,
+		})
+		breaker.OnChange(logBreaker)
+		breaker.OnChange(metrics.NewBreakerMetrics(registry).StateChanged)
+		unary = append(unary, breaker.UnaryClientInterceptor())
+		stream = append(stream, breaker.StreamClientInterceptor())
+	}
+	if cfg.HedgeDelay > 0 {
+		unary = append(unary, interceptors.UnaryClientHedging(interceptors.HedgePolicy{
+			Delay:       cfg.HedgeDelay,
+			MaxAttempts: cfg.HedgeAttempts,
+			Methods:     []string{pb.GreetingService_SayHello_FullMethodName, pbv2.GreetingServiceV2_SayHello_FullMethodName},
+		}, metrics.NewHedgeMetrics(registry)))
+	}
+
+	// Retry in the interceptor, or leave retries to gRPC through the
+	// service config and only attach the idempotency key its attempts share
+	var serviceConfigOpts []grpc.DialOption
+	switch cfg.RetryMode {
+	case retryModeInterceptor:
+		unary = append(unary, interceptors.UnaryClientRetry(retryPolicy))
+	case retryModeServiceConfig:
+		unary = append(unary, interceptors.UnaryClientIdempotencyKey())
+		sc, err := greetingServiceConfig(cfg, retryPolicy, balanced(cfg))
+		if err != nil {
+			fatal("Failed to build the service config", logging.Err(err))
+		}
+		slog.Debug("Using service config", "config", sc)
+		serviceConfigOpts = append(serviceConfigOpts, grpc.WithDefaultServiceConfig(sc))
+	default:
+		fatal("Unknown -retry-mode", "mode", cfg.RetryMode)
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(unary...),
+		grpc.WithChainStreamInterceptor(stream...),
+		tracing.DialOption(),
+	}
+	if cfg.Compress != "" {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(cfg.Compress)))
+	}
+	if cfg.Codec != "" && cfg.Codec != "proto" {
+		if encoding.GetCodecV2(cfg.Codec) == nil {
+			fatal("Unknown codec; use proto or json", "codec", cfg.Codec)
+		}
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.CallContentSubtype(cfg.Codec)))
+	}
+	if cfg.LogPayloads {
+		dialOpts = append(dialOpts, grpc.WithStatsHandler(interceptors.PayloadSizeLogger{}))
+	}
+	if cfg.MaxHeaderListSize > 0 {
+		dialOpts = append(dialOpts, grpc.WithMaxHeaderListSize(uint32(cfg.MaxHeaderListSize)))
+	}
+	if token := authToken(cfg); token != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(auth.TokenCredentials{Token: token, RequireTLS: cfg.TLS.Enabled}))
+	}
+	dialOpts = append(dialOpts, cfg.Messages.DialOptions()...)
+	dialOpts = append(dialOpts, cfg.Keepalive.DialOptions()...)
+	dialOpts = append(dialOpts, cfg.Connect.DialOptions()...)
+	dialOpts = append(dialOpts, serviceConfigOpts...)
+	if strings.HasPrefix(cfg.Addr, "xds:") {
+		xdsOpts, err := xdsDialOptions(cfg)
+		if err != nil {
+			fatal("Failed to set up xDS", logging.Err(err))
+		}
+		dialOpts = append(dialOpts, xdsOpts...)
+	}
+	conn, err := dialTarget(cfg, dialOpts)
+	if err != nil {
+		fatal("Failed to connect", logging.Err(err))
+	}
+
+	// Log connectivity changes, and keep the connection up between calls so
+	// a restarted server is noticed as soon as it is back
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	manager := connmgr.New(conn)
+	manager.OnChange(logConnectivity())
+	manager.Start(watchCtx)
+
+	return conn, func() {
+		stopWatching()
+		conn.Close()
+		stopMetrics(context.Background())
+		shutdownTracing(context.Background())
+		closeRecording()
+	}
+}
+
+// greetingClient wraps conn in the client library with the -timeout and
+// -reconnect-timeout settings
+func greetingClient(cfg *config.Client, conn *grpc.ClientConn) *greetingclient.Client {
+	return greetingclient.FromConn(conn, greetingclient.WithTimeout(cfg.Timeout), greetingclient.WithReconnect(cfg.ReconnectTimeout))
+}
+
+// logConnectivity returns a connmgr callback logging the connection going
+// down and coming back; every other transition is logged at debug level
+func logConnectivity() func(connmgr.Change) {
+	down := false
+	return func(c connmgr.Change) {
+		switch {
+		case c.To == connectivity.TransientFailure && !down:
+			down = true
+			slog.Warn("🔌 Lost the connection to the server, reconnecting", "from", c.From.String())
+		case c.To == connectivity.Ready && down:
+			down = false
+			slog.Info("🔌 Reconnected to the server")
+		default:
+			slog.Debug("Connectivity changed", "from", c.From.String(), "to", c.To.String())
+		}
+	}
+}
+
+// logBreaker logs circuit breaker state changes
+func logBreaker(c interceptors.BreakerChange) {
+	switch c.To {
+	case interceptors.BreakerOpen:
+		slog.Warn("⚡ Circuit breaker open, failing calls without trying the server", "from", c.From.String())
+	case interceptors.BreakerHalfOpen:
+		slog.Info("⚡ Circuit breaker half-open, probing the server")
+	case interceptors.BreakerClosed:
+		slog.Info("⚡ Circuit breaker closed, the server is back")
+	}
+}
+
+// dialTarget balances calls round-robin across servers when -addr lists
+// several of them or -round-robin is set, and uses one connection otherwise
+func dialTarget(cfg *config.Client, opts []grpc.DialOption) (*grpc.ClientConn, error) {
+	if !balanced(cfg) {
+		target, transportOpts := transport.DialTarget(cfg.Addr)
+		return grpc.NewClient(target, append(transportOpts, opts...)...)
+	}
+	p, err := pool.Dial(cfg.Addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return p.ClientConn, nil
+}
+
+// balanced reports whether dialTarget balances across servers
+func balanced(cfg *config.Client) bool {
+	return cfg.RoundRobin || strings.Contains(cfg.Addr, ",")
+}
+
+// callContext returns the context every call starts from, carrying the
+// requested response encoding, the -tenant-id, -caller-id and -baggage
+// request values, the -priority and any extra -metadata headers
+func callContext(cfg *config.Client) context.Context {
+	ctx := context.Background()
+	if cfg.TenantID != "" {
+		ctx = requestcontext.WithTenantID(ctx, cfg.TenantID)
+	}
+	if cfg.UserID != "" {
+		ctx = requestcontext.WithUserID(ctx, cfg.UserID)
+	}
+	if cfg.Baggage != "" {
+		b, err := baggage.Parse(cfg.Baggage)
+		if err != nil {
+			fatal("Invalid -baggage", logging.Err(err))
+		}
+		ctx = requestcontext.WithBaggage(ctx, b)
+	}
+	if cfg.ResponseEncoding != "" {
+		ctx = interceptors.WithResponseEncoding(ctx, cfg.ResponseEncoding)
+	}
+	if cfg.Priority != "" {
+		if _, err := interceptors.ParsePriority(cfg.Priority); err != nil {
+			fatal("Invalid -priority", logging.Err(err))
+		}
+		ctx = metadata.WithPriority(ctx, cfg.Priority)
+	}
+	return cfg.Metadata.Outgoing(ctx)
+}
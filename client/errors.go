@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// printStatusDetails prints err's status code and message followed by any
+// rich details the server attached, such as field violations
+func printStatusDetails(err error) {
+	st := status.Convert(err)
+	fmt.Printf("❌ %s: %s\n", st.Code(), st.Message())
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				fmt.Printf("   field %q: %s\n", v.GetField(), v.GetDescription())
+			}
+		case *errdetails.ErrorInfo:
+			fmt.Printf("   reason %s: %v\n", d.GetReason(), d.GetMetadata())
+		case error:
+			fmt.Printf("   undecodable detail: %v\n", d)
+		default:
+			fmt.Printf("   detail: %v\n", d)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+)
+
+// fanoutCommand makes a series of SayHello calls and reports the backend
+// trailer of each, showing how a balanced connection spreads them
+type fanoutCommand struct {
+	requests int
+}
+
+func (c *fanoutCommand) register(fs *flag.FlagSet) {
+	fs.IntVar(&c.requests, "requests", 9, "number of SayHello calls to make")
+}
+
+func (c *fanoutCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	client := pb.NewGreetingServiceClient(conn)
+	perBackend := make(map[string]int)
+	for i := 1; i <= c.requests; i++ {
+		callCtx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+		var md metadata.Response
+		_, err := client.SayHello(callCtx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Fan"}}, md.CallOptions()...)
+		cancel()
+		if err != nil {
+			fatal("Error calling SayHello", logging.Err(err))
+		}
+		fmt.Printf("📨 Call #%d served by %s\n", i, md.Backend())
+		perBackend[md.Backend()]++
+	}
+
+	backends := make([]string, 0, len(perBackend))
+	for b := range perBackend {
+		backends = append(backends, b)
+	}
+	sort.Strings(backends)
+	fmt.Println("✅ Calls per backend:")
+	for _, b := range backends {
+		fmt.Printf("   %-20s %d\n", b, perBackend[b])
+	}
+}
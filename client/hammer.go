@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// hammerCommand sends a burst of SayHello calls and summarizes how the
+// server answered, showing its rate limiting and request priorities at work
+type hammerCommand struct {
+	requests    int
+	concurrency int
+}
+
+func (c *hammerCommand) register(fs *flag.FlagSet) {
+	fs.IntVar(&c.requests, "requests", 200, "total SayHello calls to make")
+	fs.IntVar(&c.concurrency, "concurrency", 20, "calls in flight at once")
+}
+
+func (c *hammerCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	client := pb.NewGreetingServiceClient(conn)
+	calls := make(chan struct{})
+	go func() {
+		defer close(calls)
+		for i := 0; i < c.requests; i++ {
+			calls <- struct{}{}
+		}
+	}()
+
+	var (
+		mu         sync.Mutex
+		codeCounts = make(map[codes.Code]int)
+		maxRetry   time.Duration
+		latencies  []time.Duration
+		wg         sync.WaitGroup
+	)
+	start := time.Now()
+	for i := 0; i < c.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range calls {
+				callCtx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+				var md metadata.Response
+				began := time.Now()
+				_, err := client.SayHello(callCtx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Hammer"}}, md.CallOptions()...)
+				elapsed := time.Since(began)
+				cancel()
+
+				mu.Lock()
+				codeCounts[status.Code(err)]++
+				if err == nil {
+					latencies = append(latencies, elapsed)
+				}
+				maxRetry = max(maxRetry, md.RetryAfter())
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	fmt.Printf("🔨 %d calls in %s\n", c.requests, time.Since(start).Round(time.Millisecond))
+	seen := make([]codes.Code, 0, len(codeCounts))
+	for code := range codeCounts {
+		seen = append(seen, code)
+	}
+	sort.Slice(seen, func(i, j int) bool { return seen[i] < seen[j] })
+	for _, code := range seen {
+		fmt.Printf("   %-18s %d\n", code, codeCounts[code])
+	}
+	if len(latencies) > 0 {
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		fmt.Printf("   latency of OK calls: p50 %s, p99 %s\n",
+			latencies[len(latencies)/2].Round(time.Microsecond), latencies[len(latencies)*99/100].Round(time.Microsecond))
+	}
+	if maxRetry > 0 {
+		fmt.Printf("   longest retry-after: %s\n", maxRetry)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkHealth demonstrates the standard health API: Check asks once, Watch
+// streams every change in serving status
+func checkHealth(ctx context.Context, health healthpb.HealthClient, service string) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	resp, err := health.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		fatal("Error calling health Check", logging.Err(err))
+	}
+	fmt.Printf("✅ Check %s: %s\n", service, resp.GetStatus())
+
+	watch, err := health.Watch(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		fatal("Error calling health Watch", logging.Err(err))
+	}
+
+	// The first message is the current status; later ones arrive only when
+	// it changes, so stop after one for the demo
+	update, err := watch.Recv()
+	if err != nil {
+		fatal("Error receiving health Watch update", logging.Err(err))
+	}
+	fmt.Printf("✅ Watch %s: %s\n", service, update.GetStatus())
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+)
+
+// defaultMaxRecvMsgSize is the largest message gRPC receives unless
+// -max-recv-msg-size says otherwise
+const defaultMaxRecvMsgSize = 4 << 20
+
+// largeCommand asks for a large greeting in one message or in chunks, to
+// show message size limits
+type largeCommand struct {
+	name   string
+	size   int64
+	chunk  int
+	stream bool
+}
+
+func (c *largeCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.name, "name", "World", "name to greet")
+	fs.Int64Var(&c.size, "size", 5<<20, "payload size in bytes")
+	fs.IntVar(&c.chunk, "chunk", 1<<20, "payload bytes per message with -stream")
+	fs.BoolVar(&c.stream, "stream", false, "receive the payload in chunks over StreamHelloLarge instead of one SayHelloLarge message")
+}
+
+func (c *largeCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	client := pb.NewGreetingServiceClient(conn)
+	req := &pb.SayHelloLargeRequest{Name: c.name, SizeBytes: c.size, ChunkBytes: int32(c.chunk)}
+	if !c.stream {
+		resp, err := client.SayHelloLarge(ctx, req)
+		if err != nil {
+			printStatusDetails(err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ %s: received %d bytes in one message\n", resp.GetMessage(), len(resp.GetPayload()))
+		return
+	}
+
+	message, received, chunks, err := receiveLarge(ctx, client, req)
+	if err != nil {
+		printStatusDetails(err)
+		os.Exit(1)
+	}
+	fmt.Printf("✅ %s: received %d bytes in %d chunks\n", message, received, chunks)
+}
+
+// receiveLarge reads a whole StreamHelloLarge stream, returning the
+// greeting, the payload bytes and the number of messages received
+func receiveLarge(ctx context.Context, client pb.GreetingServiceClient, req *pb.SayHelloLargeRequest) (string, int64, int, error) {
+	stream, err := client.StreamHelloLarge(ctx, req)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	var (
+		message  string
+		received int64
+	)
+	for chunks := 0; ; chunks++ {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return message, received, chunks, nil
+		}
+		if err != nil {
+			return message, received, chunks, err
+		}
+		if resp.GetOffset() != received {
+			return message, received, chunks, fmt.Errorf("chunk at offset %d after %d bytes", resp.GetOffset(), received)
+		}
+		if chunks == 0 {
+			message = resp.GetMessage()
+		}
+		received += int64(len(resp.GetPayload()))
+	}
+}
+
+// demoLarge shows a payload just over the client's receive limit failing
+// as one message and arriving in chunks
+func demoLarge(ctx context.Context, cfg *config.Client, client pb.GreetingServiceClient) {
+	limit := cfg.Messages.MaxRecv
+	if limit <= 0 {
+		limit = defaultMaxRecvMsgSize
+	}
+	req := &pb.SayHelloLargeRequest{Name: "Frank", SizeBytes: int64(limit) + 1<<20, ChunkBytes: 1 << 20}
+
+	fmt.Printf("📦 SayHelloLarge with %d bytes, over the %d byte receive limit...\n", req.GetSizeBytes(), limit)
+	if _, err := client.SayHelloLarge(ctx, req); err != nil {
+		printStatusDetails(err)
+	} else {
+		fatal("Expected SayHelloLarge to exceed the message size limit", "size", req.GetSizeBytes())
+	}
+
+	fmt.Printf("📦 StreamHelloLarge with the same bytes in %d byte chunks...\n", req.GetChunkBytes())
+	message, received, chunks, err := receiveLarge(ctx, client, req)
+	if err != nil {
+		fatal("Error calling StreamHelloLarge", logging.Err(err))
+	}
+	fmt.Printf("✅ %s: received %d bytes in %d chunks\n", message, received, chunks)
+}
@@ -2,65 +2,112 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
-	"io"
 	"log"
-	"time"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
 
-	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-func main() {
-	// Connect to the gRPC server
-	conn, err := grpc.NewClient("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatalf("Failed to connect: %v", err)
-	}
-	defer conn.Close()
+// command is one client subcommand, e.g. `client hello -name Alice`
+type command struct {
+	summary string
+	// flags registers the subcommand's own flags; may be nil
+	flags func(fs *flag.FlagSet)
+	run   func(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn)
+}
 
-	// Create a client
-	client := pb.NewGreetingServiceClient(conn)
+// commands builds the subcommands afresh so each gets its own flag values
+func commands() map[string]command {
+	hello := &helloCommand{}
+	stream := &streamCommand{}
+	hammer := &hammerCommand{}
+	bench := &benchCommand{}
+	fanout := &fanoutCommand{}
+	large := &largeCommand{}
+	history := &historyCommand{}
+	subscribe := &subscribeCommand{}
+	upload := &uploadCommand{}
+	admin := &adminCommand{}
+	batch := &batchCommand{}
+	converse := &converseCommand{}
+	cached := &cachedCommand{}
+	codecs := &codecsCommand{}
+	replay := &replayCommand{}
+	return map[string]command{
+		"demo":      {summary: "run every example call in turn (the default)", run: runDemo},
+		"hello":     {summary: "send one SayHello", flags: hello.register, run: hello.run},
+		"batch":     {summary: "greet several names in one SayHelloBatch, each succeeding or failing on its own", flags: batch.register, run: batch.run},
+		"converse":  {summary: "hold a conversation the server keeps a session for, greeting once per turn", flags: converse.register, run: converse.run},
+		"cached":    {summary: "say hello repeatedly through a client-side cache that serves stale greetings while the server is down", flags: cached.register, run: cached.run},
+		"stream":    {summary: "receive SayHelloMultiple greetings", flags: stream.register, run: stream.run},
+		"large":     {summary: "receive a large payload in one message or in chunks, to show message size limits", flags: large.register, run: large.run},
+		"languages": {summary: "list the languages the server greets in", run: runLanguages},
+		"history":   {summary: "list the greetings the server has recorded, page by page", flags: history.register, run: history.run},
+		"subscribe": {summary: "print greetings as the server pushes them", flags: subscribe.register, run: subscribe.run},
+		"upload":    {summary: "upload a file in chunks over UploadDocument, showing progress", flags: upload.register, run: upload.run},
+		"chat":      {summary: "greet each name typed on stdin over GreetEveryone", run: runChat},
+		"fanout":    {summary: "make several SayHello calls and show which backend served each", flags: fanout.register, run: fanout.run},
+		"hammer":    {summary: "fire many SayHello calls at once to show rate limiting", flags: hammer.register, run: hammer.run},
+		"bench":     {summary: "load the server with concurrent calls and report throughput and latency percentiles", flags: bench.register, run: bench.run},
+		"codecs":    {summary: "compare the proto and JSON codecs: encoding cost, message size and round trip latency", flags: codecs.register, run: codecs.run},
+		"replay":    {summary: "send the calls of a -record recording again, with their original pacing", flags: replay.register, run: replay.run},
+		"admin":     {summary: "change the log level or chaos, drain, or show the config of a server run with -admin", flags: admin.register, run: admin.run},
+	}
+}
 
-	log.Println("🚀 gRPC Client started...")
-	log.Println("=" + string(make([]byte, 50)) + "=")
+func main() {
+	name, args := "demo", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
 
-	// Example 1: Simple unary RPC call
-	fmt.Println("\n📞 Making simple SayHello call...")
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	cmds := commands()
+	cmd, ok := cmds[name]
+	if !ok {
+		usage(cmds)
+		if name == "help" {
+			return
+		}
+		os.Exit(2)
+	}
 
-	response, err := client.SayHello(ctx, &pb.HelloRequest{Name: "Alice"})
+	cfg, err := config.LoadClientCommand(name, args, cmd.flags)
 	if err != nil {
-		log.Fatalf("Error calling SayHello: %v", err)
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if err := logging.Setup(os.Stderr, cfg.Logging.Format, cfg.Logging.Level); err != nil {
+		log.Fatalf("Failed to configure logging: %v", err)
 	}
 
-	fmt.Printf("✅ Response: %s\n", response.GetMessage())
-	fmt.Printf("   Count: %d\n", response.GetCount())
+	conn, closeConn := connect(cfg)
+	defer closeConn()
+	cmd.run(callContext(cfg), cfg, conn)
+}
 
-	// Example 2: Server streaming RPC call
-	fmt.Println("\n📡 Making streaming SayHelloMultiple call...")
-	stream, err := client.SayHelloMultiple(context.Background(), &pb.HelloRequest{Name: "Bob"})
-	if err != nil {
-		log.Fatalf("Error calling SayHelloMultiple: %v", err)
+func usage(cmds map[string]command) {
+	names := make([]string, 0, len(cmds))
+	for name := range cmds {
+		names = append(names, name)
 	}
+	sort.Strings(names)
 
-	// Receive streaming responses
-	for {
-		response, err := stream.Recv()
-		if err == io.EOF {
-			// Stream has ended
-			fmt.Println("\n✅ Streaming complete!")
-			break
-		}
-		if err != nil {
-			log.Fatalf("Error receiving stream: %v", err)
-		}
-
-		fmt.Printf("📨 Received: %s (Count: %d)\n", response.GetMessage(), response.GetCount())
+	fmt.Fprintln(os.Stderr, "Usage: client [command] [flags]")
+	fmt.Fprintln(os.Stderr, "\nCommands:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, cmds[name].summary)
 	}
+	fmt.Fprintln(os.Stderr, "\nRun `client <command> -h` for the flags of a command.")
+}
 
-	fmt.Println("\n" + string(make([]byte, 50)))
-	log.Println("✅ Client finished successfully!")
+// fatal logs msg at error level and exits, like log.Fatal
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
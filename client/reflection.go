@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+
+	"google.golang.org/grpc"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+)
+
+// listServices asks the server's reflection service which services it
+// exposes, the same call grpcurl makes for "grpcurl list"
+func listServices(ctx context.Context, conn *grpc.ClientConn) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		fatal("Error calling ServerReflectionInfo", logging.Err(err))
+	}
+	if err := stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}); err != nil {
+		fatal("Error sending reflection request", logging.Err(err))
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		fatal("Error receiving reflection response (is the server running with -reflection?)", logging.Err(err))
+	}
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		fmt.Printf("📋 %s\n", svc.GetName())
+	}
+	stream.CloseSend()
+}
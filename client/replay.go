@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/record"
+	"google.golang.org/grpc"
+)
+
+// replayCommand sends the calls recorded with -record again, e.g.
+// `client replay -speed 2 calls.jsonl`
+type replayCommand struct {
+	speed float64
+}
+
+func (c *replayCommand) register(fs *flag.FlagSet) {
+	fs.Float64Var(&c.speed, "speed", 1, "pace of the replay relative to the recording, e.g. 2 for twice as fast (0 sends every call at once)")
+}
+
+// run replays the recording and exits non-zero if any call ended with
+// another status code than it was recorded with
+func (c *replayCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	if len(cfg.Args) != 1 {
+		fatal("Usage: client replay [flags] <recording>")
+	}
+	f, err := os.Open(cfg.Args[0])
+	if err != nil {
+		fatal("Failed to open the recording", logging.Err(err))
+	}
+	calls, err := record.Read(f)
+	f.Close()
+	if err != nil {
+		fatal("Failed to read the recording", "file", cfg.Args[0], logging.Err(err))
+	}
+
+	fmt.Printf("🔁 Replaying %d call(s) from %s\n", len(calls), cfg.Args[0])
+	start := time.Now()
+	differed := 0
+	replayer := record.Replayer{Conn: conn, Speed: c.speed, Slack: cfg.Timeout}
+	err = replayer.Replay(ctx, calls, func(r record.Result) {
+		mark := "✅"
+		if !r.Matches() {
+			mark = "⚠️ "
+			differed++
+		}
+		fmt.Printf("%s %s %s in %s, %d response(s) (recorded %s in %s)\n", mark, r.Call.Method, r.Code(),
+			r.Elapsed.Round(time.Microsecond), r.Responses, r.Call.Code, r.Call.Duration().Round(time.Microsecond))
+		if r.Err != nil && !r.Matches() {
+			fmt.Printf("   %v\n", r.Err)
+		}
+	})
+	if err != nil {
+		fatal("Replay interrupted", logging.Err(err))
+	}
+	fmt.Printf("🏁 Replayed %d call(s) in %s; %d ended differently\n", len(calls), time.Since(start).Round(time.Millisecond), differed)
+	if differed > 0 {
+		os.Exit(1)
+	}
+}
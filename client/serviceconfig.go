@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+	"google.golang.org/grpc"
+)
+
+// Retry modes selected with -retry-mode
+const (
+	retryModeInterceptor   = "interceptor"
+	retryModeServiceConfig = "service-config"
+)
+
+// serviceConfig is the subset of the gRPC service config
+// (https://github.com/grpc/grpc/blob/master/doc/service_config.md) the
+// client sets
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig,omitempty"`
+	MethodConfig        []methodConfig        `json:"methodConfig"`
+}
+
+type methodConfig struct {
+	Name         []methodName `json:"name"`
+	WaitForReady bool         `json:"waitForReady"`
+	Timeout      string       `json:"timeout,omitempty"`
+	RetryPolicy  *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// methodName selects one method, or every method of Service when Method
+// is empty
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// greetingServiceConfig returns the service config for -retry-mode
+// service-config: gRPC itself retries GreetingService calls with the
+// -retry-* settings, every call waits for the connection to be ready
+// instead of failing fast, and unary calls get -timeout as deadline.
+// Streams get no timeout, as they run as long as they need. roundRobin
+// keeps the round_robin balancer a balanced connection needs, since this
+// config replaces the one pool.Dial sets.
+func greetingServiceConfig(cfg *config.Client, policy interceptors.RetryPolicy, roundRobin bool) (string, error) {
+	// gRPC rejects retry policies of fewer than two attempts, and quietly
+	// caps them at five
+	var retry *retryPolicy
+	if policy.MaxAttempts > 1 {
+		retry = &retryPolicy{
+			MaxAttempts:          policy.MaxAttempts,
+			InitialBackoff:       seconds(policy.InitialBackoff),
+			MaxBackoff:           seconds(policy.MaxBackoff),
+			BackoffMultiplier:    policy.Multiplier,
+			RetryableStatusCodes: []string{"UNAVAILABLE", "DEADLINE_EXCEEDED"},
+		}
+	}
+
+	sc := serviceConfig{}
+	if roundRobin {
+		sc.LoadBalancingConfig = []map[string]struct{}{{"round_robin": {}}}
+	}
+	services := methodConfig{WaitForReady: true, RetryPolicy: retry}
+	unary := methodConfig{WaitForReady: true, Timeout: seconds(cfg.Timeout), RetryPolicy: retry}
+	for _, desc := range []grpc.ServiceDesc{pb.GreetingService_ServiceDesc, pbv2.GreetingServiceV2_ServiceDesc} {
+		// A method's own entry wins over its service's
+		services.Name = append(services.Name, methodName{Service: desc.ServiceName})
+		for _, m := range desc.Methods {
+			unary.Name = append(unary.Name, methodName{Service: desc.ServiceName, Method: m.MethodName})
+		}
+	}
+	sc.MethodConfig = []methodConfig{unary, services}
+
+	b, err := json.Marshal(sc)
+	return string(b), err
+}
+
+// seconds formats d as the service config's duration format, e.g. "0.1s"
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
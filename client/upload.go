@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+)
+
+// maxUploadChunk is the largest chunk an UploadDocumentRequest may carry
+const maxUploadChunk = 1 << 20
+
+// uploadCommand streams a file to UploadDocument in chunks, showing
+// progress as it goes
+type uploadCommand struct {
+	file  string
+	chunk int
+}
+
+func (c *uploadCommand) register(fs *flag.FlagSet) {
+	fs.StringVar(&c.file, "file", "", "path of the file to upload (required)")
+	fs.IntVar(&c.chunk, "chunk", 64<<10, "bytes per message, at most 1MiB")
+}
+
+func (c *uploadCommand) run(ctx context.Context, cfg *config.Client, conn *grpc.ClientConn) {
+	if c.file == "" {
+		fmt.Fprintln(os.Stderr, "upload: -file is required")
+		os.Exit(2)
+	}
+	if c.chunk <= 0 || c.chunk > maxUploadChunk {
+		fmt.Fprintf(os.Stderr, "upload: -chunk must be between 1 and %d\n", maxUploadChunk)
+		os.Exit(2)
+	}
+	f, err := os.Open(c.file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "upload: %v\n", err)
+		os.Exit(1)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "upload: %v\n", err)
+		os.Exit(1)
+	}
+
+	// No -timeout here: an upload takes as long as the file needs
+	resp, sum, err := uploadDocument(ctx, pb.NewGreetingServiceClient(conn), f, info.Size(), c.chunk)
+	if err != nil {
+		fmt.Println()
+		printStatusDetails(err)
+		os.Exit(1)
+	}
+	fmt.Printf("\n✅ Uploaded %s as %s: %d bytes, sha256 %s\n", filepath.Base(c.file), resp.GetId(), resp.GetSizeBytes(), resp.GetSha256())
+	if resp.GetSha256() != sum {
+		fmt.Printf("❌ Checksum mismatch: sent %s\n", sum)
+		os.Exit(1)
+	}
+	fmt.Println("   Checksum matches the local file")
+}
+
+// uploadDocument sends the document info, then r in chunks of chunk bytes,
+// printing progress against size. It returns the server's response and the
+// sha256 of what was sent.
+func uploadDocument(ctx context.Context, client pb.GreetingServiceClient, r io.Reader, size int64, chunk int) (*pb.UploadDocumentResponse, string, error) {
+	stream, err := client.UploadDocument(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	name := "document"
+	if f, ok := r.(*os.File); ok {
+		name = filepath.Base(f.Name())
+	}
+	err = stream.Send(&pb.UploadDocumentRequest{Data: &pb.UploadDocumentRequest_Info{Info: &pb.DocumentInfo{
+		Filename:    name,
+		ContentType: mime.TypeByExtension(filepath.Ext(name)),
+		SizeBytes:   size,
+	}}})
+	if err != nil {
+		// The server's reason arrives with CloseAndRecv
+		_, err = stream.CloseAndRecv()
+		return nil, "", err
+	}
+
+	hash := sha256.New()
+	buf := make([]byte, chunk)
+	var sent int64
+	printProgress(sent, size)
+	for {
+		n, readErr := r.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.UploadDocumentRequest{Data: &pb.UploadDocumentRequest_Chunk{Chunk: buf[:n]}}); err != nil {
+				_, err = stream.CloseAndRecv()
+				return nil, "", err
+			}
+			hash.Write(buf[:n])
+			sent += int64(n)
+			printProgress(sent, size)
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return nil, "", readErr
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	return resp, hex.EncodeToString(hash.Sum(nil)), err
+}
+
+// printProgress redraws the upload progress line
+func printProgress(sent, size int64) {
+	percent := int64(100)
+	if size > 0 {
+		percent = sent * 100 / size
+	}
+	fmt.Printf("\r📤 %d / %d bytes (%d%%)", sent, size, percent)
+}
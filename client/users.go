@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	userpb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/user"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// demoUsers walks one user through its whole life cycle. The update sends
+// a field mask so only the display name changes, even though the request
+// carries an empty email.
+func demoUsers(baseCtx context.Context, cfg *config.Client, client userpb.UserServiceClient) {
+	ctx, cancel := context.WithTimeout(baseCtx, cfg.Timeout)
+	defer cancel()
+
+	u, err := client.CreateUser(ctx, &userpb.CreateUserRequest{User: &userpb.User{
+		Name:        "dana",
+		Email:       "dana@example.com",
+		DisplayName: "Dana",
+	}})
+	if err != nil {
+		fatal("Error calling CreateUser", logging.Err(err))
+	}
+	fmt.Printf("✅ Created user %d: %s <%s>\n", u.GetId(), u.GetDisplayName(), u.GetEmail())
+
+	u, err = client.UpdateUser(ctx, &userpb.UpdateUserRequest{
+		User:       &userpb.User{Id: u.GetId(), DisplayName: "Dana Scully"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	if err != nil {
+		fatal("Error calling UpdateUser", logging.Err(err))
+	}
+	fmt.Printf("✅ Updated display_name only: %s <%s>\n", u.GetDisplayName(), u.GetEmail())
+
+	list, err := client.ListUsers(ctx, &userpb.ListUsersRequest{})
+	if err != nil {
+		fatal("Error calling ListUsers", logging.Err(err))
+	}
+	fmt.Printf("✅ %d user(s) on the server\n", len(list.GetUsers()))
+
+	if _, err := client.DeleteUser(ctx, &userpb.DeleteUserRequest{Id: u.GetId()}); err != nil {
+		fatal("Error calling DeleteUser", logging.Err(err))
+	}
+	_, err = client.GetUser(ctx, &userpb.GetUserRequest{Id: u.GetId()})
+	fmt.Print("✅ Deleted; reading it again fails as expected: ")
+	printStatusDetails(err)
+}
@@ -0,0 +1,38 @@
+//go:build xds
+
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/xds" // Register the xds resolver and balancers
+)
+
+// xdsDialOptions returns the options for dialing an xds:/// address. With
+// -xds-server the bootstrap naming the control plane is built here;
+// otherwise gRPC reads the one $GRPC_XDS_BOOTSTRAP points to.
+func xdsDialOptions(cfg *config.Client) ([]grpc.DialOption, error) {
+	if cfg.XDSServer == "" {
+		return nil, nil
+	}
+	bootstrap, err := json.Marshal(map[string]any{
+		"xds_servers": []map[string]any{{
+			"server_uri":      cfg.XDSServer,
+			"channel_creds":   []map[string]string{{"type": "insecure"}},
+			"server_features": []string{"xds_v3"},
+		}},
+		"node": map[string]string{"id": "greeter-client"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// gRPC only reads the global bootstrap at startup, so the one built
+	// here goes to a resolver of this connection's own
+	resolver, err := xds.NewXDSResolverWithConfigForTesting(bootstrap)
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.DialOption{grpc.WithResolvers(resolver)}, nil
+}
@@ -0,0 +1,16 @@
+//go:build !xds
+
+package main
+
+import (
+	"errors"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"google.golang.org/grpc"
+)
+
+// xdsDialOptions fails: xDS support pulls in much of Envoy's API, so it is
+// only built into clients built with -tags xds
+func xdsDialOptions(*config.Client) ([]grpc.DialOption, error) {
+	return nil, errors.New("xds:/// addresses need a client built with -tags xds")
+}
@@ -0,0 +1,212 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+)
+
+// ClientTLS holds the client's TLS settings
+type ClientTLS struct {
+	Enabled bool
+	// CAFile verifies the server; the system roots are used when empty
+	CAFile string
+	// CertFile and KeyFile are only needed for mutual TLS
+	CertFile   string
+	KeyFile    string
+	ServerName string
+}
+
+func (t *ClientTLS) register(fs *flag.FlagSet) {
+	fs.BoolVar(&t.Enabled, "tls", false, "connect over TLS")
+	fs.StringVar(&t.CAFile, "tls-ca", "", "CA PEM file used to verify the server (system roots when empty)")
+	fs.StringVar(&t.CertFile, "tls-cert", "", "client certificate PEM file for mutual TLS")
+	fs.StringVar(&t.KeyFile, "tls-key", "", "client private key PEM file for mutual TLS")
+	fs.StringVar(&t.ServerName, "tls-server-name", "", "override the server name verified against its certificate")
+}
+
+// Connect configures how the client connects: the exponential backoff
+// between connection attempts, growing from BackoffBase by BackoffMultiplier
+// up to BackoffMax, and whether calls wait for the connection to be ready
+// instead of failing at once with Unavailable while it is down
+type Connect struct {
+	BackoffBase       time.Duration
+	BackoffMultiplier float64
+	BackoffMax        time.Duration
+	WaitForReady      bool
+}
+
+func (c *Connect) register(fs *flag.FlagSet) {
+	fs.DurationVar(&c.BackoffBase, "dial-backoff-base", backoff.DefaultConfig.BaseDelay, "wait after the first failed connection attempt")
+	fs.Float64Var(&c.BackoffMultiplier, "dial-backoff-multiplier", backoff.DefaultConfig.Multiplier, "factor the wait between connection attempts grows by after each failure")
+	fs.DurationVar(&c.BackoffMax, "dial-backoff-max", backoff.DefaultConfig.MaxDelay, "longest wait between connection attempts")
+	fs.BoolVar(&c.WaitForReady, "wait-for-ready", false, "make calls wait, up to their deadline, for the server to be reachable instead of failing with Unavailable")
+}
+
+// DialOptions returns the gRPC dial options applying the backoff and, with
+// WaitForReady, making every call wait for the connection
+func (c Connect) DialOptions() []grpc.DialOption {
+	cfg := backoff.DefaultConfig
+	cfg.BaseDelay = c.BackoffBase
+	cfg.Multiplier = c.BackoffMultiplier
+	cfg.MaxDelay = c.BackoffMax
+	opts := []grpc.DialOption{grpc.WithConnectParams(grpc.ConnectParams{
+		Backoff: cfg,
+		// Zero would cut each attempt off after the backoff delay
+		MinConnectTimeout: defaultMinConnectTimeout,
+	})}
+	if c.WaitForReady {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	}
+	return opts
+}
+
+// defaultMinConnectTimeout is how long gRPC gives a connection attempt
+// unless told otherwise
+const defaultMinConnectTimeout = 20 * time.Second
+
+// Client is the configuration of the client binary
+type Client struct {
+	// Command is the subcommand being run, such as "hello" or "demo", and
+	// Args the arguments following its flags
+	Command string
+	Args    []string
+
+	Addr       string
+	RoundRobin bool
+	// XDSServer is the control plane xds:/// addresses are resolved
+	// through, instead of the bootstrap GRPC_XDS_BOOTSTRAP names
+	XDSServer         string
+	Timeout           time.Duration
+	Metadata          Metadata
+	MaxHeaderListSize uint
+	ResponseEncoding  string
+	Compress          string
+	Codec             string
+	LogPayloads       bool
+	DebugJSON         bool
+	RecordFile        string
+	StreamOut         string
+	ListServices      bool
+	MetricsAddr       string
+
+	// TenantID, UserID and Baggage are request-scoped values sent with
+	// every call, as requestcontext carries them
+	TenantID string
+	UserID   string
+	Baggage  string
+	// Priority is sent as the x-priority header of every call
+	Priority string
+
+	AuthToken  string
+	AuthSecret string
+	// AuthRoles are granted by the token minted from AuthSecret
+	AuthRoles []string
+
+	// RetryMode is "interceptor" or "service-config"
+	RetryMode           string
+	RetryMaxAttempts    int
+	RetryInitialBackoff time.Duration
+	RetryMaxBackoff     time.Duration
+
+	HedgeDelay    time.Duration
+	HedgeAttempts int
+
+	// Breaker* configure the circuit breaker; a zero BreakerFailureRate
+	// disables it
+	BreakerFailureRate float64
+	BreakerWindow      int
+	BreakerOpenFor     time.Duration
+	BreakerProbes      int
+
+	// ReconnectTimeout is how long an interrupted SayHelloMultiple stream
+	// waits for the connection to come back before giving up
+	ReconnectTimeout time.Duration
+
+	Logging   Logging
+	Messages  MessageSize
+	Keepalive Keepalive
+	Connect   Connect
+	TLS       ClientTLS
+}
+
+// LoadClient reads the client configuration from args (usually
+// os.Args[1:]) and the environment
+func LoadClient(args []string) (*Client, error) {
+	return LoadClientCommand("", args, nil)
+}
+
+// LoadClientCommand reads the configuration of the client subcommand
+// command from args and the environment. register, when not nil, adds the
+// subcommand's own flags alongside the shared ones.
+func LoadClientCommand(command string, args []string, register func(fs *flag.FlagSet)) (*Client, error) {
+	c := &Client{Command: command}
+	fs := flag.NewFlagSet(strings.TrimSpace("client "+command), flag.ExitOnError)
+
+	fs.StringVar(&c.Addr, "addr", "localhost:50051", "server address to connect to (host:port, unix:///path/to.sock or any gRPC target), or a comma separated list to balance across")
+	fs.BoolVar(&c.RoundRobin, "round-robin", false, "balance calls round-robin across every address -addr resolves to, e.g. with dns:///host:port")
+	fs.StringVar(&c.XDSServer, "xds-server", "", "xDS control plane resolving xds:///name addresses, e.g. localhost:18000 (needs a client built with -tags xds; defaults to the $GRPC_XDS_BOOTSTRAP file)")
+	fs.DurationVar(&c.Timeout, "timeout", 5*time.Second, "deadline for each unary call")
+	fs.Var(&c.Metadata, "metadata", "extra key=value header sent with every call; may be repeated")
+	fs.UintVar(&c.MaxHeaderListSize, "max-header-list-size", 0, "maximum total size in bytes of response headers the client accepts (0 uses the gRPC default)")
+	fs.StringVar(&c.ResponseEncoding, "response-encoding", "", "ask the server to compress responses with this encoding (identity or gzip)")
+	fs.StringVar(&c.Compress, "compress", "", "compress requests with this compressor (gzip); the server answers in kind")
+	fs.StringVar(&c.Codec, "codec", "proto", "encode messages with this codec, proto or json, sent as the content-subtype; the server answers in kind")
+	fs.BoolVar(&c.LogPayloads, "log-payload-sizes", false, "log each message's size before and after compression")
+	fs.BoolVar(&c.DebugJSON, "debug-json", false, "print every message sent and received, and errors, as indented JSON on stderr")
+	fs.StringVar(&c.RecordFile, "record", "", "record every call, its requests and responses, as a JSON line in this file, to send again with \"client replay <file>\"")
+	fs.BoolVar(&c.ListServices, "list-services", false, "list the server's services through the reflection API first (server needs -reflection)")
+	fs.StringVar(&c.MetricsAddr, "metrics-addr", "", "serve client-side Prometheus metrics on http://<addr>/metrics while the client runs")
+	fs.StringVar(&c.StreamOut, "stream-out", "", "also write SayHelloMultiple messages to this file, one per line (\"-\" for stdout)")
+
+	fs.StringVar(&c.TenantID, "tenant-id", "", "tenant every call is made for, sent in the x-tenant-id header")
+	fs.StringVar(&c.UserID, "caller-id", "", "user every call is made for, sent in the user-id header (-user-id picks who hello greets)")
+	fs.StringVar(&c.Baggage, "baggage", "", "W3C baggage sent with every call, e.g. \"region=eu,plan=free\"")
+	fs.StringVar(&c.Priority, "priority", "", "x-priority header sent with every call, high, normal or low; servers queueing calls let higher priorities go first")
+
+	fs.StringVar(&c.AuthToken, "auth-token", "", "bearer token sent with every call")
+	fs.StringVar(&c.AuthSecret, "auth-secret", "", "mint a bearer token signed with this secret instead of passing -auth-token")
+	fs.Func("auth-roles", "comma separated roles granted by the token minted with -auth-secret, e.g. admin", func(value string) error {
+		c.AuthRoles = strings.Split(value, ",")
+		return nil
+	})
+
+	fs.StringVar(&c.RetryMode, "retry-mode", "interceptor", "who retries failed calls: interceptor (the client's retry interceptor) or service-config (gRPC itself, from a service config that also sets waitForReady and unary timeouts)")
+	fs.IntVar(&c.RetryMaxAttempts, "retry-max-attempts", 3, "total tries for unary calls failing with Unavailable or DeadlineExceeded")
+	fs.DurationVar(&c.RetryInitialBackoff, "retry-initial-backoff", 100*time.Millisecond, "wait before the first retry; doubles on each further retry")
+	fs.DurationVar(&c.RetryMaxBackoff, "retry-max-backoff", 2*time.Second, "maximum wait between retries")
+
+	fs.DurationVar(&c.HedgeDelay, "hedge-delay", 0, "send another copy of a SayHello that hasn't answered after this long and take the first reply (0 disables hedging)")
+	fs.IntVar(&c.HedgeAttempts, "hedge-attempts", 2, "most copies of one hedged SayHello in flight, the original included")
+
+	fs.Float64Var(&c.BreakerFailureRate, "breaker-failure-rate", 0, "open the circuit breaker, failing calls at once with Unavailable, once this fraction (0-1) of the latest calls fail (0 disables the breaker)")
+	fs.IntVar(&c.BreakerWindow, "breaker-window", 20, "number of latest calls the circuit breaker judges the failure rate over")
+	fs.DurationVar(&c.BreakerOpenFor, "breaker-open-for", 5*time.Second, "how long the circuit breaker stays open before letting probe calls through")
+	fs.IntVar(&c.BreakerProbes, "breaker-probes", 1, "probe calls that must succeed, half-open, for the circuit breaker to close")
+
+	fs.DurationVar(&c.ReconnectTimeout, "reconnect-timeout", 30*time.Second, "how long a SayHelloMultiple stream cut off by a server restart waits to reconnect and resume (0 gives up at once)")
+
+	c.Logging.register(fs)
+	c.Messages.register(fs)
+	c.Keepalive.register(fs)
+	c.Connect.register(fs)
+	c.TLS.register(fs)
+	if register != nil {
+		register(fs)
+	}
+
+	if err := parse(fs, args); err != nil {
+		return nil, err
+	}
+	// HTTP/2 carries the header list size in 32 bits
+	if c.MaxHeaderListSize > math.MaxUint32 {
+		return nil, errors.New("-max-header-list-size must fit in 32 bits")
+	}
+	c.Args = fs.Args()
+	return c, nil
+}
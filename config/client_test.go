@@ -0,0 +1,19 @@
+package config
+
+import "testing"
+
+func TestLoadClientMaxHeaderListSize(t *testing.T) {
+	tests := []struct {
+		size    string
+		wantErr bool
+	}{
+		{"4294967295", false},
+		{"4294967296", true},
+	}
+	for _, tt := range tests {
+		_, err := LoadClient([]string{"-max-header-list-size", tt.size})
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("LoadClient(-max-header-list-size %s) = %v, want error: %t", tt.size, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Package config holds the settings shared by the server and client
+// binaries. Every setting is a command line flag that can also be supplied
+// through an environment variable named GREETER_<FLAG>, e.g. -tls-cert can
+// be set with GREETER_TLS_CERT. Flags win over the environment.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// EnvPrefix is prepended to flag names to form environment variable names
+const EnvPrefix = "GREETER_"
+
+// EnvName returns the environment variable that sets the named flag
+func EnvName(flagName string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// hiddenFlags are debugging flags left out of -help
+var hiddenFlags = map[string]bool{"panic-on": true}
+
+// parse parses args into fs, then fills every flag not given on the
+// command line from its environment variable
+func parse(fs *flag.FlagSet, args []string) error {
+	fs.VisitAll(func(f *flag.Flag) {
+		f.Usage = fmt.Sprintf("%s [$%s]", f.Usage, EnvName(f.Name))
+	})
+	fs.Usage = func() { usage(fs) }
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if set[f.Name] || err != nil {
+			return
+		}
+		if value, ok := os.LookupEnv(EnvName(f.Name)); ok {
+			if setErr := fs.Set(f.Name, value); setErr != nil {
+				err = fmt.Errorf("invalid value %q for %s: %w", value, EnvName(f.Name), setErr)
+			}
+		}
+	})
+	return err
+}
+
+// usage prints the help of fs without the hidden flags
+func usage(fs *flag.FlagSet) {
+	fmt.Fprintf(fs.Output(), "Usage of %s:\n", fs.Name())
+	shown := flag.NewFlagSet(fs.Name(), flag.ContinueOnError)
+	shown.SetOutput(fs.Output())
+	fs.VisitAll(func(f *flag.Flag) {
+		if !hiddenFlags[f.Name] {
+			shown.Var(f.Value, f.Name, f.Usage)
+			shown.Lookup(f.Name).DefValue = f.DefValue
+		}
+	})
+	shown.PrintDefaults()
+}
+
+// MessageSize limits the size of gRPC messages. Zero keeps the gRPC
+// default (4 MiB received, unlimited sent).
+type MessageSize struct {
+	MaxRecv int
+	MaxSend int
+}
+
+func (m *MessageSize) register(fs *flag.FlagSet) {
+	fs.IntVar(&m.MaxRecv, "max-recv-msg-size", 0, "maximum size in bytes of a received message (0 uses the gRPC default)")
+	fs.IntVar(&m.MaxSend, "max-send-msg-size", 0, "maximum size in bytes of a sent message (0 uses the gRPC default)")
+}
+
+// ServerOptions returns the gRPC server options applying the limits
+func (m MessageSize) ServerOptions() []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if m.MaxRecv > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(m.MaxRecv))
+	}
+	if m.MaxSend > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(m.MaxSend))
+	}
+	return opts
+}
+
+// DialOptions returns the gRPC dial options applying the limits to every
+// call
+func (m MessageSize) DialOptions() []grpc.DialOption {
+	var callOpts []grpc.CallOption
+	if m.MaxRecv > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(m.MaxRecv))
+	}
+	if m.MaxSend > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(m.MaxSend))
+	}
+	if len(callOpts) == 0 {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithDefaultCallOptions(callOpts...)}
+}
+
+// Logging selects how the binaries write their logs
+type Logging struct {
+	// Format is "text" or "json"
+	Format string
+	// Level is the lowest level shown: debug, info, warn or error
+	Level string
+}
+
+func (l *Logging) register(fs *flag.FlagSet) {
+	fs.StringVar(&l.Format, "log-format", "text", "log line format: text or json")
+	fs.StringVar(&l.Level, "log-level", "info", "lowest level logged: debug, info, warn or error")
+}
+
+// Keepalive configures HTTP/2 keepalive pings. Time is how long a
+// connection may be idle before a ping is sent and Timeout how long to wait
+// for the ping ack before closing it. Zero keeps the gRPC default.
+//
+// PermitWithoutStream lets clients ping while no RPC is active. On the
+// server, MinTime is the shortest ping interval it tolerates; clients
+// pinging more often are disconnected with "too_many_pings".
+type Keepalive struct {
+	Time                time.Duration
+	Timeout             time.Duration
+	PermitWithoutStream bool
+	MinTime             time.Duration
+}
+
+func (k *Keepalive) register(fs *flag.FlagSet) {
+	fs.DurationVar(&k.Time, "keepalive-time", 0, "idle time before sending a keepalive ping (0 uses the gRPC default)")
+	fs.DurationVar(&k.Timeout, "keepalive-timeout", 0, "how long to wait for a keepalive ping ack before closing the connection (0 uses the gRPC default)")
+	fs.BoolVar(&k.PermitWithoutStream, "keepalive-permit-without-stream", false, "allow keepalive pings on connections without active RPCs")
+}
+
+// registerEnforcement adds the server-only enforcement policy flags
+func (k *Keepalive) registerEnforcement(fs *flag.FlagSet) {
+	fs.DurationVar(&k.MinTime, "keepalive-min-time", 0, "shortest client ping interval tolerated before closing the connection (0 uses the gRPC default of 5m)")
+}
+
+// ServerOptions returns the gRPC server options applying the keepalive
+// parameters and enforcement policy
+func (k Keepalive) ServerOptions() []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if k.Time != 0 || k.Timeout != 0 {
+		opts = append(opts, grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    k.Time,
+			Timeout: k.Timeout,
+		}))
+	}
+	if k.MinTime != 0 || k.PermitWithoutStream {
+		opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             k.MinTime,
+			PermitWithoutStream: k.PermitWithoutStream,
+		}))
+	}
+	return opts
+}
+
+// DialOptions returns the gRPC dial options applying the keepalive
+// parameters
+func (k Keepalive) DialOptions() []grpc.DialOption {
+	if k.Time == 0 && k.Timeout == 0 {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithKeepaliveParams(keepalive.ClientParameters{
+		Time:                k.Time,
+		Timeout:             k.Timeout,
+		PermitWithoutStream: k.PermitWithoutStream,
+	})}
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata is a repeatable key=value flag holding extra headers the client
+// sends with every call
+type Metadata struct {
+	md metadata.MD
+}
+
+// String implements flag.Value
+func (m *Metadata) String() string {
+	var pairs []string
+	for key, values := range m.md {
+		for _, v := range values {
+			pairs = append(pairs, key+"="+v)
+		}
+	}
+	return strings.Join(pairs, ",")
+}
+
+// Set implements flag.Value, adding one key=value pair
+func (m *Metadata) Set(pair string) error {
+	key, value, ok := strings.Cut(pair, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("want key=value, got %q", pair)
+	}
+	if m.md == nil {
+		m.md = metadata.MD{}
+	}
+	m.md.Append(key, value)
+	return nil
+}
+
+// Outgoing returns ctx with the pairs added to its outgoing metadata
+func (m *Metadata) Outgoing(ctx context.Context) context.Context {
+	if len(m.md) == 0 {
+		return ctx
+	}
+	return metadata.NewOutgoingContext(ctx, metadata.Join(m.md.Copy(), outgoing(ctx)))
+}
+
+func outgoing(ctx context.Context) metadata.MD {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return md
+}
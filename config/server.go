@@ -0,0 +1,274 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"math"
+	"strings"
+	"time"
+)
+
+// ServerTLS holds the server's TLS settings
+type ServerTLS struct {
+	Enabled  bool
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables mutual TLS when set
+	ClientCAFile string
+	// GenerateDir, when set, receives freshly generated self-signed
+	// certificates that the server then uses
+	GenerateDir string
+}
+
+func (t *ServerTLS) register(fs *flag.FlagSet) {
+	fs.BoolVar(&t.Enabled, "tls", false, "serve over TLS")
+	fs.StringVar(&t.CertFile, "tls-cert", "", "server certificate PEM file")
+	fs.StringVar(&t.KeyFile, "tls-key", "", "server private key PEM file")
+	fs.StringVar(&t.ClientCAFile, "tls-client-ca", "", "CA PEM file used to verify client certificates; enables mutual TLS")
+	fs.StringVar(&t.GenerateDir, "tls-generate", "", "generate a self-signed CA, server and client certificates into this directory and serve with them")
+}
+
+// Server is the configuration of the server binary
+type Server struct {
+	Addr          string
+	InstanceName  string
+	ReusePort     bool
+	ListenBacklog int
+	DrainTimeout  time.Duration
+	Debug         bool
+	Reflection    bool
+	LogPings      bool
+	LogPayloads   bool
+	MetricsAddr   string
+	DebugAddr     string
+	GatewayAddr   string
+	// GRPCWebAddr serves gRPC-Web to browsers; pages from GRPCWebOrigins
+	// ("*" for any) may call it across origins
+	GRPCWebAddr    string
+	GRPCWebOrigins []string
+	StorePath      string
+	Multiplex      bool
+
+	// EventsURL names the broker, as events.Open takes it, a
+	// GreetingCreated event is published to on EventsTopic for every
+	// greeting; empty publishes none
+	EventsURL   string
+	EventsTopic string
+
+	GRPCLogSeverity  string
+	GRPCLogVerbosity int
+
+	MaxHeaderListSize     uint
+	MaxConcurrentRequests int
+	QueueSize             int
+	// PriorityWeights shares the admission queue's freed slots among the
+	// priorities waiting, e.g. "high=8,normal=4,low=1"
+	PriorityWeights string
+
+	// MaxConnsPerPeer caps the connections open from one peer IP and
+	// MaxStreamsPerConn the streams open on one connection; zero is
+	// unlimited
+	MaxConnsPerPeer   int
+	MaxStreamsPerConn uint
+
+	StreamDelay       time.Duration
+	StreamRampUp      int
+	StreamMaxCount    int
+	StreamMaxInterval time.Duration
+
+	// BatchConcurrency is how many names of a SayHelloBatch are greeted
+	// at once
+	BatchConcurrency int
+
+	// Workers generate streamed greetings, with up to WorkerQueueSize
+	// waiting; zero generates them on each call's own goroutine
+	Workers         int
+	WorkerQueueSize int
+
+	FailRate float64
+	Chaos    string
+
+	// MethodTimeouts caps how long methods may run, as parsed by
+	// interceptors.ParseTimeouts; SlowCallThreshold warns about slow
+	// unary calls
+	MethodTimeouts    string
+	SlowCallThreshold time.Duration
+
+	// AuditLog is the file, or "-" for stdout, every call is recorded in;
+	// empty disables the audit log. The file is rotated at AuditMaxSize
+	// bytes, keeping AuditMaxBackups old ones, and request fields are
+	// redacted by AuditRedact, as parsed by audit.ParseRedactions.
+	AuditLog        string
+	AuditMaxSize    int64
+	AuditMaxBackups int
+	AuditRedact     string
+
+	// DebugJSON prints every message calls exchange as indented JSON on
+	// stderr, with the fields DebugJSONRedact names redacted, as parsed by
+	// audit.ParseRedactions
+	DebugJSON       bool
+	DebugJSONRedact string
+
+	// ConfigFile is a JSON file of settings, keyed by flag name, reloaded
+	// on SIGHUP and whenever it changes, as reload.Manager reads it
+	ConfigFile string
+
+	// TemplatesDir holds greeting.tmpl and stream.tmpl, rendering SayHello
+	// and SayHelloMultiple messages; empty uses the built-in messages
+	TemplatesDir string
+
+	// UploadDir receives UploadDocument uploads of up to MaxUploadSize
+	// bytes; empty uses service.DefaultUploadDir
+	UploadDir     string
+	MaxUploadSize int64
+
+	// Conversations end once unused for SessionTTL; at most MaxSessions
+	// are in progress at once
+	SessionTTL  time.Duration
+	MaxSessions int
+
+	// CacheSize is the most SayHello responses kept in the response cache,
+	// each for CacheTTL; zero disables the cache
+	CacheSize int
+	CacheTTL  time.Duration
+
+	// PanicOn names a method whose calls panic, to show panic recovery
+	PanicOn string
+
+	// IdempotencyTTL is how long responses are kept for calls repeating an
+	// idempotency key
+	IdempotencyTTL time.Duration
+
+	RateLimit float64
+	RateBurst int
+
+	// TenantsFile, when set, names the JSON file of tenants the server
+	// greets for, as read by tenants.Load; calls must then name one
+	TenantsFile string
+
+	// AuthSecret enables bearer token authentication when set
+	AuthSecret string
+	// Admin registers the AdminService, which requires AuthSecret
+	Admin bool
+
+	Logging   Logging
+	Messages  MessageSize
+	Keepalive Keepalive
+	TLS       ServerTLS
+
+	// flags holds the parsed flags, for Settings
+	flags *flag.FlagSet
+}
+
+// LoadServer reads the server configuration from args (usually
+// os.Args[1:]) and the environment
+func LoadServer(args []string) (*Server, error) {
+	c := &Server{}
+	fs := flag.NewFlagSet("server", flag.ExitOnError)
+
+	fs.StringVar(&c.Addr, "addr", ":50051", "address to listen on: host:port, unix:///path/to.sock or memory://name (in-process only)")
+	fs.Var(fs.Lookup("addr").Value, "listen", "same as -addr")
+	fs.StringVar(&c.InstanceName, "instance-name", "", "name reported in the backend trailer of every response (defaults to the listen address)")
+	fs.BoolVar(&c.ReusePort, "reuseport", false, "set SO_REUSEPORT so several servers can share the port (Linux only)")
+	fs.IntVar(&c.ListenBacklog, "listen-backlog", 0, "connections to queue before they are accepted, capped by net.core.somaxconn (Linux only; 0 keeps somaxconn)")
+	fs.DurationVar(&c.DrainTimeout, "drain-timeout", 10*time.Second, "how long shutdown waits for in-flight RPCs before forcing them closed")
+	fs.BoolVar(&c.Debug, "debug", false, "enable debug RPCs such as StreamLogs")
+	fs.BoolVar(&c.Reflection, "reflection", false, "register the gRPC reflection service for tools like grpcurl and evans")
+	fs.BoolVar(&c.LogPings, "log-pings", false, "log every HTTP/2 ping sent and received, to watch keepalive at work")
+	fs.BoolVar(&c.LogPayloads, "log-payload-sizes", false, "log each message's size before and after compression")
+	fs.StringVar(&c.MetricsAddr, "metrics-addr", ":9090", "serve Prometheus metrics on http://<addr>/metrics and expvars on /debug/vars (empty disables)")
+	fs.StringVar(&c.DebugAddr, "debug-addr", "", "serve pprof profiles on http://<addr>/debug/pprof/, goroutine dumps on /debug/goroutines and GC stats on /debug/gc; loopback only, e.g. localhost:6060 (empty disables)")
+	fs.StringVar(&c.GatewayAddr, "gateway-addr", "", "serve the REST/JSON gateway on this address, e.g. :8080 (plaintext gRPC only)")
+	fs.StringVar(&c.GRPCWebAddr, "grpc-web-addr", "", "serve gRPC-Web for browser clients, and a demo page at /, on this address, e.g. :8081 (plaintext gRPC only)")
+	fs.Func("grpc-web-origins", "comma separated origins whose pages may call gRPC-Web across origins, e.g. http://localhost:3000, or * for any (same-origin pages always may)", func(value string) error {
+		c.GRPCWebOrigins = strings.Split(value, ",")
+		return nil
+	})
+	fs.BoolVar(&c.Multiplex, "multiplex", false, "also serve the REST gateway, /healthz, /metrics and a status page over HTTP on -addr, next to gRPC (plaintext only; -metrics-addr is unused)")
+	fs.StringVar(&c.StorePath, "store-path", "", "record greetings in this SQLite database file so the history survives restarts (in memory when empty)")
+	fs.StringVar(&c.EventsURL, "events-url", "", "publish a GreetingCreated event for every greeting to this broker: nats://host:4222, kafka://host:9092 or memory: (empty disables)")
+	fs.StringVar(&c.EventsTopic, "events-topic", "greetings.created", "NATS subject or Kafka topic greeting events are published to")
+
+	fs.StringVar(&c.GRPCLogSeverity, "grpc-log-severity", "off", "lowest gRPC internal log level to show: off, error, warning or info")
+	fs.IntVar(&c.GRPCLogVerbosity, "grpc-log-verbosity", 0, "verbosity of gRPC internal info logs")
+
+	fs.UintVar(&c.MaxHeaderListSize, "max-header-list-size", 0, "maximum total size in bytes of request headers the server accepts (0 uses the gRPC default)")
+	fs.IntVar(&c.MaxConcurrentRequests, "max-concurrent-requests", 0, "number of requests handled at once before others queue (0 disables the admission queue)")
+	fs.IntVar(&c.QueueSize, "queue-size", 100, "number of requests of each x-priority that may wait for a slot before new ones get ResourceExhausted")
+	fs.StringVar(&c.PriorityWeights, "priority-weights", "high=8,normal=4,low=1", "share of freed slots each x-priority gets while several wait for one")
+	fs.IntVar(&c.MaxConnsPerPeer, "max-conns-per-peer", 0, "connections one client IP may have open at once; more are closed as soon as accepted (0 is unlimited)")
+	fs.UintVar(&c.MaxStreamsPerConn, "max-streams-per-conn", 0, "concurrent streams, unary calls included, one connection may have open; clients queue the rest (0 is unlimited)")
+
+	fs.DurationVar(&c.StreamDelay, "stream-delay", 1*time.Second, "pause between SayHelloMultiple messages")
+	fs.IntVar(&c.StreamRampUp, "stream-rampup", 0, "number of initial streaming messages sent with shorter gaps ramping up to -stream-delay")
+	fs.IntVar(&c.StreamMaxCount, "stream-max-count", 100, "most greetings a client may ask SayHelloMultiple for")
+	fs.DurationVar(&c.StreamMaxInterval, "stream-max-interval", 10*time.Second, "longest interval between streamed greetings a client may ask for")
+	fs.IntVar(&c.BatchConcurrency, "batch-concurrency", 8, "names of a SayHelloBatch greeted at once")
+	fs.IntVar(&c.Workers, "workers", 0, "number of streamed greetings generated at once by a worker pool (0 disables the pool)")
+	fs.IntVar(&c.WorkerQueueSize, "worker-queue-size", 100, "number of streamed greetings that may wait for a worker before streams fail with Unavailable")
+
+	fs.Float64Var(&c.FailRate, "fail-rate", 0, "fraction of unary calls (0-1) to fail with Unavailable, to demonstrate client retries")
+	fs.StringVar(&c.Chaos, "chaos", "", "inject faults, e.g. \"latency=200ms,error-rate=0.1,codes=unavailable|internal,drop-rate=0.2\"")
+	fs.StringVar(&c.MethodTimeouts, "method-timeouts", "", "longest each method may run however long the client waits, e.g. \"SayHello=2s,SayHelloMultiple=30s,*=10s\"; overruns fail with DeadlineExceeded")
+	fs.DurationVar(&c.SlowCallThreshold, "slow-call-threshold", 0, "log a warning for unary calls taking longer than this (0 disables)")
+	fs.StringVar(&c.AuditLog, "audit-log", "", "record every call as a JSON line in this file, or \"-\" for stdout (empty disables the audit log)")
+	fs.Int64Var(&c.AuditMaxSize, "audit-max-size", 10<<20, "rotate the audit log once it reaches this many bytes (0 never rotates)")
+	fs.IntVar(&c.AuditMaxBackups, "audit-max-backups", 5, "number of rotated audit logs to keep")
+	fs.StringVar(&c.AuditRedact, "audit-redact", "", "request fields to redact in the audit log, e.g. \"name=mask,user.email=hash,payload=remove\"")
+	fs.BoolVar(&c.DebugJSON, "debug-json", false, "debugging: print every request, response and error as indented JSON on stderr")
+	fs.StringVar(&c.DebugJSONRedact, "debug-json-redact", "email,user.email,users.email", "message fields to redact in -debug-json dumps, as for -audit-redact")
+	fs.StringVar(&c.ConfigFile, "config-file", "", "JSON file of log-level, rate-limit, rate-burst and chaos settings overriding the flags, reloaded on SIGHUP and whenever it changes")
+	fs.StringVar(&c.TemplatesDir, "templates-dir", "", "directory of greeting.tmpl and stream.tmpl text/templates rendering SayHello and SayHelloMultiple messages, reloaded when they change")
+	fs.StringVar(&c.UploadDir, "upload-dir", "", "directory UploadDocument stores documents in (defaults to greeter-uploads in the system temp directory)")
+	fs.Int64Var(&c.MaxUploadSize, "max-upload-size", 32<<20, "largest document in bytes UploadDocument accepts; larger ones fail with ResourceExhausted")
+	fs.DurationVar(&c.SessionTTL, "session-ttl", 10*time.Minute, "how long a conversation's session lives unused before it expires")
+	fs.IntVar(&c.MaxSessions, "max-sessions", 10000, "conversations that may be in progress at once; StartConversation fails with ResourceExhausted beyond it")
+	fs.IntVar(&c.CacheSize, "cache-size", 0, "cache up to this many SayHello responses per name and locale, evicting the least recently used (0 disables the cache)")
+	fs.DurationVar(&c.CacheTTL, "cache-ttl", 30*time.Second, "how long a cached SayHello response is served")
+	fs.StringVar(&c.PanicOn, "panic-on", "", "debugging: panic instead of handling calls to this method, e.g. SayHello, to show panics recovered as Internal errors")
+	fs.DurationVar(&c.IdempotencyTTL, "idempotency-ttl", 10*time.Minute, "how long a unary response is replayed to calls repeating its idempotency-key header")
+	fs.Float64Var(&c.RateLimit, "rate-limit", 0, "calls per second allowed for each client, keyed by token subject or IP (0 disables rate limiting)")
+	fs.IntVar(&c.RateBurst, "rate-burst", 10, "calls a client may make in a burst before -rate-limit applies")
+	fs.StringVar(&c.TenantsFile, "tenants-file", "", "JSON file of tenants with their own greeting, language and rate limit; calls must then name one in the x-tenant-id header")
+	fs.StringVar(&c.AuthSecret, "auth-secret", "", "require bearer tokens signed with this secret (health checks and reflection stay open)")
+	fs.BoolVar(&c.Admin, "admin", false, "register the AdminService for changing the log level and chaos, draining and reading the config at runtime (needs -auth-secret; callers need the admin role)")
+
+	c.Logging.register(fs)
+	c.Messages.register(fs)
+	c.Keepalive.register(fs)
+	c.Keepalive.registerEnforcement(fs)
+	c.TLS.register(fs)
+
+	if err := parse(fs, args); err != nil {
+		return nil, err
+	}
+	// A bucket of size zero never holds a token, so every call would be
+	// throttled with an endless retry-after; reloads check the same
+	if c.RateLimit > 0 && c.RateBurst < 1 {
+		return nil, errors.New("-rate-burst must be at least 1 when -rate-limit is set")
+	}
+	// HTTP/2 carries the header list size in 32 bits
+	if c.MaxHeaderListSize > math.MaxUint32 {
+		return nil, errors.New("-max-header-list-size must fit in 32 bits")
+	}
+	c.flags = fs
+	return c, nil
+}
+
+// Settings returns the value of every flag, keyed by flag name, whether it
+// came from the command line, the environment or its default. Values of
+// secret flags, such as -auth-secret, are redacted.
+func (c *Server) Settings() map[string]string {
+	settings := make(map[string]string)
+	if c.flags == nil {
+		return settings
+	}
+	c.flags.VisitAll(func(f *flag.Flag) {
+		value := f.Value.String()
+		if strings.Contains(f.Name, "secret") && value != "" {
+			value = "REDACTED"
+		}
+		settings[f.Name] = value
+	})
+	return settings
+}
@@ -0,0 +1,38 @@
+package config
+
+import "testing"
+
+func TestLoadServerRateBurst(t *testing.T) {
+	tests := []struct {
+		args    []string
+		wantErr bool
+	}{
+		{[]string{"-rate-limit", "5", "-rate-burst", "0"}, true},
+		{[]string{"-rate-limit", "5", "-rate-burst", "1"}, false},
+		{[]string{"-rate-limit", "5"}, false},
+		// Without a rate limit the burst is never used
+		{[]string{"-rate-burst", "0"}, false},
+	}
+	for _, tt := range tests {
+		_, err := LoadServer(tt.args)
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("LoadServer(%q) = %v, want error: %t", tt.args, err, tt.wantErr)
+		}
+	}
+}
+
+func TestLoadServerMaxHeaderListSize(t *testing.T) {
+	tests := []struct {
+		size    string
+		wantErr bool
+	}{
+		{"4294967295", false},
+		{"4294967296", true},
+	}
+	for _, tt := range tests {
+		_, err := LoadServer([]string{"-max-header-list-size", tt.size})
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("LoadServer(-max-header-list-size %s) = %v, want error: %t", tt.size, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Package connmgr watches the connectivity state of a gRPC client
+// connection, tells callbacks about every transition (CONNECTING, READY,
+// TRANSIENT_FAILURE, ...) and keeps the connection up by reconnecting
+// whenever it goes idle, e.g. after the server restarted.
+package connmgr
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ErrClosed is returned by WaitForReady for a closed connection
+var ErrClosed = errors.New("connmgr: connection closed")
+
+// Change is one connectivity state transition
+type Change struct {
+	From, To connectivity.State
+}
+
+// Manager watches one client connection
+type Manager struct {
+	conn *grpc.ClientConn
+
+	mu        sync.Mutex
+	callbacks []func(Change)
+}
+
+// New creates a Manager for conn. Register callbacks with OnChange, then
+// call Start.
+func New(conn *grpc.ClientConn) *Manager {
+	return &Manager{conn: conn}
+}
+
+// OnChange registers fn to be called on every state transition. Callbacks
+// run one at a time, in registration order, on the manager's goroutine, so
+// they should return quickly.
+func (m *Manager) OnChange(fn func(Change)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callbacks = append(m.callbacks, fn)
+}
+
+// Start watches the connection in the background until ctx is done or the
+// connection is closed, reporting each transition. Whenever the connection
+// is idle it is asked to connect, so it is ready before the next call.
+func (m *Manager) Start(ctx context.Context) {
+	go m.watch(ctx)
+}
+
+func (m *Manager) watch(ctx context.Context) {
+	state := m.conn.GetState()
+	for {
+		if state == connectivity.Idle {
+			m.conn.Connect()
+		}
+		if !m.conn.WaitForStateChange(ctx, state) {
+			return
+		}
+		next := m.conn.GetState()
+		m.notify(Change{From: state, To: next})
+		if next == connectivity.Shutdown {
+			return
+		}
+		state = next
+	}
+}
+
+func (m *Manager) notify(c Change) {
+	m.mu.Lock()
+	callbacks := slices.Clone(m.callbacks)
+	m.mu.Unlock()
+
+	for _, fn := range callbacks {
+		fn(c)
+	}
+}
+
+// State returns the connection's current state
+func (m *Manager) State() connectivity.State {
+	return m.conn.GetState()
+}
+
+// WaitForReady blocks until the connection is READY, connecting it if it
+// is idle. It returns ctx's error if that doesn't happen before ctx is
+// done, and ErrClosed once the connection has been closed.
+func (m *Manager) WaitForReady(ctx context.Context) error {
+	for {
+		state := m.conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Idle:
+			m.conn.Connect()
+		case connectivity.Shutdown:
+			return ErrClosed
+		}
+		if !m.conn.WaitForStateChange(ctx, state) {
+			return ctx.Err()
+		}
+	}
+}
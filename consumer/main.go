@@ -0,0 +1,80 @@
+// The consumer is the other end of the server's event bridge: it
+// subscribes to the greeting events the server publishes to a message
+// broker and logs them, as any system reacting to greetings without
+// talking gRPC would:
+//
+//	docker run -p 4222:4222 nats
+//	go run ./server -events-url nats://localhost:4222
+//	go run ./consumer -events-url nats://localhost:4222
+//	go run ./client hello -name Bob
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/events"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+)
+
+func main() {
+	url := flag.String("events-url", "nats://localhost:4222", "broker to read greeting events from: nats://host:4222 or kafka://host:9092?group=name")
+	topic := flag.String("events-topic", events.DefaultTopic, "NATS subject or Kafka topic to read greeting events from")
+	logFormat := flag.String("log-format", "text", "log line format: text or json")
+	flag.Parse()
+	if err := logging.Setup(os.Stderr, *logFormat, "info"); err != nil {
+		log.Fatalf("Failed to configure logging: %v", err)
+	}
+
+	conn, err := events.Open(*url)
+	if err != nil {
+		fatal("Failed to connect to the event broker", logging.Err(err))
+	}
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	slog.Info("👂 Waiting for greeting events", "broker", *url, "topic", *topic)
+	err = conn.Subscribe(ctx, *topic, logEvent)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		fatal("Failed to read greeting events", logging.Err(err))
+	}
+	slog.Info("👋 Consumer stopped")
+}
+
+// logEvent logs a GreetingCreated event; messages of other types are
+// skipped, so the topic may carry more kinds of events later
+func logEvent(msg events.Message) {
+	if t := msg.Headers[events.TypeHeader]; t != events.GreetingCreatedType {
+		slog.Debug("Skipping event", "type", t, "topic", msg.Topic)
+		return
+	}
+	var e events.GreetingCreated
+	if err := json.Unmarshal(msg.Value, &e); err != nil {
+		slog.Warn("Skipping malformed greeting event", "topic", msg.Topic, logging.Err(err))
+		return
+	}
+	slog.Info("📨 Greeting created",
+		"name", e.Name,
+		"message", e.Message,
+		"count", e.Count,
+		"language", e.Language,
+		"tenant_id", e.TenantID,
+		"request_id", e.RequestID,
+		"greeted_at", e.GreetedAt,
+	)
+}
+
+// fatal logs msg at error level and exits, like log.Fatal
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
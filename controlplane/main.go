@@ -0,0 +1,218 @@
+// The control plane is a tiny static xDS server for proxyless service mesh
+// load balancing: it tells gRPC clients dialing xds:///greeter to balance
+// round-robin across the given backends, with no proxy in between:
+//
+//	go run ./launcher -instances 3
+//	go run ./controlplane -backends localhost:50061,localhost:50062,localhost:50063
+//	go run -tags xds ./client fanout -addr xds:///greeter -xds-server localhost:18000
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	router "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	discoverypb "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:18000", "address to serve xDS on")
+	name := flag.String("listener", "greeter", "listener name clients dial as xds:///<name>")
+	backends := flag.String("backends", "localhost:50061,localhost:50062,localhost:50063", "comma separated host:port list of greeting servers to balance across")
+	logFormat := flag.String("log-format", "text", "log line format: text or json")
+	flag.Parse()
+	if err := logging.Setup(os.Stderr, *logFormat, "info"); err != nil {
+		log.Fatalf("Failed to configure logging: %v", err)
+	}
+
+	snapshot, err := newSnapshot(*name, strings.Split(*backends, ","))
+	if err != nil {
+		fatal("Invalid xDS resources", logging.Err(err))
+	}
+	// Every client gets the same resources, whatever node id its bootstrap
+	// names
+	snapshots := cache.NewSnapshotCache(true, anyNode{}, nil)
+	if err := snapshots.SetSnapshot(context.Background(), anyNode{}.ID(nil), snapshot); err != nil {
+		fatal("Failed to set the xDS snapshot", logging.Err(err))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	s := grpc.NewServer()
+	discoverypb.RegisterAggregatedDiscoveryServiceServer(s, xdsserver.NewServer(ctx, snapshots, xdsserver.CallbackFuncs{
+		StreamRequestFunc: logRequest,
+	}))
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fatal("Failed to listen", "addr", *addr, logging.Err(err))
+	}
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			fatal("Failed to serve", logging.Err(err))
+		}
+	}()
+	slog.Info("🧭 xDS control plane running", "addr", lis.Addr().String(), "listener", *name, "backends", *backends)
+	slog.Info("Try: go run -tags xds ./client fanout -addr xds:///" + *name + " -xds-server " + lis.Addr().String())
+
+	<-ctx.Done()
+	slog.Info("Shutting down...")
+	s.GracefulStop()
+}
+
+// anyNode hashes every node to the one snapshot
+type anyNode struct{}
+
+func (anyNode) ID(*core.Node) string {
+	return "greeter"
+}
+
+// logRequest logs what each client asks for; ACKs repeat the version they
+// accepted, NACKs carry the reason the client rejected it
+func logRequest(stream int64, req *discoverypb.DiscoveryRequest) error {
+	args := []any{"stream", stream, "node", req.GetNode().GetId(), "type", req.GetTypeUrl(), "resources", req.GetResourceNames(), "version", req.GetVersionInfo()}
+	if detail := req.GetErrorDetail(); detail != nil {
+		slog.Warn("xDS client rejected resources", append(args, "error", detail.GetMessage())...)
+		return nil
+	}
+	slog.Info("xDS request", args...)
+	return nil
+}
+
+// newSnapshot returns the resources gRPC needs to resolve xds:///name: a
+// listener routing every call to one cluster, and that cluster's endpoints
+func newSnapshot(name string, backends []string) (*cache.Snapshot, error) {
+	clusterName := name + "-cluster"
+	lis, err := newListener(name, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := newEndpoints(clusterName, backends)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := cache.NewSnapshot("1", map[resource.Type][]types.Resource{
+		resource.ListenerType: {lis},
+		resource.ClusterType:  {newCluster(clusterName)},
+		resource.EndpointType: {endpoints},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, snapshot.Consistent()
+}
+
+// newListener returns the API listener gRPC clients look up by the dialed
+// name. Its route config is inline, so no RDS round trip is needed.
+func newListener(name, clusterName string) (*listener.Listener, error) {
+	routerConfig, err := anypb.New(&router.Router{})
+	if err != nil {
+		return nil, err
+	}
+	manager, err := anypb.New(&hcm.HttpConnectionManager{
+		RouteSpecifier: &hcm.HttpConnectionManager_RouteConfig{
+			RouteConfig: &route.RouteConfiguration{
+				Name: name + "-route",
+				VirtualHosts: []*route.VirtualHost{{
+					Name:    name,
+					Domains: []string{"*"},
+					Routes: []*route.Route{{
+						Match:  &route.RouteMatch{PathSpecifier: &route.RouteMatch_Prefix{Prefix: ""}},
+						Action: &route.Route_Route{Route: &route.RouteAction{ClusterSpecifier: &route.RouteAction_Cluster{Cluster: clusterName}}},
+					}},
+				}},
+			},
+		},
+		HttpFilters: []*hcm.HttpFilter{{
+			Name:       "router",
+			ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: routerConfig},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &listener.Listener{
+		Name:        name,
+		ApiListener: &listener.ApiListener{ApiListener: manager},
+	}, nil
+}
+
+// newCluster returns a round-robin cluster whose endpoints come over the
+// same ADS stream
+func newCluster(name string) *cluster.Cluster {
+	return &cluster.Cluster{
+		Name:                 name,
+		ConnectTimeout:       durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &cluster.Cluster_Type{Type: cluster.Cluster_EDS},
+		EdsClusterConfig: &cluster.Cluster_EdsClusterConfig{
+			EdsConfig: &core.ConfigSource{
+				ConfigSourceSpecifier: &core.ConfigSource_Ads{Ads: &core.AggregatedConfigSource{}},
+				ResourceApiVersion:    core.ApiVersion_V3,
+			},
+		},
+		LbPolicy: cluster.Cluster_ROUND_ROBIN,
+	}
+}
+
+// newEndpoints puts every backend in one locality with equal weight
+func newEndpoints(clusterName string, backends []string) (*endpoint.ClusterLoadAssignment, error) {
+	locality := &endpoint.LocalityLbEndpoints{
+		Locality:            &core.Locality{Zone: "local"},
+		LoadBalancingWeight: wrapperspb.UInt32(1),
+	}
+	for _, backend := range backends {
+		host, portText, err := net.SplitHostPort(strings.TrimSpace(backend))
+		if err != nil {
+			return nil, fmt.Errorf("backend %q: %w", backend, err)
+		}
+		port, err := strconv.ParseUint(portText, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("backend %q: bad port: %w", backend, err)
+		}
+		locality.LbEndpoints = append(locality.LbEndpoints, &endpoint.LbEndpoint{
+			HostIdentifier: &endpoint.LbEndpoint_Endpoint{Endpoint: &endpoint.Endpoint{
+				Address: &core.Address{Address: &core.Address_SocketAddress{SocketAddress: &core.SocketAddress{
+					Address:       host,
+					PortSpecifier: &core.SocketAddress_PortValue{PortValue: uint32(port)},
+				}}},
+			}},
+		})
+	}
+	return &endpoint.ClusterLoadAssignment{
+		ClusterName: clusterName,
+		Endpoints:   []*endpoint.LocalityLbEndpoints{locality},
+	}, nil
+}
+
+// fatal logs msg at error level and exits, like log.Fatal
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
@@ -0,0 +1,146 @@
+// Package diagnostics serves the runtime's insides over HTTP for profiling
+// a server under load: the net/http/pprof profiles, a dump of every
+// goroutine's stack and garbage collector statistics. They reveal much
+// about the process, so the endpoint only listens on loopback addresses.
+//
+//	/debug/pprof/       CPU, heap, goroutine, block, mutex and trace profiles
+//	/debug/goroutines   every goroutine's stack, as text
+//	/debug/gc           heap and garbage collector statistics, as JSON
+package diagnostics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	runtimepprof "runtime/pprof"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+)
+
+// recentPauses is how many of the latest GC pauses /debug/gc lists
+const recentPauses = 10
+
+// Serve starts the diagnostics endpoint on addr, which must be a loopback
+// address such as localhost:6060, and returns a function that shuts it down
+func Serve(addr string) (func(context.Context) error, error) {
+	if err := checkLoopback(addr); err != nil {
+		return nil, err
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/goroutines", serveGoroutines)
+	mux.HandleFunc("/debug/gc", serveGC)
+	// No write timeout: CPU profiles and traces stream for as long as asked
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Diagnostics endpoint failed", logging.Err(err))
+		}
+	}()
+	return srv.Shutdown, nil
+}
+
+// checkLoopback refuses addresses reachable from other hosts, including
+// those without a host, which listen on every interface
+func checkLoopback(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("diagnostics: %w", err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("diagnostics: %q is not a loopback address; use e.g. localhost:%s", addr, port)
+}
+
+// Goroutines returns the number of goroutines and a dump of their stacks.
+// Grouped dumps list identical stacks once, with a count, which keeps a
+// server with thousands of streams readable.
+func Goroutines(grouped bool) (int, []byte) {
+	debugLevel := 2
+	if grouped {
+		debugLevel = 1
+	}
+	var buf bytes.Buffer
+	runtimepprof.Lookup("goroutine").WriteTo(&buf, debugLevel)
+	return runtime.NumGoroutine(), buf.Bytes()
+}
+
+func serveGoroutines(w http.ResponseWriter, r *http.Request) {
+	_, dump := Goroutines(r.URL.Query().Has("grouped"))
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write(dump)
+}
+
+// GCStats are the heap and garbage collector statistics /debug/gc serves
+type GCStats struct {
+	Goroutines int       `json:"goroutines"`
+	GOMAXPROCS int       `json:"gomaxprocs"`
+	NumGC      int64     `json:"num_gc"`
+	LastGC     time.Time `json:"last_gc"`
+	PauseTotal string    `json:"pause_total"`
+	// RecentPauses are the latest pauses, most recent first
+	RecentPauses []string `json:"recent_pauses"`
+	HeapAlloc    uint64   `json:"heap_alloc_bytes"`
+	HeapSys      uint64   `json:"heap_sys_bytes"`
+	HeapObjects  uint64   `json:"heap_objects"`
+	NextGC       uint64   `json:"next_gc_bytes"`
+	TotalAlloc   uint64   `json:"total_alloc_bytes"`
+	Mallocs      uint64   `json:"mallocs"`
+}
+
+// ReadGCStats returns the current heap and garbage collector statistics.
+// It stops the world briefly to read them.
+func ReadGCStats() GCStats {
+	var gc debug.GCStats
+	debug.ReadGCStats(&gc)
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	s := GCStats{
+		Goroutines:  runtime.NumGoroutine(),
+		GOMAXPROCS:  runtime.GOMAXPROCS(0),
+		NumGC:       gc.NumGC,
+		LastGC:      gc.LastGC,
+		PauseTotal:  gc.PauseTotal.String(),
+		HeapAlloc:   mem.HeapAlloc,
+		HeapSys:     mem.HeapSys,
+		HeapObjects: mem.HeapObjects,
+		NextGC:      mem.NextGC,
+		TotalAlloc:  mem.TotalAlloc,
+		Mallocs:     mem.Mallocs,
+	}
+	for _, p := range gc.Pause[:min(len(gc.Pause), recentPauses)] {
+		s.RecentPauses = append(s.RecentPauses, p.String())
+	}
+	return s
+}
+
+func serveGC(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(ReadGCStats())
+}
@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+)
+
+// DefaultBridgeBuffer is how many events a Bridge holds while the broker
+// is slow or away before it drops new ones
+const DefaultBridgeBuffer = 1024
+
+// publishTimeout bounds how long a Bridge waits on the broker per event
+const publishTimeout = 5 * time.Second
+
+// Bridge publishes greeting events in the background, so SayHello never
+// waits on the broker: events queue up to a buffer, and once it is full
+// new ones are dropped and counted. Failed publishes are logged and not
+// retried.
+type Bridge struct {
+	pub   Publisher
+	topic string
+
+	mu     sync.Mutex
+	queue  chan Message
+	closed bool
+	done   chan struct{}
+
+	published atomic.Int64
+	dropped   atomic.Int64
+	failed    atomic.Int64
+}
+
+// NewBridge starts a Bridge publishing to topic with pub, queueing up to
+// buffer events
+func NewBridge(pub Publisher, topic string, buffer int) *Bridge {
+	b := &Bridge{
+		pub:   pub,
+		topic: topic,
+		queue: make(chan Message, buffer),
+		done:  make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// GreetingCreated queues e for publishing, keyed by the name greeted. It
+// never blocks.
+func (b *Bridge) GreetingCreated(ctx context.Context, e GreetingCreated) {
+	value, err := json.Marshal(e)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to encode the greeting event", logging.Err(err))
+		return
+	}
+	msg := Message{
+		Topic: b.topic,
+		Key:   e.Name,
+		Value: value,
+		Headers: map[string]string{
+			TypeHeader:        GreetingCreatedType,
+			ContentTypeHeader: "application/json",
+		},
+	}
+	if e.RequestID != "" {
+		msg.Headers[RequestIDHeader] = e.RequestID
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		b.dropped.Add(1)
+		return
+	}
+	select {
+	case b.queue <- msg:
+	default:
+		// Log the 1st, 2nd, 4th... drop rather than flood the log
+		if n := b.dropped.Add(1); n&(n-1) == 0 {
+			slog.WarnContext(ctx, "Dropping greeting events; the broker is falling behind", "dropped", n)
+		}
+	}
+}
+
+func (b *Bridge) run() {
+	defer close(b.done)
+	for msg := range b.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		err := b.pub.Publish(ctx, msg)
+		cancel()
+		if err != nil {
+			b.failed.Add(1)
+			slog.Warn("Failed to publish the greeting event", "topic", msg.Topic, "request_id", msg.Headers[RequestIDHeader], logging.Err(err))
+			continue
+		}
+		b.published.Add(1)
+		slog.Debug("📣 Published greeting event", "topic", msg.Topic, "key", msg.Key, "request_id", msg.Headers[RequestIDHeader])
+	}
+}
+
+// Stats returns how many events were published, dropped because the
+// buffer was full and failed to publish
+func (b *Bridge) Stats() (published, dropped, failed int64) {
+	return b.published.Load(), b.dropped.Load(), b.failed.Load()
+}
+
+// Close stops accepting events and waits until the queued ones are
+// published, or ctx is done
+func (b *Bridge) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		close(b.queue)
+	}
+	b.mu.Unlock()
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,90 @@
+// Package events bridges greetings to a message broker, so systems that
+// don't speak gRPC learn about them from a topic: a Bridge publishes a
+// GreetingCreated event, as JSON, for every SayHello that succeeds. The
+// broker is pluggable behind Publisher and Subscriber; Open connects to
+// NATS or Kafka from a URL, and Memory is an in-process fake for tests and
+// for trying the bridge without a broker.
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// DefaultTopic is the subject or topic greeting events are published to
+const DefaultTopic = "greetings.created"
+
+// Headers of the messages a Bridge publishes
+const (
+	TypeHeader        = "event-type"
+	ContentTypeHeader = "content-type"
+	RequestIDHeader   = "request-id"
+)
+
+// Message is one message on a broker
+type Message struct {
+	Topic string
+	// Key orders messages: Kafka keeps those with the same key in order on
+	// one partition; NATS ignores it
+	Key     string
+	Value   []byte
+	Headers map[string]string
+}
+
+// Publisher sends messages to a broker
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+// Subscriber receives the messages published to a topic, calling fn for
+// each in turn until ctx is done
+type Subscriber interface {
+	Subscribe(ctx context.Context, topic string, fn func(Message)) error
+}
+
+// Conn is a connection to a broker
+type Conn interface {
+	Publisher
+	Subscriber
+	io.Closer
+}
+
+// GreetingCreatedType is the event-type header of GreetingCreated events
+const GreetingCreatedType = "GreetingCreated"
+
+// GreetingCreated is the event published for every greeting
+type GreetingCreated struct {
+	Name      string    `json:"name"`
+	Message   string    `json:"message"`
+	Count     int32     `json:"count"`
+	Language  string    `json:"language,omitempty"`
+	TenantID  string    `json:"tenant_id,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	RequestID string    `json:"request_id,omitempty"`
+	GreetedAt time.Time `json:"greeted_at"`
+}
+
+// Open connects to the broker rawURL names:
+//
+//	nats://localhost:4222             NATS; separate several servers with commas
+//	kafka://localhost:9092?group=g    Kafka brokers; group is the consumer group subscribers join
+//	memory:                           an in-process Memory broker
+func Open(rawURL string) (Conn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("events: %w", err)
+	}
+	switch u.Scheme {
+	case "nats":
+		return DialNATS(rawURL)
+	case "kafka":
+		return NewKafka(u)
+	case "memory":
+		return NewMemory(), nil
+	default:
+		return nil, fmt.Errorf("events: unknown broker %q in %q; use nats://, kafka:// or memory:", u.Scheme, rawURL)
+	}
+}
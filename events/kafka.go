@@ -0,0 +1,83 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// defaultKafkaGroup is the consumer group Kafka subscribers join unless
+// the URL names one
+const defaultKafkaGroup = "greeting-consumers"
+
+// Kafka publishes to and subscribes to Kafka topics. Messages with the
+// same key go to the same partition, so a name's greetings stay in order.
+type Kafka struct {
+	brokers []string
+	group   string
+	writer  *kafka.Writer
+}
+
+// NewKafka returns a Kafka client for the brokers in u's host, separated
+// by commas, e.g. kafka://k1:9092,k2:9092?group=audit. It connects on
+// first use.
+func NewKafka(u *url.URL) (*Kafka, error) {
+	if u.Host == "" {
+		return nil, errors.New("events: kafka:// needs at least one broker, e.g. kafka://localhost:9092")
+	}
+	brokers := strings.Split(u.Host, ",")
+	group := u.Query().Get("group")
+	if group == "" {
+		group = defaultKafkaGroup
+	}
+	return &Kafka{
+		brokers: brokers,
+		group:   group,
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}, nil
+}
+
+// Publish writes msg to the topic msg.Topic and waits for the brokers to
+// acknowledge it
+func (k *Kafka) Publish(ctx context.Context, msg Message) error {
+	m := kafka.Message{Topic: msg.Topic, Key: []byte(msg.Key), Value: msg.Value}
+	for key, v := range msg.Headers {
+		m.Headers = append(m.Headers, kafka.Header{Key: key, Value: []byte(v)})
+	}
+	return k.writer.WriteMessages(ctx, m)
+}
+
+// Subscribe reads topic as a member of the client's consumer group,
+// calling fn with each message and committing it once fn returns, until
+// ctx is done
+func (k *Kafka) Subscribe(ctx context.Context, topic string, fn func(Message)) error {
+	r := kafka.NewReader(kafka.ReaderConfig{Brokers: k.brokers, GroupID: k.group, Topic: topic})
+	defer r.Close()
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			return err
+		}
+		msg := Message{Topic: m.Topic, Key: string(m.Key), Value: m.Value, Headers: make(map[string]string, len(m.Headers))}
+		for _, h := range m.Headers {
+			msg.Headers[h.Key] = string(h.Value)
+		}
+		fn(msg)
+		if err := r.CommitMessages(ctx, m); err != nil {
+			return err
+		}
+	}
+}
+
+// Close flushes pending writes
+func (k *Kafka) Close() error {
+	return k.writer.Close()
+}
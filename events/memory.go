@@ -0,0 +1,97 @@
+package events
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// Memory is an in-process broker: every subscriber to a topic gets every
+// message published to it, and Published keeps them all for tests to
+// inspect. Publish never blocks; a subscriber that falls behind holds up
+// only itself.
+type Memory struct {
+	mu          sync.Mutex
+	published   []Message
+	subscribers map[string][]chan Message
+	closed      bool
+}
+
+// memoryBuffer is how many messages a Memory subscriber may fall behind by
+// before Publish drops messages for it
+const memoryBuffer = 256
+
+// NewMemory creates an empty Memory broker
+func NewMemory() *Memory {
+	return &Memory{subscribers: make(map[string][]chan Message)}
+}
+
+// Publish records msg and hands it to the topic's subscribers
+func (m *Memory) Publish(ctx context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.published = append(m.published, msg)
+	for _, ch := range m.subscribers[msg.Topic] {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+	return nil
+}
+
+// Published returns every message published so far, oldest first
+func (m *Memory) Published() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.published)
+}
+
+// Subscribe calls fn with every message published to topic from now on,
+// until ctx is done or the broker is closed
+func (m *Memory) Subscribe(ctx context.Context, topic string, fn func(Message)) error {
+	ch := make(chan Message, memoryBuffer)
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil
+	}
+	m.subscribers[topic] = append(m.subscribers[topic], ch)
+	m.mu.Unlock()
+
+	defer func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if !m.closed {
+			m.subscribers[topic] = slices.DeleteFunc(m.subscribers[topic], func(c chan Message) bool { return c == ch })
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			fn(msg)
+		}
+	}
+}
+
+// Close ends every subscription
+func (m *Memory) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	for _, subs := range m.subscribers {
+		for _, ch := range subs {
+			close(ch)
+		}
+	}
+	m.subscribers = nil
+	return nil
+}
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATS publishes to and subscribes to NATS subjects. Core NATS delivers
+// to the subscribers connected at the time only; use a JetStream stream on
+// the subject for events that must survive a consumer being down.
+type NATS struct {
+	nc *nats.Conn
+}
+
+// DialNATS connects to the NATS servers in url, separated by commas. The
+// connection reconnects on its own should a server go away.
+func DialNATS(url string) (*NATS, error) {
+	nc, err := nats.Connect(url, nats.Name("greeter"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("events: connecting to NATS: %w", err)
+	}
+	return &NATS{nc: nc}, nil
+}
+
+// Publish publishes msg on the subject msg.Topic
+func (n *NATS) Publish(ctx context.Context, msg Message) error {
+	m := nats.NewMsg(msg.Topic)
+	m.Data = msg.Value
+	for k, v := range msg.Headers {
+		m.Header.Set(k, v)
+	}
+	if err := n.nc.PublishMsg(m); err != nil {
+		return err
+	}
+	// Wait for the server to have it, so an error surfaces here
+	return n.nc.FlushWithContext(ctx)
+}
+
+// Subscribe calls fn with every message published to the subject topic,
+// which may hold wildcards, until ctx is done
+func (n *NATS) Subscribe(ctx context.Context, topic string, fn func(Message)) error {
+	ch := make(chan *nats.Msg, memoryBuffer)
+	sub, err := n.nc.ChanSubscribe(topic, ch)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case m := <-ch:
+			msg := Message{Topic: m.Subject, Value: m.Data, Headers: make(map[string]string, len(m.Header))}
+			for k := range m.Header {
+				msg.Headers[k] = m.Header.Get(k)
+			}
+			fn(msg)
+		}
+	}
+}
+
+// Close flushes what was published and disconnects
+func (n *NATS) Close() error {
+	return n.nc.Drain()
+}
@@ -0,0 +1,14 @@
+// Package gen regenerates the Go code for the protos under proto/:
+//
+//	go generate ./gen
+//
+// lints the protos with buf, checks them for changes that break clients
+// of the last commit, then runs protoc-gen-go, protoc-gen-go-grpc,
+// protoc-gen-grpc-gateway and protoc-gen-validate as configured in
+// buf.gen.yaml. buf and the plugins are built from tools/go.mod, so the
+// output only changes when the protos or those pinned versions do.
+package gen
+
+//go:generate go run -C ../tools github.com/bufbuild/buf/cmd/buf lint ..
+//go:generate go run -C ../tools github.com/bufbuild/buf/cmd/buf breaking .. --against ../.git#ref=HEAD --against-config ../buf.yaml
+//go:generate go run -C ../tools github.com/bufbuild/buf/cmd/buf generate .. --path ../proto --template ../buf.gen.yaml -o ..
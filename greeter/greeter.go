@@ -0,0 +1,109 @@
+// Package greeter formats greetings in several languages. Each language is
+// a Catalog; a Registry picks the best catalog for a caller's preferences
+// and falls back to its first catalog when none of them match.
+package greeter
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Catalog holds how one language greets someone
+type Catalog struct {
+	Tag language.Tag
+	// Name is the language's English name, NativeName its own
+	Name       string
+	NativeName string
+
+	Salutation string
+	// Separator goes between salutation and subject; ", " when empty
+	Separator string
+	// Subject is a format for the name with one %s verb, e.g. "%sさん";
+	// the plain name when empty
+	Subject     string
+	Punctuation string
+}
+
+// Parts is a greeting split the way the GreetingService reports it
+type Parts struct {
+	Salutation  string
+	Separator   string
+	Subject     string
+	Punctuation string
+}
+
+// String renders the full greeting
+func (p Parts) String() string {
+	return p.Salutation + p.Separator + p.Subject + p.Punctuation
+}
+
+// Greet returns the greeting for name in c's language
+func (c Catalog) Greet(name string) Parts {
+	p := Parts{Salutation: c.Salutation, Separator: c.Separator, Subject: name, Punctuation: c.Punctuation}
+	if p.Separator == "" {
+		p.Separator = ", "
+	}
+	if c.Subject != "" {
+		p.Subject = fmt.Sprintf(c.Subject, name)
+	}
+	return p
+}
+
+// The built-in catalogs
+var (
+	English  = Catalog{Tag: language.English, Name: "English", NativeName: "English", Salutation: "Hello", Punctuation: "!"}
+	German   = Catalog{Tag: language.German, Name: "German", NativeName: "Deutsch", Salutation: "Hallo", Punctuation: "!"}
+	Hindi    = Catalog{Tag: language.Hindi, Name: "Hindi", NativeName: "हिन्दी", Salutation: "नमस्ते", Subject: "%s जी", Punctuation: "!"}
+	French   = Catalog{Tag: language.French, Name: "French", NativeName: "Français", Salutation: "Bonjour", Punctuation: " !"}
+	Japanese = Catalog{Tag: language.Japanese, Name: "Japanese", NativeName: "日本語", Salutation: "こんにちは", Separator: "、", Subject: "%sさん", Punctuation: "！"}
+)
+
+// Registry negotiates between a set of catalogs
+type Registry struct {
+	catalogs []Catalog
+	matcher  language.Matcher
+}
+
+// New returns a Registry of catalogs. The first one is the fallback for
+// callers whose preferences match none of them.
+func New(catalogs ...Catalog) *Registry {
+	tags := make([]language.Tag, len(catalogs))
+	for i, c := range catalogs {
+		tags[i] = c.Tag
+	}
+	return &Registry{catalogs: catalogs, matcher: language.NewMatcher(tags)}
+}
+
+// Default holds the built-in catalogs, falling back to English
+var Default = New(English, German, Hindi, French, Japanese)
+
+// Match returns the catalog best suited to preferences, each either a
+// single tag such as "de-CH" or an Accept-Language list such as
+// "fr-CA, de;q=0.8". Earlier preferences win, so pass the most explicit
+// one first; empty and malformed ones are skipped.
+func (r *Registry) Match(preferences ...string) Catalog {
+	var tags []language.Tag
+	for _, pref := range preferences {
+		if strings.TrimSpace(pref) == "" {
+			continue
+		}
+		parsed, _, err := language.ParseAcceptLanguage(pref)
+		if err != nil {
+			continue
+		}
+		tags = append(tags, parsed...)
+	}
+
+	_, i, confidence := r.matcher.Match(tags...)
+	if confidence == language.No {
+		return r.catalogs[0]
+	}
+	return r.catalogs[i]
+}
+
+// Catalogs returns the registry's catalogs, fallback first
+func (r *Registry) Catalogs() []Catalog {
+	return append([]Catalog(nil), r.catalogs...)
+}
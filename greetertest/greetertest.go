@@ -0,0 +1,125 @@
+// Package greetertest runs the greeting service in-process over an
+// in-memory bufconn listener, so tests can exercise real gRPC calls without
+// opening ports:
+//
+//	func TestSayHello(t *testing.T) {
+//		ts := greetertest.StartTestServer(t)
+//		resp, err := ts.Client.SayHello(ctx, req)
+//		...
+//	}
+package greetertest
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// bufSize is the in-memory listener's buffer size
+const bufSize = 1 << 20
+
+// TestServer is a greeting server running in-process with a client
+// connected to it
+type TestServer struct {
+	Server   *grpc.Server
+	Greeter  *service.Server
+	Conn     *grpc.ClientConn
+	Client   pb.GreetingServiceClient
+	ClientV2 pbv2.GreetingServiceV2Client
+
+	lis *bufconn.Listener
+}
+
+// Dialer returns a dial option connecting to the server, for clients other
+// than Conn, e.g. greetingclient.New("passthrough:///bufconn",
+// greetingclient.WithDialOptions(ts.Dialer()))
+func (ts *TestServer) Dialer() grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return ts.lis.DialContext(ctx)
+	})
+}
+
+// Option configures StartTestServer
+type Option func(*options)
+
+type options struct {
+	service []service.Option
+	server  []grpc.ServerOption
+	dial    []grpc.DialOption
+}
+
+// WithServiceOptions configures the greeting service, e.g. with
+// service.WithStreamDelay(0) to make streaming tests fast
+func WithServiceOptions(opts ...service.Option) Option {
+	return func(o *options) {
+		o.service = append(o.service, opts...)
+	}
+}
+
+// WithServerOptions adds gRPC server options such as interceptors
+func WithServerOptions(opts ...grpc.ServerOption) Option {
+	return func(o *options) {
+		o.server = append(o.server, opts...)
+	}
+}
+
+// WithDialOptions adds gRPC dial options such as client interceptors
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dial = append(o.dial, opts...)
+	}
+}
+
+// StartTestServer starts the v1 and v2 greeting services on a bufconn
+// listener and dials them. Streams are paced without delay unless
+// overridden. Everything is torn down when the test finishes.
+func StartTestServer(t testing.TB, opts ...Option) *TestServer {
+	t.Helper()
+
+	o := &options{service: []service.Option{service.WithStreamDelay(0)}}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	lis := bufconn.Listen(bufSize)
+	s := grpc.NewServer(o.server...)
+	greeter := service.New(o.service...)
+	pb.RegisterGreetingServiceServer(s, greeter)
+	pbv2.RegisterGreetingServiceV2Server(s, greeter.V2())
+	go func() {
+		// Serve only fails once the listener is closed during cleanup
+		_ = s.Serve(lis)
+	}()
+
+	dial := append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, o.dial...)
+	conn, err := grpc.NewClient("passthrough:///bufconn", dial...)
+	if err != nil {
+		s.Stop()
+		t.Fatalf("greetertest: dial bufconn: %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+	})
+	return &TestServer{
+		Server:   s,
+		Greeter:  greeter,
+		Conn:     conn,
+		Client:   pb.NewGreetingServiceClient(conn),
+		ClientV2: pbv2.NewGreetingServiceV2Client(conn),
+		lis:      lis,
+	}
+}
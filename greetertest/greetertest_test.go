@@ -0,0 +1,134 @@
+package greetertest_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func name(n string) *pb.HelloRequest {
+	return &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: n}}
+}
+
+func TestSayHello(t *testing.T) {
+	ts := greetertest.StartTestServer(t)
+
+	resp, err := ts.Client.SayHello(context.Background(), name("Alice"))
+	if err != nil {
+		t.Fatalf("SayHello: %v", err)
+	}
+	if got, want := resp.GetMessage(), "Hello, Alice!"; got != want {
+		t.Errorf("message = %q, want %q", got, want)
+	}
+	if resp.GetCount() != 1 {
+		t.Errorf("count = %d, want 1", resp.GetCount())
+	}
+
+	resp, err = ts.Client.SayHello(context.Background(), name("Alice"))
+	if err != nil {
+		t.Fatalf("second SayHello: %v", err)
+	}
+	if resp.GetCount() != 2 {
+		t.Errorf("second count = %d, want 2", resp.GetCount())
+	}
+}
+
+func TestSayHelloMultiple(t *testing.T) {
+	ts := greetertest.StartTestServer(t)
+
+	req := name("Bob")
+	req.Count = 3
+	stream, err := ts.Client.SayHelloMultiple(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SayHelloMultiple: %v", err)
+	}
+	var counts []int32
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		counts = append(counts, resp.GetCount())
+	}
+	if len(counts) != 3 || counts[0] != 1 || counts[1] != 2 || counts[2] != 3 {
+		t.Errorf("counts = %v, want [1 2 3]", counts)
+	}
+}
+
+func TestGreetEveryone(t *testing.T) {
+	ts := greetertest.StartTestServer(t)
+
+	stream, err := ts.Client.GreetEveryone(context.Background())
+	if err != nil {
+		t.Fatalf("GreetEveryone: %v", err)
+	}
+	for i, n := range []string{"Alice", "Bob"} {
+		if err := stream.Send(name(n)); err != nil {
+			t.Fatalf("Send %s: %v", n, err)
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv %s: %v", n, err)
+		}
+		if resp.GetCount() != int32(i+1) {
+			t.Errorf("%s: count = %d, want %d", n, resp.GetCount(), i+1)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend: %v", err)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("Recv after CloseSend = %v, want io.EOF", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	ts := greetertest.StartTestServer(t)
+
+	tests := []struct {
+		desc string
+		req  *pb.HelloRequest
+		want codes.Code
+	}{
+		{"empty name", name(""), codes.InvalidArgument},
+		{"unknown user", &pb.HelloRequest{Identity: &pb.HelloRequest_UserId{UserId: 999}}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := ts.Client.SayHello(context.Background(), tt.req)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("SayHello: code = %v, want %v (%v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestDeadlineExceeded(t *testing.T) {
+	ts := greetertest.StartTestServer(t, greetertest.WithServiceOptions(service.WithStreamDelay(time.Second)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	stream, err := ts.Client.SayHelloMultiple(ctx, name("Carol"))
+	if err != nil {
+		t.Fatalf("SayHelloMultiple: %v", err)
+	}
+	for {
+		_, err = stream.Recv()
+		if err != nil {
+			break
+		}
+	}
+	if got := status.Code(err); got != codes.DeadlineExceeded {
+		t.Errorf("Recv: code = %v, want DeadlineExceeded (%v)", got, err)
+	}
+}
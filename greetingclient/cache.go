@@ -0,0 +1,219 @@
+package greetingclient
+
+import (
+	"container/list"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"google.golang.org/grpc/codes"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// CachePolicy says how long a Cache serves a response, by its age, the
+// time since the server sent it, like HTTP's Cache-Control max-age,
+// stale-while-revalidate and stale-if-error:
+//
+//   - younger than Fresh, it is served without calling the server
+//   - up to StaleWhileRevalidate older than that, it is served as stale
+//     while a call in the background fetches a fresh one
+//   - up to StaleIfError older than Fresh, it is served as stale when the
+//     server can't be reached
+//
+// Responses older than all of them are dropped.
+type CachePolicy struct {
+	Fresh                time.Duration
+	StaleWhileRevalidate time.Duration
+	StaleIfError         time.Duration
+}
+
+// DefaultCachePolicy serves responses for 30 seconds, revalidates them in
+// the background for 5 minutes more and falls back to them for an hour
+// while the server is unreachable
+var DefaultCachePolicy = CachePolicy{
+	Fresh:                30 * time.Second,
+	StaleWhileRevalidate: 5 * time.Minute,
+	StaleIfError:         time.Hour,
+}
+
+// maxAge returns the oldest response p ever serves
+func (p CachePolicy) maxAge() time.Duration {
+	return p.Fresh + max(p.StaleWhileRevalidate, p.StaleIfError)
+}
+
+// CachedResponse is a SayHello response served through a Cache
+type CachedResponse struct {
+	*pb.HelloResponse
+	// Stale is set when the response is past its freshness: served while a
+	// fresh one is fetched, or because the server couldn't be reached
+	Stale bool
+	// Age is how long ago the server sent the response; zero for responses
+	// it sent for this call
+	Age time.Duration
+	// Err is why the server couldn't be reached, for stale responses served
+	// in its place
+	Err error
+}
+
+// Cache serves recent SayHello responses from memory, keeping at most a
+// fixed number, evicting the least recently used. Responses are cached by
+// request, locale header and tenant, and served as its CachePolicy says,
+// so a client keeps greeting, if with older greetings, while the server is
+// down.
+type Cache struct {
+	client *Client
+	size   int
+	policy CachePolicy
+
+	mu      sync.Mutex
+	lru     *list.List // front is the most recently used *cachedGreeting
+	entries map[string]*list.Element
+	// refreshing holds the keys being fetched in the background, so a key
+	// has only one refresh at a time
+	refreshing map[string]bool
+}
+
+type cachedGreeting struct {
+	key       string
+	resp      *pb.HelloResponse
+	fetchedAt time.Time
+}
+
+// NewCache creates a Cache of up to size responses calling through c
+func NewCache(c *Client, size int, policy CachePolicy) *Cache {
+	return &Cache{
+		client:     c,
+		size:       size,
+		policy:     policy,
+		lru:        list.New(),
+		entries:    make(map[string]*list.Element),
+		refreshing: make(map[string]bool),
+	}
+}
+
+// SayHello returns the greeting for req from the cache or from the server.
+// It fails when the server fails, or can't be reached and no response
+// recent enough is cached.
+func (c *Cache) SayHello(ctx context.Context, req *pb.HelloRequest) (*CachedResponse, error) {
+	key, err := cacheKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	cached, age, ok := c.get(key)
+	switch {
+	case ok && age < c.policy.Fresh:
+		return &CachedResponse{HelloResponse: cached, Age: age}, nil
+	case ok && age < c.policy.Fresh+c.policy.StaleWhileRevalidate:
+		c.refresh(ctx, key, req)
+		return &CachedResponse{HelloResponse: cached, Stale: true, Age: age}, nil
+	}
+
+	resp, err := c.client.SayHello(ctx, req)
+	if err == nil {
+		c.put(key, resp)
+		return &CachedResponse{HelloResponse: resp}, nil
+	}
+	if ok && unreachable(err) && age < c.policy.Fresh+c.policy.StaleIfError {
+		return &CachedResponse{HelloResponse: cached, Stale: true, Age: age, Err: err}, nil
+	}
+	return nil, err
+}
+
+// unreachable reports whether err says the server couldn't be reached or
+// didn't answer in time, rather than that it refused the call
+func unreachable(err error) bool {
+	code := status.Code(err)
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+// refresh fetches req's greeting again in the background, unless it is
+// being fetched already. The fetch outlives ctx, keeping its values.
+func (c *Cache) refresh(ctx context.Context, key string, req *pb.HelloRequest) {
+	c.mu.Lock()
+	if c.refreshing[key] {
+		c.mu.Unlock()
+		return
+	}
+	c.refreshing[key] = true
+	c.mu.Unlock()
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() {
+			c.mu.Lock()
+			delete(c.refreshing, key)
+			c.mu.Unlock()
+		}()
+		resp, err := c.client.SayHello(ctx, req)
+		if err != nil {
+			slog.DebugContext(ctx, "Failed to refresh a cached greeting; serving it stale", logging.Err(err))
+			return
+		}
+		c.put(key, resp)
+	}()
+}
+
+// cacheKey identifies req's response: the request itself, and the locale
+// header and tenant the server greets by
+func cacheKey(ctx context.Context, req *pb.HelloRequest) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	md, _ := grpcmd.FromOutgoingContext(ctx)
+	key := string(b)
+	for _, v := range append(md.Get(metadata.LocaleHeader), requestcontext.TenantID(ctx)) {
+		key += "\x00" + v
+	}
+	return key, nil
+}
+
+// get returns the cached response of key and its age
+func (c *Cache) get(key string) (*pb.HelloResponse, time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, 0, false
+	}
+	e := el.Value.(*cachedGreeting)
+	age := time.Since(e.fetchedAt)
+	if age >= c.policy.maxAge() {
+		c.lru.Remove(el)
+		delete(c.entries, key)
+		return nil, 0, false
+	}
+	c.lru.MoveToFront(el)
+	return e.resp, age, true
+}
+
+func (c *Cache) put(key string, resp *pb.HelloResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*cachedGreeting)
+		e.resp, e.fetchedAt = resp, time.Now()
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cachedGreeting{key: key, resp: resp, fetchedAt: time.Now()})
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedGreeting).key)
+	}
+}
+
+// Len returns the number of cached responses
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
@@ -0,0 +1,424 @@
+// Package greetingclient is a client for the greeting service for Go
+// programs that embed one, wrapping the generated stubs with deadlines,
+// retries and streams that survive server restarts:
+//
+//	c, err := greetingclient.New("localhost:50051", greetingclient.WithTimeout(2*time.Second))
+//	if err != nil { ... }
+//	defer c.Close()
+//	resp, err := c.SayHello(ctx, greetingclient.Name("Alice"))
+package greetingclient
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/connmgr"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/transport"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/workers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultTimeout is the deadline of each unary call unless WithTimeout
+// says otherwise
+const DefaultTimeout = 5 * time.Second
+
+// DefaultResumePolicy is how streams resume with WithReconnect unless
+// WithResumePolicy says otherwise
+var DefaultResumePolicy = interceptors.RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// minConnectTimeout is how long each connection attempt may take with
+// WithBackoff, as gRPC allows by default
+const minConnectTimeout = 20 * time.Second
+
+// Client calls a greeting server
+type Client struct {
+	conn         *grpc.ClientConn
+	greeter      pb.GreetingServiceClient
+	timeout      time.Duration
+	reconnect    time.Duration
+	resumePolicy interceptors.RetryPolicy
+	// ownsConn is set when Close should close conn
+	ownsConn bool
+}
+
+// Option configures New and FromConn
+type Option func(*options)
+
+type options struct {
+	tls          *tls.Config
+	timeout      time.Duration
+	retry        interceptors.RetryPolicy
+	reconnect    time.Duration
+	resumePolicy interceptors.RetryPolicy
+	backoff      *backoff.Config
+	wait         bool
+	dial         []grpc.DialOption
+}
+
+// WithTLS connects over TLS configured by cfg instead of in plaintext.
+// New only.
+func WithTLS(cfg *tls.Config) Option {
+	return func(o *options) {
+		o.tls = cfg
+	}
+}
+
+// WithTimeout sets the deadline of each unary call, shortening any later
+// deadline of the caller's context
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithRetries sets the total tries of unary calls failing with Unavailable
+// or DeadlineExceeded, backing off as interceptors.DefaultRetryPolicy does;
+// 1 disables retries. New only.
+func WithRetries(maxAttempts int) Option {
+	return func(o *options) {
+		o.retry.MaxAttempts = maxAttempts
+	}
+}
+
+// WithReconnect makes a stream cut off with Unavailable, e.g. by a server
+// restart, wait up to d for the connection to come back and resume after
+// the last greeting received, as WithResumePolicy says. Zero, the default,
+// returns the error.
+func WithReconnect(d time.Duration) Option {
+	return func(o *options) {
+		o.reconnect = d
+	}
+}
+
+// WithResumePolicy sets how WithReconnect resumes streams: the backoff
+// between tries, and how many tries in a row may go without a greeting
+// before the last error is returned. DefaultResumePolicy unless set.
+func WithResumePolicy(p interceptors.RetryPolicy) Option {
+	return func(o *options) {
+		o.resumePolicy = p
+	}
+}
+
+// WithBackoff sets the exponential backoff between connection attempts,
+// gRPC's backoff.DefaultConfig unless set. New only.
+func WithBackoff(cfg backoff.Config) Option {
+	return func(o *options) {
+		o.backoff = &cfg
+	}
+}
+
+// WithWaitForReady makes calls made while the server is unreachable, e.g.
+// before it has started, wait for it until their deadline instead of
+// failing at once with Unavailable. New only.
+func WithWaitForReady() Option {
+	return func(o *options) {
+		o.wait = true
+	}
+}
+
+// WithDialOptions adds gRPC dial options, e.g. per-RPC credentials or more
+// interceptors. New only.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dial = append(o.dial, opts...)
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{timeout: DefaultTimeout, retry: interceptors.DefaultRetryPolicy, resumePolicy: DefaultResumePolicy}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// New connects to the greeting server at target, which may also be a
+// unix:// or memory:// address as understood by the transport package.
+// Every call carries a request id and the requestcontext values of its
+// context, and unary calls are retried; Close the client when done.
+func New(target string, opts ...Option) (*Client, error) {
+	o := newOptions(opts)
+	creds := insecure.NewCredentials()
+	if o.tls != nil {
+		creds = credentials.NewTLS(o.tls)
+	}
+
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(interceptors.UnaryClientRequestID(), requestcontext.UnaryClientInterceptor(), interceptors.UnaryClientRetry(o.retry)),
+		grpc.WithChainStreamInterceptor(interceptors.StreamClientRequestID(), requestcontext.StreamClientInterceptor()),
+	}, o.dial...)
+	if o.backoff != nil {
+		dialOpts = append(dialOpts, grpc.WithConnectParams(grpc.ConnectParams{Backoff: *o.backoff, MinConnectTimeout: minConnectTimeout}))
+	}
+	if o.wait {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	}
+	target, transportOpts := transport.DialTarget(target)
+	conn, err := grpc.NewClient(target, append(transportOpts, dialOpts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	c := fromConn(conn, o)
+	c.ownsConn = true
+	return c, nil
+}
+
+// FromConn makes a Client calling over conn, which the caller keeps
+// owning: Close leaves it open. Options configuring the connection are
+// ignored.
+func FromConn(conn *grpc.ClientConn, opts ...Option) *Client {
+	return fromConn(conn, newOptions(opts))
+}
+
+func fromConn(conn *grpc.ClientConn, o options) *Client {
+	return &Client{
+		conn:         conn,
+		greeter:      pb.NewGreetingServiceClient(conn),
+		timeout:      o.timeout,
+		reconnect:    o.reconnect,
+		resumePolicy: o.resumePolicy,
+	}
+}
+
+// Conn returns the client's connection, for calling the server's other
+// services
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Close closes the connection New opened
+func (c *Client) Close() error {
+	if !c.ownsConn {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// Name returns a request greeting name
+func Name(name string) *pb.HelloRequest {
+	return &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: name}}
+}
+
+// UserID returns a request greeting the directory user with id
+func UserID(id int64) *pb.HelloRequest {
+	return &pb.HelloRequest{Identity: &pb.HelloRequest_UserId{UserId: id}}
+}
+
+// SayHello sends one greeting request
+func (c *Client) SayHello(ctx context.Context, req *pb.HelloRequest, opts ...grpc.CallOption) (*pb.HelloResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.greeter.SayHello(ctx, req, opts...)
+}
+
+// SayHelloBatch greets several names in one call, in language if not
+// empty. The call fails only if the batch as a whole is invalid; check
+// each result's error.
+func (c *Client) SayHelloBatch(ctx context.Context, names []string, language string, opts ...grpc.CallOption) (*pb.SayHelloBatchResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.greeter.SayHelloBatch(ctx, &pb.SayHelloBatchRequest{Names: names, Language: language}, opts...)
+}
+
+// Conversation is a conversation the server keeps a session for; it holds
+// the session token the other conversation calls send
+type Conversation struct {
+	c     *Client
+	token string
+}
+
+// StartConversation greets name, in language if not empty, and returns the
+// conversation the greeting starts along with it
+func (c *Client) StartConversation(ctx context.Context, name, language string, opts ...grpc.CallOption) (*Conversation, *pb.ConversationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	resp, err := c.greeter.StartConversation(ctx, &pb.StartConversationRequest{Name: name, Language: language}, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &Conversation{c: c, token: resp.GetSessionToken()}, resp, nil
+}
+
+// Conversation returns the conversation of a session token from an earlier
+// StartConversation, e.g. one another process started
+func (c *Client) Conversation(token string) *Conversation {
+	return &Conversation{c: c, token: token}
+}
+
+// Token returns the conversation's session token
+func (v *Conversation) Token() string {
+	return v.token
+}
+
+// Continue greets again within the conversation, switching it to language
+// if not empty. Conversations the server no longer knows, ended or expired,
+// fail with NotFound.
+func (v *Conversation) Continue(ctx context.Context, language string, opts ...grpc.CallOption) (*pb.ConversationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, v.c.timeout)
+	defer cancel()
+	return v.c.greeter.ContinueConversation(ctx, &pb.ContinueConversationRequest{SessionToken: v.token, Language: language}, opts...)
+}
+
+// End ends the conversation, so the server forgets its session
+func (v *Conversation) End(ctx context.Context, opts ...grpc.CallOption) (*pb.EndConversationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, v.c.timeout)
+	defer cancel()
+	return v.c.greeter.EndConversation(ctx, &pb.EndConversationRequest{SessionToken: v.token}, opts...)
+}
+
+// StreamGreetings calls SayHelloMultiple and hands each greeting to fn as
+// it arrives, until the stream ends, ctx is done or fn returns an error,
+// which StreamGreetings then returns. With WithReconnect a stream cut off
+// by a server restart continues with ResumeStream from the last greeting
+// received, or starts over if none was.
+func (c *Client) StreamGreetings(ctx context.Context, req *pb.HelloRequest, fn func(*pb.HelloResponse) error) error {
+	return c.streamGreetings(ctx, func(ctx context.Context) (grpc.ServerStreamingClient[pb.HelloResponse], error) {
+		return c.greeter.SayHelloMultiple(ctx, req)
+	}, fn)
+}
+
+// ResumeGreetings continues a stream after the greeting whose resume
+// token it is given, e.g. one a previous StreamGreetings handed fn before
+// the program stopped, otherwise like StreamGreetings
+func (c *Client) ResumeGreetings(ctx context.Context, token string, fn func(*pb.HelloResponse) error) error {
+	return c.streamGreetings(ctx, c.resume(token), fn)
+}
+
+func (c *Client) resume(token string) func(context.Context) (grpc.ServerStreamingClient[pb.HelloResponse], error) {
+	return func(ctx context.Context) (grpc.ServerStreamingClient[pb.HelloResponse], error) {
+		return c.greeter.ResumeStream(ctx, &pb.ResumeStreamRequest{ResumeToken: token})
+	}
+}
+
+// streamGreetings receives the greetings of the streams open opens, opening
+// a resumed one whenever the connection comes back after a stream broke
+// off. The connection may well stay ready while streams keep failing, so
+// tries back off, and give up after too many in a row without a greeting.
+func (c *Client) streamGreetings(ctx context.Context, open func(context.Context) (grpc.ServerStreamingClient[pb.HelloResponse], error), fn func(*pb.HelloResponse) error) error {
+	var last *pb.HelloResponse
+	for attempt := 1; ; attempt++ {
+		received, err := c.streamOnce(ctx, open, fn)
+		if received != nil {
+			last = received
+			// The stream got somewhere, so the next break starts afresh
+			attempt = 1
+		}
+		var handlerErr handlerError
+		if errors.As(err, &handlerErr) {
+			return handlerErr.err
+		}
+		if err == nil || status.Code(err) != codes.Unavailable || c.reconnect <= 0 || serverBusy(err) || attempt >= c.resumePolicy.MaxAttempts {
+			return err
+		}
+
+		slog.WarnContext(ctx, "🔌 Stream interrupted, waiting to reconnect", "received", last.GetCount(), "wait", c.reconnect, "attempt", attempt+1, "max_attempts", c.resumePolicy.MaxAttempts, logging.Err(err))
+		waitCtx, cancel := context.WithTimeout(ctx, c.reconnect)
+		readyErr := connmgr.New(c.conn).WaitForReady(waitCtx)
+		cancel()
+		if readyErr != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(c.resumePolicy.Backoff(attempt)):
+		}
+
+		if token := last.GetResumeToken(); token != "" {
+			open = c.resume(token)
+			slog.InfoContext(ctx, "🔁 Resuming the stream", "after", last.GetCount())
+		} else {
+			slog.InfoContext(ctx, "🔁 Starting the stream over")
+		}
+	}
+}
+
+// serverBusy reports whether err is a server refusing work because its
+// worker pool is full rather than a connection lost; the connection stays
+// ready, so resuming at once would only be refused again
+func serverBusy(err error) bool {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == workers.SaturatedReason {
+			return true
+		}
+	}
+	return false
+}
+
+// Greetings is StreamGreetings with a channel: greetings arrive on the
+// first channel, which is closed when the stream ends, and the second
+// then receives the error ending it, nil on success
+func (c *Client) Greetings(ctx context.Context, req *pb.HelloRequest) (<-chan *pb.HelloResponse, <-chan error) {
+	greetings := make(chan *pb.HelloResponse)
+	done := make(chan error, 1)
+	go func() {
+		err := c.StreamGreetings(ctx, req, func(resp *pb.HelloResponse) error {
+			select {
+			case greetings <- resp:
+				return nil
+			case <-ctx.Done():
+				return status.FromContextError(ctx.Err()).Err()
+			}
+		})
+		close(greetings)
+		done <- err
+	}()
+	return greetings, done
+}
+
+// handlerError carries an error returned by a StreamGreetings callback, so
+// it is never mistaken for a stream failure worth resuming
+type handlerError struct {
+	err error
+}
+
+func (e handlerError) Error() string {
+	return e.err.Error()
+}
+
+// streamOnce receives one stream, returning the last greeting it received
+func (c *Client) streamOnce(ctx context.Context, open func(context.Context) (grpc.ServerStreamingClient[pb.HelloResponse], error), fn func(*pb.HelloResponse) error) (last *pb.HelloResponse, err error) {
+	// Cancel the call when fn stops it early
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return last, nil
+		}
+		if err != nil {
+			return last, err
+		}
+		if err := fn(resp); err != nil {
+			return last, handlerError{err}
+		}
+		last = resp
+	}
+}
@@ -0,0 +1,176 @@
+package greetingclient_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetingclient"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newClient starts a test server with opts and connects a Client to it
+// with clientOpts
+func newClient(t *testing.T, opts []greetertest.Option, clientOpts ...greetingclient.Option) *greetingclient.Client {
+	t.Helper()
+	ts := greetertest.StartTestServer(t, opts...)
+	c, err := greetingclient.New("passthrough:///bufconn", append(clientOpts, greetingclient.WithDialOptions(ts.Dialer()))...)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// failFirst fails the first n unary calls with code, counting every call in
+// calls
+func failFirst(n int32, code codes.Code, calls *atomic.Int32) greetertest.Option {
+	return greetertest.WithServerOptions(grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if calls.Add(1) <= n {
+			return nil, status.Error(code, "not yet")
+		}
+		return handler(ctx, req)
+	}))
+}
+
+func TestSayHello(t *testing.T) {
+	c := newClient(t, nil)
+
+	resp, err := c.SayHello(context.Background(), greetingclient.Name("Alice"))
+	if err != nil {
+		t.Fatalf("SayHello: %v", err)
+	}
+	if got, want := resp.GetMessage(), "Hello, Alice!"; got != want {
+		t.Errorf("message = %q, want %q", got, want)
+	}
+}
+
+func TestStreamGreetings(t *testing.T) {
+	c := newClient(t, nil)
+
+	req := greetingclient.Name("Bob")
+	req.Count = 3
+	var counts []int32
+	err := c.StreamGreetings(context.Background(), req, func(g *pb.HelloResponse) error {
+		counts = append(counts, g.GetCount())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamGreetings: %v", err)
+	}
+	if len(counts) != 3 || counts[0] != 1 || counts[2] != 3 {
+		t.Errorf("counts = %v, want [1 2 3]", counts)
+	}
+}
+
+func TestStreamGreetingsHandlerError(t *testing.T) {
+	c := newClient(t, nil)
+
+	stop := status.Error(codes.Aborted, "enough")
+	received := 0
+	err := c.StreamGreetings(context.Background(), greetingclient.Name("Bob"), func(*pb.HelloResponse) error {
+		received++
+		return stop
+	})
+	if err != stop {
+		t.Errorf("StreamGreetings = %v, want the handler's error", err)
+	}
+	if received != 1 {
+		t.Errorf("handler called %d times, want 1", received)
+	}
+}
+
+func TestGreetings(t *testing.T) {
+	c := newClient(t, nil)
+
+	req := greetingclient.UserID(3)
+	req.Count = 2
+	greetings, done := c.Greetings(context.Background(), req)
+	var messages []string
+	for g := range greetings {
+		messages = append(messages, g.GetMessage())
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("Greetings: %v", err)
+	}
+	if len(messages) != 2 {
+		t.Errorf("got %d greetings, want 2: %q", len(messages), messages)
+	}
+}
+
+func TestClose(t *testing.T) {
+	ts := greetertest.StartTestServer(t)
+	c, err := greetingclient.New("passthrough:///bufconn", greetingclient.WithDialOptions(ts.Dialer()))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if _, err := c.SayHello(context.Background(), greetingclient.Name("Alice")); status.Code(err) != codes.Canceled {
+		t.Errorf("SayHello after Close = %v, want Canceled", err)
+	}
+
+	// A client made FromConn leaves the connection to its owner
+	if err := greetingclient.FromConn(ts.Conn).Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if _, err := ts.Client.SayHello(context.Background(), greetingclient.Name("Alice")); err != nil {
+		t.Errorf("SayHello on the connection after FromConn's Close: %v", err)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	hang := greetertest.WithServerOptions(grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}))
+	c := newClient(t, []greetertest.Option{hang}, greetingclient.WithTimeout(50*time.Millisecond))
+
+	start := time.Now()
+	_, err := c.SayHello(context.Background(), greetingclient.Name("Alice"))
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("SayHello = %v, want DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("SayHello took %s, want about the 50ms timeout", elapsed)
+	}
+}
+
+func TestWithRetries(t *testing.T) {
+	t.Run("retried", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newClient(t, []greetertest.Option{failFirst(2, codes.Unavailable, &calls)}, greetingclient.WithRetries(3))
+		if _, err := c.SayHello(context.Background(), greetingclient.Name("Alice")); err != nil {
+			t.Fatalf("SayHello: %v", err)
+		}
+		if n := calls.Load(); n != 3 {
+			t.Errorf("server saw %d calls, want 3", n)
+		}
+	})
+	t.Run("disabled", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newClient(t, []greetertest.Option{failFirst(2, codes.Unavailable, &calls)}, greetingclient.WithRetries(1))
+		if _, err := c.SayHello(context.Background(), greetingclient.Name("Alice")); status.Code(err) != codes.Unavailable {
+			t.Fatalf("SayHello = %v, want Unavailable", err)
+		}
+		if n := calls.Load(); n != 1 {
+			t.Errorf("server saw %d calls, want 1", n)
+		}
+	})
+	t.Run("not retryable", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newClient(t, []greetertest.Option{failFirst(1, codes.InvalidArgument, &calls)}, greetingclient.WithRetries(3))
+		if _, err := c.SayHello(context.Background(), greetingclient.Name("Alice")); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("SayHello = %v, want InvalidArgument", err)
+		}
+		if n := calls.Load(); n != 1 {
+			t.Errorf("server saw %d calls, want 1", n)
+		}
+	})
+}
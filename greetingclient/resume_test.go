@@ -0,0 +1,56 @@
+package greetingclient_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetingclient"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamsUnavailable fails every stream with Unavailable while the
+// connection stays ready, recording when each one was opened
+type streamsUnavailable struct {
+	mu     sync.Mutex
+	opened []time.Time
+}
+
+func (s *streamsUnavailable) option() greetertest.Option {
+	return greetertest.WithServerOptions(grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		s.mu.Lock()
+		s.opened = append(s.opened, time.Now())
+		s.mu.Unlock()
+		return status.Error(codes.Unavailable, "try again")
+	}))
+}
+
+func TestStreamGreetingsResumeGivesUp(t *testing.T) {
+	var server streamsUnavailable
+	policy := interceptors.RetryPolicy{MaxAttempts: 4, InitialBackoff: 20 * time.Millisecond, Multiplier: 2}
+	c := newClient(t, []greetertest.Option{server.option()},
+		greetingclient.WithReconnect(time.Second),
+		greetingclient.WithResumePolicy(policy))
+
+	err := c.StreamGreetings(context.Background(), greetingclient.Name("Alice"), func(*pb.HelloResponse) error { return nil })
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("StreamGreetings = %v, want the last Unavailable", err)
+	}
+	if n := len(server.opened); n != policy.MaxAttempts {
+		t.Fatalf("server saw %d streams, want %d", n, policy.MaxAttempts)
+	}
+
+	// Each try waits twice as long as the one before: 20ms, 40ms, 80ms
+	for i := 1; i < len(server.opened); i++ {
+		gap, want := server.opened[i].Sub(server.opened[i-1]), policy.Backoff(i)
+		if gap < want {
+			t.Errorf("gap before try %d = %s, want at least the %s backoff", i+1, gap, want)
+		}
+	}
+}
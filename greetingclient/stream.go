@@ -0,0 +1,37 @@
+package greetingclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+)
+
+// StreamTo calls SayHelloMultiple and writes each message to w, one per
+// line, as soon as it arrives. Writers with buffering (bufio.Writer,
+// http.ResponseWriter) are flushed after every line. It returns the number
+// of messages written, those whose flush failed included. Streams resume
+// as StreamGreetings does.
+func (c *Client) StreamTo(ctx context.Context, req *pb.HelloRequest, w io.Writer) (int, error) {
+	written := 0
+	err := c.StreamGreetings(ctx, req, func(resp *pb.HelloResponse) error {
+		if _, err := fmt.Fprintln(w, resp.GetMessage()); err != nil {
+			return err
+		}
+		written++
+		return flush(w)
+	})
+	return written, err
+}
+
+func flush(w io.Writer) error {
+	switch f := w.(type) {
+	case interface{ Flush() error }:
+		return f.Flush()
+	case http.Flusher:
+		f.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package greetingclient_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetingclient"
+)
+
+func TestStreamTo(t *testing.T) {
+	c := newClient(t, nil)
+
+	req := greetingclient.Name("Carol")
+	req.Count = 3
+	var buf bytes.Buffer
+	n, err := c.StreamTo(context.Background(), req, &buf)
+	if err != nil {
+		t.Fatalf("StreamTo: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("StreamTo wrote %d messages, want 3", n)
+	}
+	var want strings.Builder
+	for i := 1; i <= 3; i++ {
+		fmt.Fprintf(&want, "Hello #%d, Carol! Streaming response %d of 3\n", i, i)
+	}
+	if got := buf.String(); got != want.String() {
+		t.Errorf("StreamTo wrote\n%s\nwant\n%s", got, want.String())
+	}
+}
+
+// failingFlusher keeps what is written but fails to flush it
+type failingFlusher struct {
+	bytes.Buffer
+}
+
+var errFlush = errors.New("flush failed")
+
+func (*failingFlusher) Flush() error {
+	return errFlush
+}
+
+func TestStreamToFlushError(t *testing.T) {
+	c := newClient(t, nil)
+
+	req := greetingclient.Name("Carol")
+	req.Count = 3
+	var w failingFlusher
+	n, err := c.StreamTo(context.Background(), req, &w)
+	if !errors.Is(err, errFlush) {
+		t.Fatalf("StreamTo = %v, want the flush error", err)
+	}
+	// The message was written before the flush failed, so it counts
+	if lines := strings.Count(w.String(), "\n"); n != 1 || lines != 1 {
+		t.Errorf("StreamTo = %d with %d lines written, want 1 and 1", n, lines)
+	}
+}
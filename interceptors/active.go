@@ -0,0 +1,38 @@
+package interceptors
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+)
+
+// ActiveStreams counts in-flight RPCs. Every gRPC call, unary or streaming,
+// runs on its own HTTP/2 stream, so this is the number of streams a
+// graceful stop has to drain.
+type ActiveStreams struct {
+	n atomic.Int64
+}
+
+// Count returns the number of RPCs currently running
+func (a *ActiveStreams) Count() int64 {
+	return a.n.Load()
+}
+
+// UnaryServerInterceptor tracks unary calls
+func (a *ActiveStreams) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		a.n.Add(1)
+		defer a.n.Add(-1)
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor tracks streaming calls
+func (a *ActiveStreams) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		a.n.Add(1)
+		defer a.n.Add(-1)
+		return handler(srv, ss)
+	}
+}
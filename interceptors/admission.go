@@ -0,0 +1,363 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Priority is how urgent a call is, from its x-priority header
+type Priority int
+
+// Priorities, most urgent first
+const (
+	PriorityHigh Priority = iota
+	PriorityNormal
+	PriorityLow
+)
+
+// Priorities lists every priority, most urgent first
+var Priorities = []Priority{PriorityHigh, PriorityNormal, PriorityLow}
+
+var priorityNames = []string{"high", "normal", "low"}
+
+func (p Priority) String() string {
+	return priorityNames[p]
+}
+
+// ParsePriority returns the priority named name: high, normal or low
+func ParsePriority(name string) (Priority, error) {
+	for i, n := range priorityNames {
+		if strings.EqualFold(name, n) {
+			return Priority(i), nil
+		}
+	}
+	return 0, fmt.Errorf("priority must be high, normal or low, got %q", name)
+}
+
+// callPriority returns the priority the call under ctx asked for; calls
+// without one, or with one that isn't known, are normal
+func callPriority(ctx context.Context) Priority {
+	p, err := ParsePriority(metadata.IncomingPriority(ctx))
+	if err != nil {
+		return PriorityNormal
+	}
+	return p
+}
+
+// PriorityWeights are the shares of freed slots each priority gets while
+// calls of several priorities wait, indexed by Priority
+type PriorityWeights [3]int
+
+// DefaultPriorityWeights let 8 high priority calls through for every 4
+// normal and 1 low one while all three wait
+var DefaultPriorityWeights = PriorityWeights{8, 4, 1}
+
+// ParsePriorityWeights reads a comma separated spec such as
+// "high=8,normal=4,low=1"; priorities it leaves out keep their default
+// weight
+func ParsePriorityWeights(spec string) (PriorityWeights, error) {
+	weights := DefaultPriorityWeights
+	for _, field := range strings.Split(spec, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok {
+			return weights, fmt.Errorf("priority weights: want priority=weight, got %q", field)
+		}
+		p, err := ParsePriority(name)
+		if err != nil {
+			return weights, fmt.Errorf("priority weights: %w", err)
+		}
+		w, err := strconv.Atoi(value)
+		if err != nil || w < 1 {
+			return weights, fmt.Errorf("priority weights: %s: want a positive integer, got %q", name, value)
+		}
+		weights[p] = w
+	}
+	return weights, nil
+}
+
+func (w PriorityWeights) String() string {
+	pairs := make([]string, len(Priorities))
+	for i, p := range Priorities {
+		pairs[i] = fmt.Sprintf("%s=%d", p, w[p])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// AdmissionQueue limits how many requests run at once. Requests beyond the
+// limit wait in one bounded queue per priority, told apart by their
+// x-priority header; once a priority's queue is full its new requests are
+// rejected with ResourceExhausted, so a flood of low priority calls can't
+// crowd out high priority ones. Each freed slot goes to the head of one of
+// the queues, picked by weighted round robin: while all wait, every
+// priority gets its weight's share of the slots, so even low priority
+// calls keep moving. Exempt methods skip the queue altogether.
+type AdmissionQueue struct {
+	maxConcurrent int
+	queueSize     int
+	weights       PriorityWeights
+	exempt        []string
+
+	mu       sync.Mutex
+	inFlight int
+	queues   [3][]*admissionWaiter
+	// credit is each priority's smooth weighted round robin balance
+	credit   [3]int
+	admitted [3]int64
+	rejected [3]int64
+}
+
+// admissionWaiter is a queued request; ready is closed once it is given a
+// slot
+type admissionWaiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+// AdmissionOption configures an AdmissionQueue
+type AdmissionOption func(*AdmissionQueue)
+
+// WithPriorityWeights shares freed slots among waiting priorities by w
+// rather than DefaultPriorityWeights
+func WithPriorityWeights(w PriorityWeights) AdmissionOption {
+	return func(q *AdmissionQueue) {
+		q.weights = w
+	}
+}
+
+// WithAdmissionExemptions lets the methods given run without taking a slot:
+// full method names, or service names ending in "/" for all of their
+// methods. Exempt the calls reporting on load, which must answer under it,
+// and long-lived streams, which would otherwise hold their slots for good.
+func WithAdmissionExemptions(methods ...string) AdmissionOption {
+	return func(q *AdmissionQueue) {
+		q.exempt = append(q.exempt, methods...)
+	}
+}
+
+// NewAdmissionQueue allows maxConcurrent requests to run with up to
+// queueSize more of each priority waiting for a slot
+func NewAdmissionQueue(maxConcurrent, queueSize int, opts ...AdmissionOption) *AdmissionQueue {
+	q := &AdmissionQueue{
+		maxConcurrent: maxConcurrent,
+		queueSize:     queueSize,
+		weights:       DefaultPriorityWeights,
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+	return q
+}
+
+// PriorityStats is a snapshot of one priority's queue
+type PriorityStats struct {
+	Priority Priority
+	// Queued requests are waiting for a slot
+	Queued int
+	// Admitted counts the requests given a slot, at once or after waiting
+	Admitted int64
+	// Rejected counts the requests turned away because the queue was full
+	Rejected int64
+}
+
+// Stats returns a snapshot of every priority's queue, most urgent first
+func (q *AdmissionQueue) Stats() []PriorityStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	stats := make([]PriorityStats, len(Priorities))
+	for i, p := range Priorities {
+		stats[i] = PriorityStats{Priority: p, Queued: len(q.queues[p]), Admitted: q.admitted[p], Rejected: q.rejected[p]}
+	}
+	return stats
+}
+
+// Depth returns the number of requests waiting for a slot, of all
+// priorities
+func (q *AdmissionQueue) Depth() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.waiting()
+}
+
+// waiting returns the number of queued requests; q.mu must be held
+func (q *AdmissionQueue) waiting() int {
+	n := 0
+	for _, queue := range q.queues {
+		n += len(queue)
+	}
+	return n
+}
+
+// Capacity returns the maximum number of waiting requests of each priority
+func (q *AdmissionQueue) Capacity() int {
+	return q.queueSize
+}
+
+// InFlight returns the number of requests currently running
+func (q *AdmissionQueue) InFlight() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.inFlight
+}
+
+// Rejected returns how many requests were turned away because their
+// queue was full, of all priorities
+func (q *AdmissionQueue) Rejected() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var n int64
+	for _, rejected := range q.rejected {
+		n += rejected
+	}
+	return n
+}
+
+// admit blocks until the request may run and returns a func releasing its
+// slot
+func (q *AdmissionQueue) admit(ctx context.Context) (func(), error) {
+	p := callPriority(ctx)
+
+	q.mu.Lock()
+	// Requests only skip the queues while nobody waits, so a freed slot
+	// can't be taken from under a queued request
+	if q.inFlight < q.maxConcurrent && q.waiting() == 0 {
+		q.inFlight++
+		q.admitted[p]++
+		q.mu.Unlock()
+		return q.release, nil
+	}
+	if len(q.queues[p]) >= q.queueSize {
+		q.rejected[p]++
+		q.mu.Unlock()
+		return nil, status.Errorf(codes.ResourceExhausted, "server busy: %s priority request queue is full (%d waiting)", p, q.queueSize)
+	}
+	w := &admissionWaiter{ready: make(chan struct{})}
+	q.queues[p] = append(q.queues[p], w)
+	q.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return q.release, nil
+	case <-ctx.Done():
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		if w.granted {
+			// The slot came as the call gave up; pass it on
+			q.inFlight--
+			q.dispatch()
+		} else {
+			q.remove(p, w)
+		}
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// exempted reports whether method runs without taking a slot
+func (q *AdmissionQueue) exempted(method string) bool {
+	for _, e := range q.exempt {
+		if method == e || (strings.HasSuffix(e, "/") && strings.HasPrefix(method, e)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *AdmissionQueue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.inFlight--
+	q.dispatch()
+}
+
+// dispatch hands the free slots to waiting requests; q.mu must be held
+func (q *AdmissionQueue) dispatch() {
+	for q.inFlight < q.maxConcurrent {
+		p, ok := q.next()
+		if !ok {
+			return
+		}
+		w := q.queues[p][0]
+		q.queues[p][0] = nil
+		q.queues[p] = q.queues[p][1:]
+		w.granted = true
+		close(w.ready)
+		q.inFlight++
+		q.admitted[p]++
+	}
+}
+
+// next picks the priority whose queue gets the next slot by smooth
+// weighted round robin among the non-empty queues: each gains its weight
+// in credit, and the one with the most is served and pays back the total
+func (q *AdmissionQueue) next() (Priority, bool) {
+	best, total := -1, 0
+	for _, p := range Priorities {
+		if len(q.queues[p]) == 0 {
+			continue
+		}
+		q.credit[p] += q.weights[p]
+		total += q.weights[p]
+		if best < 0 || q.credit[p] > q.credit[best] {
+			best = int(p)
+		}
+	}
+	if best < 0 {
+		return 0, false
+	}
+	q.credit[best] -= total
+	if len(q.queues[best]) == 1 {
+		// Credit only balances priorities while they wait; one drained
+		// starts afresh next time
+		q.credit[best] = 0
+	}
+	return Priority(best), true
+}
+
+// remove takes w, which gave up waiting, out of p's queue; q.mu must be
+// held
+func (q *AdmissionQueue) remove(p Priority, w *admissionWaiter) {
+	for i, queued := range q.queues[p] {
+		if queued == w {
+			q.queues[p] = append(q.queues[p][:i], q.queues[p][i+1:]...)
+			return
+		}
+	}
+}
+
+// UnaryServerInterceptor queues unary calls behind the concurrency limit
+func (q *AdmissionQueue) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if q.exempted(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		release, err := q.admit(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor queues streaming calls behind the concurrency
+// limit; a stream holds its slot until it finishes
+func (q *AdmissionQueue) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if q.exempted(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		release, err := q.admit(ss.Context())
+		if err != nil {
+			return err
+		}
+		defer release()
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,116 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	queuedMethod = "/greeting.GreetingService/SayHello"
+	exemptMethod = "/greeting.GreetingService/GetStats"
+)
+
+// waitFor polls cond until it holds or a second passes
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestAdmissionQueueSaturation(t *testing.T) {
+	q := NewAdmissionQueue(1, 2, WithAdmissionExemptions(exemptMethod))
+	interceptor := q.UnaryServerInterceptor()
+
+	unblock := make(chan struct{})
+	blocking := func(ctx context.Context, req any) (any, error) {
+		<-unblock
+		return "ok", nil
+	}
+	call := func(method string, handler grpc.UnaryHandler) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	// One call runs and two wait, filling the normal priority queue
+	errs := make(chan error, 3)
+	for range 3 {
+		go func() { errs <- call(queuedMethod, blocking) }()
+	}
+	waitFor(t, "a call in flight and two queued", func() bool {
+		return q.InFlight() == 1 && q.Depth() == 2
+	})
+
+	err := call(queuedMethod, blocking)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("call to a full queue = %v, want ResourceExhausted", err)
+	}
+	if n := q.Rejected(); n != 1 {
+		t.Errorf("Rejected() = %d, want 1", n)
+	}
+
+	// Exempt methods run at once, however full the queue
+	done := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	if err := call(exemptMethod, done); err != nil {
+		t.Errorf("exempt call = %v, want it to run past the queue", err)
+	}
+	if d := q.Depth(); d != 2 {
+		t.Errorf("Depth() after the exempt call = %d, want 2", d)
+	}
+
+	close(unblock)
+	for range 3 {
+		if err := <-errs; err != nil {
+			t.Errorf("queued call = %v, want it admitted once slots free up", err)
+		}
+	}
+	if d, n := q.Depth(), q.InFlight(); d != 0 || n != 0 {
+		t.Errorf("Depth(), InFlight() = %d, %d after draining, want 0, 0", d, n)
+	}
+}
+
+func TestAdmissionQueueExemptStreams(t *testing.T) {
+	q := NewAdmissionQueue(1, 0, WithAdmissionExemptions("/grpc.health.v1.Health/"))
+	interceptor := q.StreamServerInterceptor()
+
+	// A watch holding its stream open doesn't take the only slot
+	started, unblock := make(chan struct{}), make(chan struct{})
+	watching := make(chan error, 1)
+	go func() {
+		watching <- interceptor(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/grpc.health.v1.Health/Watch"}, func(any, grpc.ServerStream) error {
+			close(started)
+			<-unblock
+			return nil
+		})
+	}()
+	<-started
+	err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: queuedMethod}, func(any, grpc.ServerStream) error {
+		return nil
+	})
+	if err != nil {
+		t.Errorf("stream alongside an exempt watch = %v, want it admitted", err)
+	}
+	close(unblock)
+	if err := <-watching; err != nil {
+		t.Errorf("watch = %v", err)
+	}
+}
+
+// fakeServerStream is a grpc.ServerStream with nothing but a context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,245 @@
+package interceptors
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BreakerPolicy controls when a CircuitBreaker opens and how it recovers
+type BreakerPolicy struct {
+	// FailureRate opens the breaker once this fraction (0-1) of the last
+	// Window calls failed
+	FailureRate float64
+	// Window is how many of the latest calls FailureRate is judged over;
+	// the breaker waits for that many before opening, so a single early
+	// failure doesn't open it. Counting calls rather than time keeps it
+	// just as quick when failures slow calls down.
+	Window int
+	// OpenFor is how long the breaker stays open before letting probes
+	// through
+	OpenFor time.Duration
+	// Probes is how many calls are let through half-open; the breaker
+	// closes once they all succeed and opens again on the first failure
+	Probes int
+}
+
+// DefaultBreakerPolicy opens once half of the last 20 calls failed and
+// probes again after 5 seconds
+var DefaultBreakerPolicy = BreakerPolicy{
+	FailureRate: 0.5,
+	Window:      20,
+	OpenFor:     5 * time.Second,
+	Probes:      1,
+}
+
+// BreakerState is the state of a CircuitBreaker
+type BreakerState int
+
+const (
+	// BreakerClosed lets every call through, counting failures
+	BreakerClosed BreakerState = iota
+	// BreakerOpen fails every call at once with Unavailable
+	BreakerOpen
+	// BreakerHalfOpen lets a few probe calls through to see whether the
+	// server has recovered
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerChange is a state transition of a CircuitBreaker
+type BreakerChange struct {
+	From, To BreakerState
+}
+
+// CircuitBreaker stops calling a server that keeps failing: once too many
+// calls fail it opens and fails calls at once with Unavailable, without
+// touching the network, then lets probe calls through to find out when
+// the server is back. Only failures that point at the server count:
+// Unavailable, DeadlineExceeded, ResourceExhausted, Internal and Unknown.
+type CircuitBreaker struct {
+	policy BreakerPolicy
+
+	mu        sync.Mutex
+	state     BreakerState
+	callbacks []func(BreakerChange)
+	// outcomes is a ring of the latest calls' failures while closed, next
+	// the slot the next call goes in
+	outcomes       []bool
+	next, failures int
+	openedAt       time.Time
+	// probing and probed count probes in flight and succeeded while
+	// half-open
+	probing, probed int
+}
+
+// NewCircuitBreaker creates a closed breaker
+func NewCircuitBreaker(policy BreakerPolicy) *CircuitBreaker {
+	return &CircuitBreaker{policy: policy, outcomes: make([]bool, 0, max(policy.Window, 1))}
+}
+
+// OnChange registers fn to be called with every state change. Callbacks
+// run synchronously on the call that caused the change, so they should be
+// quick.
+func (b *CircuitBreaker) OnChange(fn func(BreakerChange)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.callbacks = append(b.callbacks, fn)
+}
+
+// State returns the breaker's current state
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// UnaryClientInterceptor guards unary calls with the breaker. Install it
+// before the retry interceptor so a call and its retries count once.
+func (b *CircuitBreaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		done, err := b.allow(method)
+		if err != nil {
+			return err
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		done(err)
+		return err
+	}
+}
+
+// StreamClientInterceptor guards opening streams with the breaker; errors
+// once a stream is open don't count
+func (b *CircuitBreaker) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		done, err := b.allow(method)
+		if err != nil {
+			return nil, err
+		}
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		done(err)
+		return cs, err
+	}
+}
+
+// allow lets a call through, returning the function to report its outcome
+// with, or fails it with Unavailable when the breaker is open
+func (b *CircuitBreaker) allow(method string) (func(error), error) {
+	b.mu.Lock()
+	var changes []BreakerChange
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.policy.OpenFor {
+		changes = append(changes, b.setState(BreakerHalfOpen))
+	}
+
+	probe := false
+	switch {
+	case b.state == BreakerOpen:
+		b.mu.Unlock()
+		return nil, status.Errorf(codes.Unavailable, "circuit breaker open: %s not attempted after repeated failures", method)
+	case b.state == BreakerHalfOpen && b.probing+b.probed >= b.policy.Probes:
+		b.mu.Unlock()
+		return nil, status.Errorf(codes.Unavailable, "circuit breaker half-open: %s not attempted while probes are in flight", method)
+	case b.state == BreakerHalfOpen:
+		b.probing++
+		probe = true
+	}
+	callbacks := slices.Clone(b.callbacks)
+	b.mu.Unlock()
+	notifyBreaker(callbacks, changes)
+
+	return func(err error) { b.record(probe, breakerFailure(err)) }, nil
+}
+
+// record counts the outcome of a call allow let through
+func (b *CircuitBreaker) record(probe, failed bool) {
+	b.mu.Lock()
+	var changes []BreakerChange
+	switch {
+	case probe:
+		// A probe from an earlier half-open spell may finish after the
+		// counters were reset
+		b.probing = max(b.probing-1, 0)
+		if b.state != BreakerHalfOpen {
+			break
+		}
+		if failed {
+			changes = append(changes, b.setState(BreakerOpen))
+		} else if b.probed++; b.probed >= b.policy.Probes {
+			changes = append(changes, b.setState(BreakerClosed))
+		}
+	case b.state == BreakerClosed:
+		b.push(failed)
+		if len(b.outcomes) == cap(b.outcomes) && float64(b.failures) >= b.policy.FailureRate*float64(len(b.outcomes)) {
+			changes = append(changes, b.setState(BreakerOpen))
+		}
+	}
+	callbacks := slices.Clone(b.callbacks)
+	b.mu.Unlock()
+	notifyBreaker(callbacks, changes)
+}
+
+// setState moves to state, resetting its counters; b.mu must be held
+func (b *CircuitBreaker) setState(state BreakerState) BreakerChange {
+	change := BreakerChange{From: b.state, To: state}
+	b.state = state
+	switch state {
+	case BreakerOpen:
+		b.openedAt = time.Now()
+	case BreakerHalfOpen:
+		b.probing, b.probed = 0, 0
+	case BreakerClosed:
+		b.outcomes, b.next, b.failures = b.outcomes[:0], 0, 0
+	}
+	return change
+}
+
+// push records one outcome, replacing the oldest once the window is full;
+// b.mu must be held
+func (b *CircuitBreaker) push(failed bool) {
+	if len(b.outcomes) < cap(b.outcomes) {
+		b.outcomes = append(b.outcomes, false)
+	} else if b.outcomes[b.next] {
+		b.failures--
+	}
+	b.outcomes[b.next] = failed
+	if failed {
+		b.failures++
+	}
+	b.next = (b.next + 1) % cap(b.outcomes)
+}
+
+func notifyBreaker(callbacks []func(BreakerChange), changes []BreakerChange) {
+	for _, change := range changes {
+		for _, fn := range callbacks {
+			fn(change)
+		}
+	}
+}
+
+// breakerFailure reports whether err suggests the server is in trouble,
+// rather than the request being wrong or the caller giving up
+func breakerFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,201 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Chaos describes faults to inject into calls, to show how clients cope
+// with a misbehaving backend
+type Chaos struct {
+	// Latency is the most delay added before a call runs; each call waits
+	// a random duration up to it
+	Latency time.Duration
+	// ErrorRate is the fraction of calls (0-1) failed before they run,
+	// with a code picked at random from Codes
+	ErrorRate float64
+	Codes     []codes.Code
+	// DropRate is the fraction of streams (0-1) cut off with Unavailable
+	// after a random number of messages
+	DropRate float64
+}
+
+// ParseChaos reads a comma separated spec such as
+// "latency=200ms,error-rate=0.1,codes=unavailable|internal,drop-rate=0.2".
+// Codes default to Unavailable.
+func ParseChaos(spec string) (Chaos, error) {
+	c := Chaos{Codes: []codes.Code{codes.Unavailable}}
+	for _, field := range strings.Split(spec, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok {
+			return Chaos{}, fmt.Errorf("chaos: want key=value, got %q", field)
+		}
+
+		var err error
+		switch key {
+		case "latency":
+			c.Latency, err = time.ParseDuration(value)
+		case "error-rate":
+			c.ErrorRate, err = strconv.ParseFloat(value, 64)
+		case "drop-rate":
+			c.DropRate, err = strconv.ParseFloat(value, 64)
+		case "codes":
+			c.Codes = nil
+			for _, name := range strings.Split(value, "|") {
+				var code codes.Code
+				if err = code.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(name)))); err != nil {
+					break
+				}
+				c.Codes = append(c.Codes, code)
+			}
+		default:
+			err = fmt.Errorf("unknown setting")
+		}
+		if err != nil {
+			return Chaos{}, fmt.Errorf("chaos: %s: %w", key, err)
+		}
+	}
+	return c, nil
+}
+
+// String summarizes the faults for logging
+func (c Chaos) String() string {
+	return fmt.Sprintf("latency<=%s error-rate=%.2f codes=%v drop-rate=%.2f", c.Latency, c.ErrorRate, c.Codes, c.DropRate)
+}
+
+// UnaryServerInterceptor delays and fails unary calls
+func (c Chaos) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := c.inject(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor delays, fails and drops streams
+func (c Chaos) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ss, err := c.injectStream(ss, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// injectStream is inject for streams, which may also be set up to drop
+func (c Chaos) injectStream(ss grpc.ServerStream, method string) (grpc.ServerStream, error) {
+	if err := c.inject(ss.Context(), method); err != nil {
+		return nil, err
+	}
+	if rand.Float64() < c.DropRate {
+		ss = &droppingStream{ServerStream: ss, remaining: 1 + rand.IntN(3), method: method}
+	}
+	return ss, nil
+}
+
+// inject waits out the random latency, then maybe fails the call
+func (c Chaos) inject(ctx context.Context, method string) error {
+	if c.Latency > 0 {
+		t := time.NewTimer(rand.N(c.Latency))
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if len(c.Codes) > 0 && rand.Float64() < c.ErrorRate {
+		code := c.Codes[rand.IntN(len(c.Codes))]
+		slog.InfoContext(ctx, "chaos: failing call", "method", method, "code", code.String())
+		return status.Errorf(code, "chaos: injected %s", code)
+	}
+	return nil
+}
+
+// droppingStream fails once it has sent its allotted messages, as if the
+// connection to the client had been lost mid-stream
+type droppingStream struct {
+	grpc.ServerStream
+	remaining int
+	method    string
+}
+
+func (s *droppingStream) SendMsg(m any) error {
+	if s.remaining == 0 {
+		slog.InfoContext(s.Context(), "chaos: dropping stream", "method", s.method)
+		return status.Error(codes.Unavailable, "chaos: stream dropped")
+	}
+	s.remaining--
+	return s.ServerStream.SendMsg(m)
+}
+
+// ChaosSwitch injects the faults of whichever Chaos is currently set, so
+// chaos can be turned on, changed and off while the server runs
+type ChaosSwitch struct {
+	current atomic.Pointer[Chaos]
+	exempt  []string
+}
+
+// NewChaosSwitch creates a ChaosSwitch, initially off, that never injects
+// faults into the exempt methods: full method names, or service names
+// ending in "/" for all of their methods. Exempt whatever turns chaos off.
+func NewChaosSwitch(exempt ...string) *ChaosSwitch {
+	return &ChaosSwitch{exempt: exempt}
+}
+
+// Set starts injecting c; nil turns chaos off
+func (s *ChaosSwitch) Set(c *Chaos) {
+	s.current.Store(c)
+}
+
+// Current returns the faults being injected, or nil when chaos is off
+func (s *ChaosSwitch) Current() *Chaos {
+	return s.current.Load()
+}
+
+// faults returns the faults to inject into method, or nil for none
+func (s *ChaosSwitch) faults(method string) *Chaos {
+	for _, e := range s.exempt {
+		if method == e || (strings.HasSuffix(e, "/") && strings.HasPrefix(method, e)) {
+			return nil
+		}
+	}
+	return s.Current()
+}
+
+// UnaryServerInterceptor delays and fails unary calls while chaos is on
+func (s *ChaosSwitch) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if c := s.faults(info.FullMethod); c != nil {
+			if err := c.inject(ctx, info.FullMethod); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor delays, fails and drops streams while chaos is on
+func (s *ChaosSwitch) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if c := s.faults(info.FullMethod); c != nil {
+			var err error
+			if ss, err = c.injectStream(ss, info.FullMethod); err != nil {
+				return err
+			}
+		}
+		return handler(srv, ss)
+	}
+}
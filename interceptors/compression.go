@@ -0,0 +1,61 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/metadata"
+)
+
+// ResponseEncodingHeader is the metadata key clients use to choose how the
+// server compresses its responses for one call, e.g. "gzip" or "identity"
+const ResponseEncodingHeader = "x-response-encoding"
+
+// WithResponseEncoding asks the server to compress responses to calls made
+// with the returned context using the named compressor
+func WithResponseEncoding(ctx context.Context, name string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, ResponseEncodingHeader, name)
+}
+
+// UnaryServerResponseCompression compresses unary responses with the
+// compressor requested in the x-response-encoding header
+func UnaryServerResponseCompression() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		selectCompressor(ctx)
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerResponseCompression compresses stream messages with the
+// compressor requested in the x-response-encoding header
+func StreamServerResponseCompression() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		selectCompressor(ss.Context())
+		return handler(srv, ss)
+	}
+}
+
+// selectCompressor applies the requested encoding. Unknown encodings, or
+// ones the client can't decode, fall back to identity.
+func selectCompressor(ctx context.Context) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return
+	}
+	values := md.Get(ResponseEncodingHeader)
+	if len(values) == 0 {
+		return
+	}
+
+	name := values[0]
+	if name != "identity" && encoding.GetCompressor(name) == nil {
+		name = "identity"
+	}
+	if err := grpc.SetSendCompressor(ctx, name); err != nil {
+		slog.WarnContext(ctx, "Falling back to identity encoding", "encoding", values[0], logging.Err(err))
+		grpc.SetSendCompressor(ctx, "identity")
+	}
+}
@@ -0,0 +1,77 @@
+package interceptors_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/encoding/gzip" // Register the gzip compressor
+	"google.golang.org/grpc/stats"
+)
+
+// encodingRecorder records the grpc-encoding of the response headers the
+// client receives; gRPC keeps the header itself from the caller
+type encodingRecorder struct {
+	mu       sync.Mutex
+	encoding string
+}
+
+func (r *encodingRecorder) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (r *encodingRecorder) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *encodingRecorder) HandleConn(context.Context, stats.ConnStats) {}
+
+func (r *encodingRecorder) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if h, ok := s.(*stats.InHeader); ok && h.Client {
+		r.mu.Lock()
+		r.encoding = h.Compression
+		r.mu.Unlock()
+	}
+}
+
+func (r *encodingRecorder) last() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.encoding
+}
+
+func TestResponseCompression(t *testing.T) {
+	tests := []struct {
+		requested string
+		want      string
+	}{
+		{"gzip", "gzip"},
+		{"identity", "identity"},
+		// Unknown encodings fall back to identity
+		{"brotli", "identity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.requested, func(t *testing.T) {
+			recorder := &encodingRecorder{}
+			ts := greetertest.StartTestServer(t,
+				greetertest.WithServerOptions(grpc.UnaryInterceptor(interceptors.UnaryServerResponseCompression())),
+				greetertest.WithDialOptions(grpc.WithStatsHandler(recorder)))
+
+			ctx := interceptors.WithResponseEncoding(context.Background(), tt.requested)
+			resp, err := ts.Client.SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}})
+			if err != nil {
+				t.Fatalf("SayHello: %v", err)
+			}
+			if resp.GetMessage() != "Hello, Alice!" {
+				t.Errorf("message = %q, want it decoded", resp.GetMessage())
+			}
+			if got := recorder.last(); got != tt.want {
+				t.Errorf("grpc-encoding = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
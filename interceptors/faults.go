@@ -0,0 +1,21 @@
+package interceptors
+
+import (
+	"context"
+	"math/rand/v2"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerRandomFailures fails the given fraction of unary calls with
+// Unavailable before they reach the handler, to exercise client retries
+func UnaryServerRandomFailures(rate float64) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if rand.Float64() < rate {
+			return nil, status.Error(codes.Unavailable, "injected failure")
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,67 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerHeaders reads the custom request headers and answers
+// successful calls with the server-version (and echoed request-id) header,
+// and every call with the backend and processing-time trailers. backend
+// names this server instance.
+func UnaryServerHeaders(version, backend string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		logHeaders(ctx, info.FullMethod)
+
+		resp, err := handler(ctx, req)
+		// Failed calls get no headers, only trailers (which carry the
+		// request id too): gRPC's built-in retries treat a call whose
+		// headers arrived as committed and never retry it
+		if err == nil {
+			if err := grpc.SetHeader(ctx, metadata.ResponseHeader(version, requestID(ctx))); err != nil {
+				slog.WarnContext(ctx, "Failed to set response headers", logging.Err(err))
+			}
+		}
+		grpc.SetTrailer(ctx, metadata.ResponseTrailer(backend, time.Since(start)))
+		return resp, err
+	}
+}
+
+// StreamServerHeaders is the streaming counterpart of UnaryServerHeaders.
+// Headers are set before the handler runs so they go out with the first
+// message.
+func StreamServerHeaders(version, backend string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := ss.Context()
+		logHeaders(ctx, info.FullMethod)
+
+		if err := ss.SetHeader(metadata.ResponseHeader(version, requestID(ctx))); err != nil {
+			slog.WarnContext(ctx, "Failed to set response headers", logging.Err(err))
+		}
+		err := handler(srv, ss)
+		ss.SetTrailer(metadata.ResponseTrailer(backend, time.Since(start)))
+		return err
+	}
+}
+
+func logHeaders(ctx context.Context, method string) {
+	if md := metadata.FromIncoming(ctx); md.Locale != "" {
+		slog.DebugContext(ctx, "headers", "method", method, "locale", md.Locale)
+	}
+}
+
+// requestID is the id UnaryServerRequestID settled on, or the one the
+// client sent when that interceptor isn't installed
+func requestID(ctx context.Context) string {
+	if id := logging.RequestID(ctx); id != "" {
+		return id
+	}
+	return metadata.FromIncoming(ctx).ID
+}
@@ -0,0 +1,168 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// HedgePolicy controls how UnaryClientHedging duplicates slow calls
+type HedgePolicy struct {
+	// Delay is how long to wait for an answer before sending another copy
+	// of the call
+	Delay time.Duration
+	// MaxAttempts is the most copies of one call in flight, the original
+	// included; 1 or less disables hedging
+	MaxAttempts int
+	// Methods lists the full method names to hedge; only hedge calls that
+	// are safe to run more than once
+	Methods []string
+}
+
+// HedgeRecorder is told about the duplicate work hedging causes, e.g. to
+// export it as metrics
+type HedgeRecorder interface {
+	// Hedged is called for every copy sent after the original
+	Hedged(method string)
+	// Won is called with the attempt (1 for the original) that answered
+	// first and the number of slower copies that were cancelled
+	Won(method string, attempt, cancelled int)
+}
+
+type hedgeResult struct {
+	attempt int
+	reply   proto.Message
+	outputs *callOutputs
+	err     error
+}
+
+// callOutputs holds what grpc.Header, grpc.Trailer and grpc.Peer options
+// would write for one copy of a call. Copies run at once, so each gets its
+// own and only the winner's reach the caller.
+type callOutputs struct {
+	header, trailer metadata.MD
+	peer            peer.Peer
+}
+
+// privateOutputs returns opts with the caller's header, trailer and peer
+// destinations redirected into out
+func privateOutputs(opts []grpc.CallOption, out *callOutputs) []grpc.CallOption {
+	private := make([]grpc.CallOption, len(opts))
+	for i, opt := range opts {
+		switch opt.(type) {
+		case grpc.HeaderCallOption:
+			private[i] = grpc.Header(&out.header)
+		case grpc.TrailerCallOption:
+			private[i] = grpc.Trailer(&out.trailer)
+		case grpc.PeerCallOption:
+			private[i] = grpc.Peer(&out.peer)
+		default:
+			private[i] = opt
+		}
+	}
+	return private
+}
+
+// deliverOutputs copies the winner's outputs to the caller's destinations
+func deliverOutputs(opts []grpc.CallOption, out *callOutputs) {
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case grpc.HeaderCallOption:
+			*o.HeaderAddr = out.header
+		case grpc.TrailerCallOption:
+			*o.TrailerAddr = out.trailer
+		case grpc.PeerCallOption:
+			*o.PeerAddr = out.peer
+		}
+	}
+}
+
+// UnaryClientHedging sends a call again when no answer arrived within
+// policy.Delay, up to policy.MaxAttempts copies, and returns the first
+// success, cancelling the copies still running. A copy failing with a
+// code retries don't help with (anything but Unavailable or
+// DeadlineExceeded) ends the call with that error. On a balanced connection
+// each copy may reach a different backend. All copies carry the same
+// idempotency key. rec may be nil.
+func UnaryClientHedging(policy HedgePolicy, rec HedgeRecorder) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		out, ok := reply.(proto.Message)
+		if policy.MaxAttempts <= 1 || !ok || !slices.Contains(policy.Methods, method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		// Set the key once so every copy is the same logical call
+		if !hasOutgoingIdempotencyKey(ctx) {
+			ctx = metadata.AppendToOutgoingContext(ctx, IdempotencyKeyHeader, newIdempotencyKey())
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make(chan hedgeResult, policy.MaxAttempts)
+		send := func(attempt int) {
+			r := proto.Clone(out)
+			proto.Reset(r)
+			outputs := &callOutputs{}
+			go func() {
+				err := invoker(ctx, method, req, r, cc, privateOutputs(opts, outputs)...)
+				results <- hedgeResult{attempt: attempt, reply: r, outputs: outputs, err: err}
+			}()
+		}
+
+		send(1)
+		sent, pending := 1, 1
+		timer := time.NewTimer(policy.Delay)
+		defer timer.Stop()
+
+		var last hedgeResult
+		for pending > 0 {
+			select {
+			case <-timer.C:
+				if sent < policy.MaxAttempts {
+					sent++
+					pending++
+					slog.InfoContext(ctx, "Hedging call: no answer yet, sending another copy", "method", method, "delay", policy.Delay, "copy", sent)
+					send(sent)
+					if rec != nil {
+						rec.Hedged(method)
+					}
+					timer.Reset(policy.Delay)
+				}
+			case r := <-results:
+				pending--
+				if r.err == nil {
+					proto.Reset(out)
+					proto.Merge(out, r.reply)
+					deliverOutputs(opts, r.outputs)
+					if rec != nil {
+						rec.Won(method, r.attempt, pending)
+					}
+					return nil
+				}
+				// Errors a retry wouldn't fix won't be fixed by another copy
+				if !retryable(ctx, r.err) {
+					deliverOutputs(opts, r.outputs)
+					return r.err
+				}
+				last = r
+				// Don't wait out the delay when every copy has failed
+				if pending == 0 && sent < policy.MaxAttempts && ctx.Err() == nil {
+					sent++
+					pending++
+					send(sent)
+					if rec != nil {
+						rec.Hedged(method)
+					}
+				}
+			}
+		}
+		deliverOutputs(opts, last.outputs)
+		return last.err
+	}
+}
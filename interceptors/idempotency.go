@@ -0,0 +1,162 @@
+// Package interceptors contains gRPC client and server interceptors shared by
+// the demo binaries. They form the demo's middleware layer: chain them with
+// grpc.ChainUnaryInterceptor / grpc.ChainStreamInterceptor and add your own
+// alongside them.
+package interceptors
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotencyKeyHeader is the metadata key carrying a client-chosen key that
+// identifies one logical call across retry attempts
+const IdempotencyKeyHeader = "idempotency-key"
+
+type cachedResponse struct {
+	resp    proto.Message
+	expires time.Time
+}
+
+// IdempotencyCache remembers successful unary responses by method and
+// idempotency key so a repeated call returns the original result, with an
+// x-cache: hit trailer, instead of running the handler again. A duplicate
+// arriving while the first call is still running, such as a hedged copy,
+// waits for it.
+type IdempotencyCache struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	entries  map[string]cachedResponse
+	inflight map[string]chan struct{}
+}
+
+// NewIdempotencyCache creates a cache that keeps responses for ttl
+func NewIdempotencyCache(ttl time.Duration) *IdempotencyCache {
+	return &IdempotencyCache{
+		ttl:      ttl,
+		entries:  make(map[string]cachedResponse),
+		inflight: make(map[string]chan struct{}),
+	}
+}
+
+// WithIdempotencyKey returns ctx carrying key as the idempotency-key header
+// of outgoing calls, so repeating a call with it returns the first result
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return grpcmd.AppendToOutgoingContext(ctx, IdempotencyKeyHeader, key)
+}
+
+// UnaryClientIdempotencyKey gives every call without one an idempotency
+// key, for when something other than UnaryClientRetry, such as gRPC's own
+// retries configured in the service config, sends the attempts
+func UnaryClientIdempotencyKey() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !hasOutgoingIdempotencyKey(ctx) {
+			ctx = WithIdempotencyKey(ctx, newIdempotencyKey())
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// UnaryServerInterceptor serves cached responses for repeated keys and
+// records the response of the first successful call. Calls carrying a key
+// get an x-cache trailer saying which happened.
+func (c *IdempotencyCache) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key := idempotencyKey(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		// Scope keys to the tenant and method, so a key another tenant
+		// happens to use never returns its response, and one reused for
+		// another method never returns a response of the wrong type
+		key = requestcontext.TenantID(ctx) + " " + info.FullMethod + " " + key
+
+		for {
+			resp, running := c.claim(key)
+			if resp != nil {
+				grpc.SetTrailer(ctx, grpcmd.Pairs(metadata.CacheTrailer, metadata.CacheHit))
+				return resp, nil
+			}
+			if running == nil {
+				break
+			}
+			// Another call with the key is running; take its response, or
+			// run the handler if it failed
+			select {
+			case <-running:
+			case <-ctx.Done():
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+		}
+
+		grpc.SetTrailer(ctx, grpcmd.Pairs(metadata.CacheTrailer, metadata.CacheMiss))
+		// Release the key even if the handler panics, or calls repeating
+		// it would wait forever
+		var msg proto.Message
+		defer func() { c.finish(key, msg) }()
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		msg, _ = resp.(proto.Message)
+		return resp, nil
+	}
+}
+
+// claim returns the cached response for key, or the channel closed when
+// the call already running with key finishes. When it returns neither,
+// the caller now runs the call and must call finish.
+func (c *IdempotencyCache) claim(key string) (proto.Message, chan struct{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[key]; ok && time.Now().Before(entry.expires) {
+		return proto.Clone(entry.resp), nil
+	}
+	if running, ok := c.inflight[key]; ok {
+		return nil, running
+	}
+	c.inflight[key] = make(chan struct{})
+	return nil, nil
+}
+
+// finish records resp for key, unless it is nil because the call failed,
+// and wakes the calls waiting on it
+func (c *IdempotencyCache) finish(key string, resp proto.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	close(c.inflight[key])
+	delete(c.inflight, key)
+	if resp == nil {
+		return
+	}
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cachedResponse{resp: proto.Clone(resp), expires: now.Add(c.ttl)}
+}
+
+func idempotencyKey(ctx context.Context) string {
+	md, ok := grpcmd.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(IdempotencyKeyHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,95 @@
+package interceptors_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fastRetries retries as interceptors.DefaultRetryPolicy does, without the
+// waits
+var fastRetries = interceptors.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Multiplier: 1}
+
+func TestIdempotencyCacheRunsRetriedCallsOnce(t *testing.T) {
+	// The first response is lost on its way out, after the handler ran,
+	// so the client retries a call the server already processed
+	var lost atomic.Bool
+	loseFirstResponse := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil && lost.CompareAndSwap(false, true) {
+			return nil, status.Error(codes.Unavailable, "response lost")
+		}
+		return resp, err
+	}
+	var runs atomic.Int32
+	countRuns := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		runs.Add(1)
+		return handler(ctx, req)
+	}
+	cache := interceptors.NewIdempotencyCache(time.Minute)
+	ts := greetertest.StartTestServer(t,
+		greetertest.WithServerOptions(grpc.ChainUnaryInterceptor(loseFirstResponse, cache.UnaryServerInterceptor(), countRuns)),
+		greetertest.WithDialOptions(grpc.WithChainUnaryInterceptor(interceptors.UnaryClientIdempotencyKey(), interceptors.UnaryClientRetry(fastRetries))))
+
+	var trailer grpcmd.MD
+	resp, err := ts.Client.SayHello(context.Background(), &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}, grpc.Trailer(&trailer))
+	if err != nil {
+		t.Fatalf("SayHello: %v", err)
+	}
+	if n := runs.Load(); n != 1 {
+		t.Errorf("handler ran %d times, want 1", n)
+	}
+	if resp.GetCount() != 1 {
+		t.Errorf("count = %d, want 1: the retry must not greet again", resp.GetCount())
+	}
+	if got := trailer.Get(metadata.CacheTrailer); len(got) != 1 || got[0] != metadata.CacheHit {
+		t.Errorf("%s trailer = %v, want %s", metadata.CacheTrailer, got, metadata.CacheHit)
+	}
+
+	// A new call gets a new key, and runs
+	if _, err := ts.Client.SayHello(context.Background(), &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}); err != nil {
+		t.Fatalf("second SayHello: %v", err)
+	}
+	if n := runs.Load(); n != 2 {
+		t.Errorf("handler ran %d times after a second call, want 2", n)
+	}
+}
+
+func TestIdempotencyCacheKeysPerTenant(t *testing.T) {
+	var runs atomic.Int32
+	countRuns := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		runs.Add(1)
+		return handler(ctx, req)
+	}
+	cache := interceptors.NewIdempotencyCache(time.Minute)
+	ts := greetertest.StartTestServer(t,
+		greetertest.WithServerOptions(grpc.ChainUnaryInterceptor(requestcontext.UnaryServerInterceptor(), cache.UnaryServerInterceptor(), countRuns)),
+		greetertest.WithDialOptions(grpc.WithUnaryInterceptor(requestcontext.UnaryClientInterceptor())))
+
+	// Both tenants happen to pick the same key
+	req := &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}
+	for _, tenant := range []string{"acme", "globex"} {
+		ctx := interceptors.WithIdempotencyKey(requestcontext.WithTenantID(context.Background(), tenant), "same-key")
+		var trailer grpcmd.MD
+		if _, err := ts.Client.SayHello(ctx, req, grpc.Trailer(&trailer)); err != nil {
+			t.Fatalf("SayHello for %s: %v", tenant, err)
+		}
+		if got := trailer.Get(metadata.CacheTrailer); len(got) != 1 || got[0] != metadata.CacheMiss {
+			t.Errorf("%s's %s trailer = %v, want %s", tenant, metadata.CacheTrailer, got, metadata.CacheMiss)
+		}
+	}
+	if n := runs.Load(); n != 2 {
+		t.Errorf("handler ran %d times, want once per tenant", n)
+	}
+}
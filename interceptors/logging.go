@@ -0,0 +1,45 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerLogging writes one structured access log line per unary call.
+// Install it after UnaryServerRequestID so the line names the request.
+func UnaryServerLogging() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logAccess(ctx, "unary", info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerLogging writes one structured access log line per streaming
+// call once the stream finishes
+func StreamServerLogging() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logAccess(ss.Context(), "stream", info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logAccess(ctx context.Context, kind, method string, start time.Time, err error) {
+	slog.InfoContext(ctx, "access", "type", kind, "method", method, "peer", peerAddr(ctx), "code", status.Code(err).String(), "duration", time.Since(start))
+}
+
+// peerAddr returns the caller's address, or "unknown" outside a gRPC call
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
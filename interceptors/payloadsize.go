@@ -0,0 +1,50 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/stats"
+)
+
+// PayloadSizeLogger is a stats handler that logs every message's size
+// before and after compression, so the effect of a compressor such as
+// gzip is visible per call. Install it with grpc.StatsHandler on a server
+// or grpc.WithStatsHandler on a client; interceptors never see compressed
+// sizes.
+type PayloadSizeLogger struct{}
+
+type methodKey struct{}
+
+// TagRPC remembers the method so payload events can name it
+func (PayloadSizeLogger) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, methodKey{}, info.FullMethodName)
+}
+
+// HandleRPC logs inbound and outbound payload sizes
+func (PayloadSizeLogger) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	method, _ := ctx.Value(methodKey{}).(string)
+	switch p := s.(type) {
+	case *stats.InPayload:
+		logPayload(ctx, method, "in", p.Length, p.CompressedLength)
+	case *stats.OutPayload:
+		logPayload(ctx, method, "out", p.Length, p.CompressedLength)
+	}
+}
+
+// TagConn is a no-op; only RPC events are logged
+func (PayloadSizeLogger) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn is a no-op; only RPC events are logged
+func (PayloadSizeLogger) HandleConn(context.Context, stats.ConnStats) {}
+
+func logPayload(ctx context.Context, method, dir string, size, compressed int) {
+	ratio := 100.0
+	if size > 0 {
+		ratio = float64(compressed) * 100 / float64(size)
+	}
+	slog.InfoContext(ctx, "payload", "dir", dir, "method", method, "size", size, "compressed", compressed, "ratio", fmt.Sprintf("%.0f%%", ratio))
+}
@@ -0,0 +1,151 @@
+package interceptors
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/auth"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// idleBucketTTL is how long a client's bucket is kept after its last call
+const idleBucketTTL = 10 * time.Minute
+
+// RateLimiter gives every client its own token bucket. Clients are told
+// apart by their authenticated subject when the call carries one, otherwise
+// by peer IP. Calls over the limit fail with ResourceExhausted and a
+// retry-after trailer saying when a token will be available.
+type RateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewRateLimiter allows each client rps calls per second on average with
+// bursts of up to burst calls; an rps of zero starts it off
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		limit:   rate.Limit(rps),
+		burst:   burst,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// UnaryServerInterceptor rate limits unary calls
+func (l *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := l.allow(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rate limits the opening of streams; messages on
+// an open stream are not counted
+func (l *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.allow(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// SetLimit changes the limit of every client, those with a bucket already
+// included, to rps calls per second with bursts of up to burst calls. An
+// rps of zero lets every call through.
+func (l *RateLimiter) SetLimit(rps float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limit = rate.Limit(rps)
+	l.burst = burst
+	for _, b := range l.buckets {
+		b.limiter.SetLimit(l.limit)
+		b.limiter.SetBurst(burst)
+	}
+}
+
+// Limit returns the calls per second and the burst each client is allowed
+func (l *RateLimiter) Limit() (rps float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return float64(l.limit), l.burst
+}
+
+// allow takes a token from the caller's bucket, or reports when to retry
+func (l *RateLimiter) allow(ctx context.Context) error {
+	key := clientKey(ctx)
+	limiter := l.bucket(key)
+	if limiter == nil {
+		return nil
+	}
+	r := limiter.Reserve()
+	delay := r.Delay()
+	if delay == 0 {
+		return nil
+	}
+	r.Cancel()
+
+	retryAfter := max(delay, time.Millisecond).Round(time.Millisecond)
+	grpc.SetTrailer(ctx, grpcmd.Pairs(metadata.RetryAfterTrailer, strconv.FormatInt(retryAfter.Milliseconds(), 10)))
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s; retry in %s", key, retryAfter)
+}
+
+// bucket returns key's token bucket, or nil while the limiter is off
+func (l *RateLimiter) bucket(key string) *rate.Limiter {
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limit == 0 {
+		return nil
+	}
+
+	if now.Sub(l.lastSweep) > time.Minute {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastSeen) > idleBucketTTL {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	return b.limiter
+}
+
+// clientKey identifies the caller: its token subject, else its IP address
+func clientKey(ctx context.Context) string {
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return "subject:" + claims.Subject
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return "ip:" + host
+		}
+		return "ip:" + p.Addr.String()
+	}
+	return "unknown"
+}
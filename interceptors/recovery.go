@@ -0,0 +1,80 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"path"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PanicRecorder is told about every panic the recovery interceptors catch,
+// e.g. to export them as metrics
+type PanicRecorder interface {
+	Panicked(method string)
+}
+
+// UnaryServerRecovery turns a panic in a later interceptor or the handler
+// into an Internal error for that call alone, logging the stack trace,
+// instead of letting it crash the server. rec may be nil.
+func UnaryServerRecovery(rec PanicRecorder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				resp, err = nil, recovered(ctx, info.FullMethod, r, rec)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerRecovery is the streaming counterpart of UnaryServerRecovery
+func StreamServerRecovery(rec PanicRecorder) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ss.Context(), info.FullMethod, r, rec)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// recovered logs and records the panic r and returns the error the client
+// gets, which keeps the panic value to the server's logs
+func recovered(ctx context.Context, fullMethod string, r any, rec PanicRecorder) error {
+	slog.ErrorContext(ctx, "💥 Recovered from a panic", "method", fullMethod, "panic", r, "stack", string(debug.Stack()))
+	if rec != nil {
+		rec.Panicked(fullMethod)
+	}
+	return status.Errorf(codes.Internal, "%s failed unexpectedly", path.Base(fullMethod))
+}
+
+// UnaryServerPanicOn panics in place of the handler of calls to method, a
+// full or bare method name such as "SayHello", to show recovery at work.
+// Debugging only.
+func UnaryServerPanicOn(method string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if matchesMethod(info.FullMethod, method) {
+			panic("panic requested by -panic-on " + method)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerPanicOn is the streaming counterpart of UnaryServerPanicOn
+func StreamServerPanicOn(method string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if matchesMethod(info.FullMethod, method) {
+			panic("panic requested by -panic-on " + method)
+		}
+		return handler(srv, ss)
+	}
+}
+
+func matchesMethod(fullMethod, method string) bool {
+	return fullMethod == method || path.Base(fullMethod) == method
+}
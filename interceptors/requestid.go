@@ -0,0 +1,79 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+// UnaryServerRequestID takes the request id from the request-id header, or
+// makes one up when the client sent none, and puts it in the handler's
+// context so every line logged for the call carries it. The id is also
+// returned in the request-id trailer. Install it before the interceptors
+// that log.
+func UnaryServerRequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = incomingRequestID(ctx)
+		grpc.SetTrailer(ctx, grpcmd.Pairs(metadata.RequestIDHeader, logging.RequestID(ctx)))
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerRequestID is the streaming counterpart of
+// UnaryServerRequestID
+func StreamServerRequestID() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := incomingRequestID(ss.Context())
+		ss.SetTrailer(grpcmd.Pairs(metadata.RequestIDHeader, logging.RequestID(ctx)))
+		return handler(srv, &requestIDStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func incomingRequestID(ctx context.Context) context.Context {
+	id := metadata.FromIncoming(ctx).ID
+	if id == "" {
+		id = logging.NewRequestID()
+	}
+	return logging.WithRequestID(ctx, id)
+}
+
+type requestIDStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDStream) Context() context.Context {
+	return s.ctx
+}
+
+// UnaryClientRequestID sends every call with a request-id header: the one
+// already attached to the context, or a new one. The context handed on
+// carries the id too, so retries and hedged copies of the call log and send
+// the same id. Install it first in the chain.
+func UnaryClientRequestID() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingRequestID(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientRequestID is the streaming counterpart of
+// UnaryClientRequestID
+func StreamClientRequestID() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingRequestID(ctx), desc, cc, method, opts...)
+	}
+}
+
+func outgoingRequestID(ctx context.Context) context.Context {
+	if id := metadata.OutgoingRequestID(ctx); id != "" {
+		return logging.WithRequestID(ctx, id)
+	}
+	id := logging.RequestID(ctx)
+	if id == "" {
+		id = logging.NewRequestID()
+	}
+	return metadata.WithRequestID(logging.WithRequestID(ctx, id), id)
+}
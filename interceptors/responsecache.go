@@ -0,0 +1,204 @@
+package interceptors
+
+import (
+	"container/list"
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// CacheRecorder is told whether each cacheable call was served from the
+// response cache, e.g. to export the hit ratio as metrics
+type CacheRecorder interface {
+	CacheHit(method string)
+	CacheMiss(method string)
+}
+
+// ResponseCache memoizes successful responses of read-only unary methods,
+// keyed by method, request and the headers the response varies on, such as
+// the locale. It holds at most a fixed number of responses, evicting the
+// least recently used, each for a limited time. Cache hits skip the handler
+// entirely, so anything it records, such as the greeting history, misses
+// them too.
+type ResponseCache struct {
+	size    int
+	ttl     time.Duration
+	methods map[string]bool
+	vary    []string
+	rec     CacheRecorder
+
+	mu      sync.Mutex
+	lru     *list.List // front is the most recently used *cacheEntry
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key     string
+	resp    proto.Message
+	header  grpcmd.MD
+	expires time.Time
+}
+
+// NewResponseCache creates a cache of up to size responses of methods, full
+// method names, kept for ttl. Requests differing in a vary header are
+// cached apart. rec may be nil.
+func NewResponseCache(size int, ttl time.Duration, methods, vary []string, rec CacheRecorder) *ResponseCache {
+	c := &ResponseCache{
+		size:    size,
+		ttl:     ttl,
+		methods: make(map[string]bool),
+		vary:    vary,
+		rec:     rec,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+	for _, m := range methods {
+		c.methods[m] = true
+	}
+	return c
+}
+
+// UnaryServerInterceptor answers cacheable calls from the cache, with an
+// x-cache: hit trailer and the headers the handler originally set, and
+// caches the response of every successful call that missed
+func (c *ResponseCache) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !c.methods[info.FullMethod] || !ok {
+			return handler(ctx, req)
+		}
+		key, err := c.key(ctx, info.FullMethod, msg)
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		if resp, header, ok := c.get(key); ok {
+			c.record(info.FullMethod, true)
+			if len(header) > 0 {
+				grpc.SetHeader(ctx, header)
+			}
+			grpc.SetTrailer(ctx, grpcmd.Pairs(metadata.CacheTrailer, metadata.CacheHit))
+			return resp, nil
+		}
+		c.record(info.FullMethod, false)
+
+		// Capture the headers the handler sets, to replay them on hits
+		stream := &headerRecorder{ServerTransportStream: grpc.ServerTransportStreamFromContext(ctx)}
+		resp, err := handler(grpc.NewContextWithServerTransportStream(ctx, stream), req)
+		if err != nil {
+			return nil, err
+		}
+		grpc.SetTrailer(ctx, grpcmd.Pairs(metadata.CacheTrailer, metadata.CacheMiss))
+		if msg, ok := resp.(proto.Message); ok {
+			c.put(key, msg, stream.header)
+		}
+		return resp, nil
+	}
+}
+
+// Flush empties the cache, returning how many responses it dropped
+func (c *ResponseCache) Flush() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := c.lru.Len()
+	c.lru.Init()
+	clear(c.entries)
+	return n
+}
+
+// Len returns the number of cached responses, expired ones included until
+// they are next looked up or evicted
+func (c *ResponseCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// key identifies a call by method, vary headers and the request's
+// deterministic encoding
+func (c *ResponseCache) key(ctx context.Context, method string, req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	md, _ := grpcmd.FromIncomingContext(ctx)
+	var key strings.Builder
+	key.WriteString(method)
+	for _, header := range c.vary {
+		key.WriteString("\x00" + strings.Join(md.Get(header), ","))
+	}
+	key.WriteString("\x00")
+	key.Write(b)
+	return key.String(), nil
+}
+
+func (c *ResponseCache) get(key string) (proto.Message, grpcmd.MD, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if time.Now().After(entry.expires) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return nil, nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return proto.Clone(entry.resp), entry.header.Copy(), true
+}
+
+func (c *ResponseCache) put(key string, resp proto.Message, header grpcmd.MD) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{key: key, resp: proto.Clone(resp), header: header, expires: time.Now().Add(c.ttl)}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		slog.Debug("Evicted cached response", "size", c.size)
+	}
+}
+
+func (c *ResponseCache) record(method string, hit bool) {
+	switch {
+	case c.rec == nil:
+	case hit:
+		c.rec.CacheHit(method)
+	default:
+		c.rec.CacheMiss(method)
+	}
+}
+
+// headerRecorder passes headers on to the real stream and keeps a copy
+type headerRecorder struct {
+	grpc.ServerTransportStream
+	header grpcmd.MD
+}
+
+func (s *headerRecorder) SetHeader(md grpcmd.MD) error {
+	s.header = grpcmd.Join(s.header, md)
+	return s.ServerTransportStream.SetHeader(md)
+}
+
+func (s *headerRecorder) SendHeader(md grpcmd.MD) error {
+	s.header = grpcmd.Join(s.header, md)
+	return s.ServerTransportStream.SendHeader(md)
+}
@@ -0,0 +1,118 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"math"
+	mathrand "math/rand/v2"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy controls how UnaryClientRetry retries transient failures
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries, including the first one
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration
+	// Multiplier grows the wait after every retry
+	Multiplier float64
+	// Jitter randomizes each wait by up to this fraction (0.2 = ±20%) so
+	// many clients don't retry in lockstep
+	Jitter float64
+}
+
+// DefaultRetryPolicy is a reasonable policy for interactive calls
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// Backoff returns the wait before retry number n (starting at 1)
+func (p RetryPolicy) Backoff(n int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(n-1))
+	if max := float64(p.MaxBackoff); p.MaxBackoff > 0 && d > max {
+		d = max
+	}
+	if p.Jitter > 0 {
+		d *= 1 + p.Jitter*(2*mathrand.Float64()-1)
+	}
+	return time.Duration(d)
+}
+
+type retryPolicyKey struct{}
+
+// WithRetryPolicy overrides the interceptor's policy for calls made with
+// the returned context, e.g. MaxAttempts: 1 to disable retries for one call
+func WithRetryPolicy(ctx context.Context, p RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, p)
+}
+
+// retryable reports whether a failed attempt may be tried again. A
+// DeadlineExceeded caused by the caller's own deadline is final.
+func retryable(ctx context.Context, err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return true
+	case codes.DeadlineExceeded:
+		return ctx.Err() == nil
+	default:
+		return false
+	}
+}
+
+// UnaryClientRetry retries calls that fail with Unavailable or
+// DeadlineExceeded using exponential backoff with jitter. Every attempt of
+// one logical call carries the same idempotency key so the server can
+// return the original result instead of processing the request twice.
+func UnaryClientRetry(policy RetryPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		p := policy
+		if override, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+			p = override
+		}
+
+		// Set the key once, before the first attempt, so retries reuse it
+		if !hasOutgoingIdempotencyKey(ctx) {
+			ctx = metadata.AppendToOutgoingContext(ctx, IdempotencyKeyHeader, newIdempotencyKey())
+		}
+
+		var err error
+		for attempt := 1; ; attempt++ {
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= p.MaxAttempts || !retryable(ctx, err) {
+				return err
+			}
+
+			wait := p.Backoff(attempt)
+			slog.InfoContext(ctx, "Retrying call", "method", method, "wait", wait.Round(time.Millisecond), "attempt", attempt+1, "max_attempts", p.MaxAttempts, "code", status.Code(err).String())
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(wait):
+			}
+		}
+	}
+}
+
+func hasOutgoingIdempotencyKey(ctx context.Context) bool {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	return ok && len(md.Get(IdempotencyKeyHeader)) > 0
+}
+
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,24 @@
+package interceptors
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, Multiplier: 2, MaxBackoff: 300 * time.Millisecond, Jitter: 0.2}
+	seen := make(map[time.Duration]bool)
+	for range 20 {
+		for n, base := range []time.Duration{100, 200, 300, 300} {
+			base *= time.Millisecond
+			d := policy.Backoff(n + 1)
+			if d < base*8/10 || d > base*12/10 {
+				t.Fatalf("backoff %d = %s, want within 20%% of %s", n+1, d, base)
+			}
+			seen[d] = true
+		}
+	}
+	if len(seen) < 10 {
+		t.Errorf("backoffs took %d distinct values, want them jittered", len(seen))
+	}
+}
@@ -0,0 +1,136 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TimeoutPolicy caps how long the server spends on each method, whatever
+// deadline the client sent, and reports unary calls that run slow
+type TimeoutPolicy struct {
+	// Limits maps a method to the longest it may run. Keys are a full
+	// method ("/greeting.GreetingService/SayHello"), a bare method name
+	// matching it in every service ("SayHello"), or "*" for every method
+	// not listed otherwise.
+	Limits map[string]time.Duration
+	// SlowAfter logs a warning for unary calls running longer, whether or
+	// not they finish in time; zero disables the warning. Streams are
+	// expected to run long and aren't reported.
+	SlowAfter time.Duration
+}
+
+// ParseTimeouts reads a comma separated spec of method=duration pairs such
+// as "SayHello=2s,SayHelloMultiple=30s,*=10s" into TimeoutPolicy.Limits
+func ParseTimeouts(spec string) (map[string]time.Duration, error) {
+	limits := make(map[string]time.Duration)
+	for _, field := range strings.Split(spec, ",") {
+		method, value, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("timeouts: want method=duration, got %q", field)
+		}
+		limit, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("timeouts: %s: %w", method, err)
+		}
+		if limit <= 0 {
+			return nil, fmt.Errorf("timeouts: %s: want a positive duration, got %s", method, value)
+		}
+		limits[method] = limit
+	}
+	return limits, nil
+}
+
+// String summarizes the limits for logging
+func (p TimeoutPolicy) String() string {
+	pairs := make([]string, 0, len(p.Limits))
+	for method, limit := range p.Limits {
+		pairs = append(pairs, method+"="+limit.String())
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// limit returns the longest fullMethod may run, or zero for no limit
+func (p TimeoutPolicy) limit(fullMethod string) time.Duration {
+	if limit, ok := p.Limits[fullMethod]; ok {
+		return limit
+	}
+	if limit, ok := p.Limits[path.Base(fullMethod)]; ok {
+		return limit
+	}
+	return p.Limits["*"]
+}
+
+// UnaryServerInterceptor runs each call's handler with its method's limit
+// as deadline, failing calls that overrun it with DeadlineExceeded
+func (p TimeoutPolicy) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		limit := p.limit(info.FullMethod)
+		callCtx, cancel := withLimit(ctx, limit)
+		defer cancel()
+
+		resp, err := handler(callCtx, req)
+		if took := time.Since(start); p.SlowAfter > 0 && took > p.SlowAfter {
+			slog.WarnContext(ctx, "🐢 Slow call", "method", info.FullMethod, "took", took, "threshold", p.SlowAfter)
+		}
+		if overran(ctx, callCtx) {
+			return nil, overrunError(info.FullMethod, limit)
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor
+func (p TimeoutPolicy) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		limit := p.limit(info.FullMethod)
+		if limit <= 0 {
+			return handler(srv, ss)
+		}
+		ctx, cancel := withLimit(ss.Context(), limit)
+		defer cancel()
+
+		err := handler(srv, &timeoutStream{ServerStream: ss, ctx: ctx})
+		if overran(ss.Context(), ctx) {
+			return overrunError(info.FullMethod, limit)
+		}
+		return err
+	}
+}
+
+func withLimit(ctx context.Context, limit time.Duration) (context.Context, context.CancelFunc) {
+	if limit <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, limit)
+}
+
+// overran reports whether callCtx ended because of the method's limit
+// rather than anything the client did
+func overran(parent, callCtx context.Context) bool {
+	return parent.Err() == nil && callCtx.Err() == context.DeadlineExceeded
+}
+
+func overrunError(fullMethod string, limit time.Duration) error {
+	return status.Errorf(codes.DeadlineExceeded, "%s ran past the server's %s limit", path.Base(fullMethod), limit)
+}
+
+type timeoutStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *timeoutStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,159 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// validator is implemented by messages generated by protoc-gen-validate
+type validator interface {
+	ValidateAll() error
+}
+
+// The protoc-gen-validate error types, matched by behavior since each
+// message has its own
+type (
+	multiError interface {
+		AllErrors() []error
+	}
+	fieldError interface {
+		Field() string
+		Reason() string
+		Cause() error
+	}
+)
+
+// UnaryServerValidation rejects requests breaking the (validate.rules) in
+// their proto definition with InvalidArgument before they reach the
+// handler. Messages without rules pass through.
+func UnaryServerValidation() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validateMessage(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerValidation checks every message the client streams in; the
+// stream fails on the first invalid one
+func StreamServerValidation() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateMessage(m)
+}
+
+// validateMessage returns InvalidArgument with an errdetails.BadRequest
+// listing each violation by field path, e.g. "user.name"
+func validateMessage(m any) error {
+	v, ok := m.(validator)
+	if !ok {
+		return nil
+	}
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	var desc protoreflect.MessageDescriptor
+	if pm, ok := m.(proto.Message); ok {
+		desc = pm.ProtoReflect().Descriptor()
+	}
+	violations := fieldViolations(err, desc, "")
+	st, detailErr := status.New(codes.InvalidArgument, "invalid request").
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
+
+// fieldViolations lists the violations in err, following the causes of
+// nested messages' violations down to the offending field. Fields are
+// named as in the .proto file and joined with dots, e.g. "user.user_id".
+func fieldViolations(err error, desc protoreflect.MessageDescriptor, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var all []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			all = append(all, fieldViolations(e, desc, prefix)...)
+		}
+		return all
+	}
+
+	fe, ok := err.(fieldError)
+	if !ok {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+	name, field := protoField(desc, fe.Field())
+	path := name
+	if prefix != "" {
+		path = prefix + "." + name
+	}
+	if cause := fe.Cause(); cause != nil && isValidationError(cause) {
+		var nested protoreflect.MessageDescriptor
+		if field != nil {
+			nested = field.Message()
+		}
+		return fieldViolations(cause, nested, path)
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: path, Description: fe.Reason()}}
+}
+
+// protoField maps the Go field name protoc-gen-validate reports, such as
+// "UserId", back to the field (or oneof) in desc, such as "user_id"
+func protoField(desc protoreflect.MessageDescriptor, goName string) (string, protoreflect.FieldDescriptor) {
+	if desc == nil {
+		return goName, nil
+	}
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); goCamelCase(string(fd.Name())) == goName {
+			return string(fd.Name()), fd
+		}
+	}
+	oneofs := desc.Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		if od := oneofs.Get(i); goCamelCase(string(od.Name())) == goName {
+			return string(od.Name()), nil
+		}
+	}
+	return goName, nil
+}
+
+// goCamelCase names a proto field the way protoc-gen-go does for the
+// usual snake_case names: user_id becomes UserId
+func goCamelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func isValidationError(err error) bool {
+	var multi multiError
+	var fe fieldError
+	return errors.As(err, &multi) || errors.As(err, &fe)
+}
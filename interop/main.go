@@ -0,0 +1,95 @@
+// The interop binary runs a scripted suite of calls against a greeting
+// server and reports which behaved as the service promises: all four kinds
+// of RPC, deadlines, cancellation, large messages, compression, metadata
+// echo and, given the server's -auth-secret, authentication failures. It
+// exits 1 if any scenario failed, so forks of this template can run it as
+// a conformance check in CI:
+//
+//	go run ./server -auth-secret s3cret -admin
+//	go run ./interop -addr localhost:50051 -auth-secret s3cret
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/certs"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip" // Register the gzip compressor
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "address of the greeting server to test")
+	useTLS := flag.Bool("tls", false, "connect over TLS")
+	caFile := flag.String("tls-ca", "", "CA PEM file used to verify the server (system roots when empty)")
+	serverName := flag.String("tls-server-name", "", "override the server name verified against its certificate")
+	secret := flag.String("auth-secret", "", "the server's -auth-secret, to mint tokens with and run the authentication scenarios; they are skipped without it")
+	timeout := flag.Duration("timeout", 10*time.Second, "deadline of each scenario")
+	run := flag.String("run", "", "only run the scenarios whose name matches this regular expression")
+	flag.Parse()
+	if err := logging.Setup(os.Stderr, "text", "info"); err != nil {
+		log.Fatalf("Failed to configure logging: %v", err)
+	}
+	filter, err := regexp.Compile(*run)
+	if err != nil {
+		fatal("Invalid -run pattern", logging.Err(err))
+	}
+
+	creds := insecure.NewCredentials()
+	if *useTLS {
+		tlsConfig, err := certs.ClientConfig(*caFile, "", "", *serverName)
+		if err != nil {
+			fatal("Failed to set up TLS", logging.Err(err))
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		fatal("Failed to create the client", "addr", *addr, logging.Err(err))
+	}
+	defer conn.Close()
+
+	s := &suite{conn: conn, secret: *secret, tls: *useTLS}
+	var passed, failed, skipped int
+	start := time.Now()
+	for _, sc := range scenarios {
+		if !filter.MatchString(sc.name) {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+		began := time.Now()
+		err := sc.run(ctx, s)
+		elapsed := time.Since(began).Round(time.Millisecond)
+		cancel()
+		switch reason, skip := err.(skipError); {
+		case err == nil:
+			passed++
+			fmt.Printf("✅ %-28s %v\n", sc.name, elapsed)
+		case skip:
+			skipped++
+			fmt.Printf("⏭️  %-28s skipped: %s\n", sc.name, reason)
+		default:
+			failed++
+			fmt.Printf("❌ %-28s %v\n", sc.name, err)
+		}
+	}
+	fmt.Printf("\n🏁 %d passed, %d failed, %d skipped in %v\n", passed, failed, skipped, time.Since(start).Round(time.Millisecond))
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// fatal logs msg at error level and exits, like log.Fatal
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
@@ -0,0 +1,399 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/auth"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	adminpb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/admin"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+)
+
+// tokenTTL is how long the tokens minted for the scenarios stay valid
+const tokenTTL = 5 * time.Minute
+
+// suite is what the scenarios share: the connection and how to
+// authenticate on it
+type suite struct {
+	conn   *grpc.ClientConn
+	secret string
+	tls    bool
+}
+
+// scenario is one check of the suite; run returns nil when the server
+// behaved as expected, or a skipError when the check doesn't apply
+type scenario struct {
+	name string
+	run  func(ctx context.Context, s *suite) error
+}
+
+// skipError is why a scenario was skipped
+type skipError string
+
+func (e skipError) Error() string { return string(e) }
+
+// scenarios are run in this order
+var scenarios = []scenario{
+	{"unary", unary},
+	{"server-streaming", serverStreaming},
+	{"client-streaming", clientStreaming},
+	{"bidi-streaming", bidiStreaming},
+	{"validation", validation},
+	{"deadline-exceeded", deadlineExceeded},
+	{"cancellation", cancellation},
+	{"large-unary", largeUnary},
+	{"large-streaming", largeStreaming},
+	{"large-over-limit", largeOverLimit},
+	{"compression-gzip", compressionGzip},
+	{"metadata-echo", metadataEcho},
+	{"auth-missing-token", authMissingToken},
+	{"auth-invalid-token", authInvalidToken},
+	{"auth-admin-role", authAdminRole},
+}
+
+func (s *suite) greeter() pb.GreetingServiceClient {
+	return pb.NewGreetingServiceClient(s.conn)
+}
+
+// callOptions returns the options authenticating a call as an ordinary
+// user, with no roles, when the suite has the server's secret
+func (s *suite) callOptions(opts ...grpc.CallOption) ([]grpc.CallOption, error) {
+	if s.secret == "" {
+		return opts, nil
+	}
+	token, err := auth.NewIssuer(s.secret).Issue("interop", tokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, withToken(token, s.tls)), nil
+}
+
+func withToken(token string, tls bool) grpc.CallOption {
+	return grpc.PerRPCCredentials(auth.TokenCredentials{Token: token, RequireTLS: tls})
+}
+
+// expectCode returns nil if err has code want, and an error saying what
+// the call ended with otherwise
+func expectCode(err error, want codes.Code) error {
+	if got := status.Code(err); got != want {
+		return fmt.Errorf("want %s, got %s: %v", want, got, status.Convert(err).Message())
+	}
+	return nil
+}
+
+func unary(ctx context.Context, s *suite) error {
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	resp, err := s.greeter().SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Interop"}}, opts...)
+	if err != nil {
+		return err
+	}
+	if resp.GetMessage() == "" {
+		return errors.New("empty greeting")
+	}
+	if resp.GetCount() < 1 {
+		return fmt.Errorf("count is %d, want at least 1", resp.GetCount())
+	}
+	return nil
+}
+
+func serverStreaming(ctx context.Context, s *suite) error {
+	const want = 3
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	stream, err := s.greeter().SayHelloMultiple(ctx, &pb.HelloRequest{
+		Identity:   &pb.HelloRequest_Name{Name: "Interop"},
+		Count:      want,
+		IntervalMs: 1,
+	}, opts...)
+	if err != nil {
+		return err
+	}
+	got := 0
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		got++
+		if resp.GetMessage() == "" {
+			return fmt.Errorf("greeting %d is empty", got)
+		}
+	}
+	if got != want {
+		return fmt.Errorf("got %d greetings, want %d", got, want)
+	}
+	return nil
+}
+
+func clientStreaming(ctx context.Context, s *suite) error {
+	names := []string{"Alice", "Bob", "Carol"}
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	stream, err := s.greeter().SayHelloToEveryone(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := stream.Send(&pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: name}}); err != nil {
+			break // CloseAndRecv returns the status that ended the stream
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	if int(resp.GetCount()) != len(names) {
+		return fmt.Errorf("count is %d, want %d", resp.GetCount(), len(names))
+	}
+	return nil
+}
+
+func bidiStreaming(ctx context.Context, s *suite) error {
+	names := []string{"Alice", "Bob", "Carol"}
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	stream, err := s.greeter().GreetEveryone(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	// Each greeting must arrive before the next name is sent
+	for _, name := range names {
+		if err := stream.Send(&pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: name}}); err != nil {
+			_, err = stream.Recv()
+			return err
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if resp.GetMessage() == "" {
+			return fmt.Errorf("greeting for %s is empty", name)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	if _, err := stream.Recv(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("want the stream to end after %d greetings, got %v", len(names), err)
+	}
+	return nil
+}
+
+func validation(ctx context.Context, s *suite) error {
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	_, err = s.greeter().SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: ""}}, opts...)
+	return expectCode(err, codes.InvalidArgument)
+}
+
+// deadlineExceeded asks for a stream longer than the call's deadline
+func deadlineExceeded(ctx context.Context, s *suite) error {
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+	stream, err := s.greeter().SayHelloMultiple(ctx, &pb.HelloRequest{
+		Identity:   &pb.HelloRequest_Name{Name: "Interop"},
+		Count:      5,
+		IntervalMs: 500,
+	}, opts...)
+	if err != nil {
+		return expectCode(err, codes.DeadlineExceeded)
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			return expectCode(err, codes.DeadlineExceeded)
+		}
+	}
+}
+
+// cancellation cancels a stream once its first greeting arrives
+func cancellation(ctx context.Context, s *suite) error {
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := s.greeter().SayHelloMultiple(ctx, &pb.HelloRequest{
+		Identity:   &pb.HelloRequest_Name{Name: "Interop"},
+		Count:      5,
+		IntervalMs: 500,
+	}, opts...)
+	if err != nil {
+		return err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	cancel()
+	for {
+		if _, err := stream.Recv(); err != nil {
+			return expectCode(err, codes.Canceled)
+		}
+	}
+}
+
+// largeSize is the payload of the large message scenarios: well over the
+// usual HTTP/2 frame and flow control windows, under the 4 MiB gRPC
+// receives by default
+const largeSize = 1 << 20
+
+func largeUnary(ctx context.Context, s *suite) error {
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	resp, err := s.greeter().SayHelloLarge(ctx, &pb.SayHelloLargeRequest{Name: "Interop", SizeBytes: largeSize}, opts...)
+	if err != nil {
+		return err
+	}
+	if len(resp.GetPayload()) != largeSize {
+		return fmt.Errorf("payload is %d bytes, want %d", len(resp.GetPayload()), largeSize)
+	}
+	return nil
+}
+
+// largeStreaming asks for a payload larger than a message may be, in
+// chunks
+func largeStreaming(ctx context.Context, s *suite) error {
+	const size, chunk = 8 << 20, 256 << 10
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	stream, err := s.greeter().StreamHelloLarge(ctx, &pb.SayHelloLargeRequest{Name: "Interop", SizeBytes: size, ChunkBytes: chunk}, opts...)
+	if err != nil {
+		return err
+	}
+	var received int64
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if resp.GetOffset() != received {
+			return fmt.Errorf("chunk at offset %d, want %d", resp.GetOffset(), received)
+		}
+		received += int64(len(resp.GetPayload()))
+	}
+	if received != size {
+		return fmt.Errorf("received %d bytes, want %d", received, size)
+	}
+	return nil
+}
+
+// largeOverLimit asks for a response larger than the client accepts
+func largeOverLimit(ctx context.Context, s *suite) error {
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	_, err = s.greeter().SayHelloLarge(ctx, &pb.SayHelloLargeRequest{Name: "Interop", SizeBytes: 8 << 20}, opts...)
+	return expectCode(err, codes.ResourceExhausted)
+}
+
+// compressionGzip sends a compressed request, which a server without the
+// gzip compressor rejects with Unimplemented
+func compressionGzip(ctx context.Context, s *suite) error {
+	opts, err := s.callOptions(grpc.UseCompressor(gzip.Name))
+	if err != nil {
+		return err
+	}
+	resp, err := s.greeter().SayHelloLarge(ctx, &pb.SayHelloLargeRequest{Name: "Interop", SizeBytes: largeSize}, opts...)
+	if err != nil {
+		return err
+	}
+	if len(resp.GetPayload()) != largeSize {
+		return fmt.Errorf("payload is %d bytes, want %d", len(resp.GetPayload()), largeSize)
+	}
+	return nil
+}
+
+// metadataEcho checks the request id sent comes back in the response
+// headers, along with the server's version
+func metadataEcho(ctx context.Context, s *suite) error {
+	const id = "interop-metadata-echo"
+	var md metadata.Response
+	opts, err := s.callOptions(md.CallOptions()...)
+	if err != nil {
+		return err
+	}
+	_, err = s.greeter().SayHello(metadata.WithRequestID(ctx, id), &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Interop"}}, opts...)
+	if err != nil {
+		return err
+	}
+	if got := md.RequestID(); got != id {
+		return fmt.Errorf("%s echoed as %q, want %q", metadata.RequestIDHeader, got, id)
+	}
+	if md.ServerVersion() == "" {
+		return fmt.Errorf("no %s header", metadata.ServerVersionHeader)
+	}
+	return nil
+}
+
+var errNoSecret = skipError("needs -auth-secret")
+
+func authMissingToken(ctx context.Context, s *suite) error {
+	if s.secret == "" {
+		return errNoSecret
+	}
+	_, err := s.greeter().SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Interop"}})
+	return expectCode(err, codes.Unauthenticated)
+}
+
+func authInvalidToken(ctx context.Context, s *suite) error {
+	if s.secret == "" {
+		return errNoSecret
+	}
+	// A token signed with another secret
+	token, err := auth.NewIssuer(s.secret+"-wrong").Issue("interop", tokenTTL)
+	if err != nil {
+		return err
+	}
+	_, err = s.greeter().SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Interop"}}, withToken(token, s.tls))
+	return expectCode(err, codes.Unauthenticated)
+}
+
+// authAdminRole calls the AdminService with a token lacking the admin role
+func authAdminRole(ctx context.Context, s *suite) error {
+	if s.secret == "" {
+		return errNoSecret
+	}
+	opts, err := s.callOptions()
+	if err != nil {
+		return err
+	}
+	_, err = adminpb.NewAdminServiceClient(s.conn).GetConfig(ctx, &adminpb.GetConfigRequest{}, opts...)
+	if status.Code(err) == codes.Unimplemented {
+		return skipError("the server has no AdminService (-admin)")
+	}
+	return expectCode(err, codes.PermissionDenied)
+}
@@ -0,0 +1,102 @@
+// The launcher starts several greeting server instances in one process on
+// consecutive ports, to demonstrate client-side load balancing:
+//
+//	go run ./launcher -instances 3
+//	go run ./client fanout -addr localhost:50061,localhost:50062,localhost:50063
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// version is reported to clients in the server-version response header
+const version = "1.0.0"
+
+func main() {
+	instances := flag.Int("instances", 3, "number of server instances to start")
+	host := flag.String("host", "localhost", "host to listen on")
+	basePort := flag.Int("base-port", 50061, "port of the first instance; the others use the following ports")
+	logFormat := flag.String("log-format", "text", "log line format: text or json")
+	flag.Parse()
+	if err := logging.Setup(os.Stderr, *logFormat, "info"); err != nil {
+		log.Fatalf("Failed to configure logging: %v", err)
+	}
+
+	var servers []*grpc.Server
+	var addrs []string
+	for i := 1; i <= *instances; i++ {
+		addr := net.JoinHostPort(*host, fmt.Sprint(*basePort+i-1))
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			fatal("Failed to listen", "addr", addr, logging.Err(err))
+		}
+
+		name := fmt.Sprintf("instance-%d", i)
+		s := newInstance(name)
+		servers = append(servers, s)
+		addrs = append(addrs, addr)
+		go func() {
+			if err := s.Serve(lis); err != nil {
+				fatal("Failed to serve", "instance", name, logging.Err(err))
+			}
+		}()
+		slog.Info("✅ Instance listening", "instance", name, "addr", lis.Addr().String())
+	}
+	slog.Info("Try: go run ./client fanout -addr " + strings.Join(addrs, ","))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+
+	slog.Info("Shutting down...")
+	for _, s := range servers {
+		s.GracefulStop()
+	}
+	slog.Info("👋 All instances stopped")
+}
+
+// newInstance builds one server that names itself in the backend trailer
+func newInstance(name string) *grpc.Server {
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptors.UnaryServerRequestID(),
+			interceptors.UnaryServerLogging(),
+			interceptors.UnaryServerHeaders(version, name),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.StreamServerRequestID(),
+			interceptors.StreamServerLogging(),
+			interceptors.StreamServerHeaders(version, name),
+		),
+	)
+
+	greeter := service.New()
+	pb.RegisterGreetingServiceServer(s, greeter)
+	pbv2.RegisterGreetingServiceV2Server(s, greeter.V2())
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	return s
+}
+
+// fatal logs msg at error level and exits, like log.Fatal
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
@@ -0,0 +1,129 @@
+// Package logging sets up structured logging with log/slog for the demo
+// binaries and carries request ids through contexts, so every line logged
+// while serving or making a call names the request it belongs to.
+//
+// Setup installs the default slog logger. Log with the *Context functions
+// (slog.InfoContext and friends) inside a call and the line gets a
+// request_id attribute from the context.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+)
+
+// RequestIDKey is the attribute naming the request a log line belongs to
+const RequestIDKey = "request_id"
+
+// level is the lowest level the logger installed by Setup shows; SetLevel
+// changes it while the process runs
+var level slog.LevelVar
+
+// Setup makes a slog logger writing to w the default, which the standard
+// log package then writes through too. format is "text" or "json" and
+// lvl the lowest level shown: "debug", "info", "warn" or "error".
+func Setup(w io.Writer, format, lvl string) error {
+	if err := SetLevel(lvl); err != nil {
+		return err
+	}
+
+	opts := &slog.HandlerOptions{Level: &level}
+	var handler slog.Handler
+	switch format {
+	case "text":
+		handler = slog.NewTextHandler(w, opts)
+	case "json":
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		return fmt.Errorf("unknown log format %q", format)
+	}
+
+	slog.SetDefault(slog.New(contextHandler{handler}))
+	return nil
+}
+
+// SetLevel changes the lowest level logged to name, one of "debug", "info",
+// "warn" or "error"
+func SetLevel(name string) error {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(name)); err != nil {
+		return fmt.Errorf("unknown log level %q", name)
+	}
+	level.Set(lvl)
+	return nil
+}
+
+// Level returns the lowest level currently logged
+func Level() slog.Level {
+	return level.Level()
+}
+
+// contextHandler adds the request id and any attributes of the context a
+// line is logged with
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		r.AddAttrs(slog.String(RequestIDKey, id))
+	}
+	r.AddAttrs(contextAttrs(ctx)...)
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns ctx carrying id as the id of the request being
+// served or made
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request id carried by ctx, or "" if there is none
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+type attrsKey struct{}
+
+// WithAttrs returns ctx carrying attrs, besides any it already carries, so
+// every line logged with it gets them, e.g. the tenant a call is for
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	if len(attrs) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, attrsKey{}, slices.Concat(contextAttrs(ctx), attrs))
+}
+
+func contextAttrs(ctx context.Context) []slog.Attr {
+	attrs, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	return attrs
+}
+
+// NewRequestID returns a random request id
+func NewRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Err returns an "error" attribute for err, the key every line reporting a
+// failure uses
+func Err(err error) slog.Attr {
+	return slog.Any("error", err)
+}
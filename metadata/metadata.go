@@ -0,0 +1,192 @@
+// Package metadata names the custom headers and trailers the demo exchanges
+// and wraps reading and writing them, so the client and server agree on
+// keys and formats. It builds on google.golang.org/grpc/metadata.
+package metadata
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+// Request headers sent by the client
+const (
+	// RequestIDHeader identifies one logical call; the server echoes it in
+	// its response headers and trailers so both sides can correlate logs,
+	// and picks one itself when the client sent none
+	RequestIDHeader = "request-id"
+	// LocaleHeader carries the caller's preferred language, e.g. "fr-FR"
+	LocaleHeader = "locale"
+	// PriorityHeader is how urgent the call is, "high", "normal" or "low";
+	// a server queueing calls lets higher priorities go first
+	PriorityHeader = "x-priority"
+)
+
+// Response headers and trailers sent by the server
+const (
+	// ServerVersionHeader is a response header naming the server build
+	ServerVersionHeader = "server-version"
+	// ProcessingTimeTrailer is a trailer with how long the server spent on
+	// the call, in time.Duration format
+	ProcessingTimeTrailer = "processing-time"
+	// RetryAfterTrailer accompanies ResourceExhausted errors from the rate
+	// limiter with how many milliseconds to wait before trying again
+	RetryAfterTrailer = "retry-after"
+	// BackendTrailer names the server instance that handled the call, which
+	// shows how a balanced client spreads its calls
+	BackendTrailer = "backend"
+	// CacheTrailer is "hit" when a call was answered from a cache, the
+	// response cache or the response recorded for its idempotency key, or
+	// "miss" when it ran the handler
+	CacheTrailer = "x-cache"
+	// ViaTrailer lists the proxies a call passed through on its way to the
+	// backend, the one nearest the client first
+	ViaTrailer = "via"
+)
+
+// CacheTrailer values
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+// WithRequestID returns ctx carrying id as the request-id header of
+// outgoing calls
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return grpcmd.AppendToOutgoingContext(ctx, RequestIDHeader, id)
+}
+
+// OutgoingRequestID returns the request-id header ctx carries for outgoing
+// calls, or "" if it carries none
+func OutgoingRequestID(ctx context.Context) string {
+	md, _ := grpcmd.FromOutgoingContext(ctx)
+	return first(md, RequestIDHeader)
+}
+
+// WithLocale returns ctx carrying locale as the locale header of outgoing
+// calls
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return grpcmd.AppendToOutgoingContext(ctx, LocaleHeader, locale)
+}
+
+// WithPriority returns ctx carrying priority as the x-priority header of
+// outgoing calls
+func WithPriority(ctx context.Context, priority string) context.Context {
+	return grpcmd.AppendToOutgoingContext(ctx, PriorityHeader, priority)
+}
+
+// IncomingPriority returns the x-priority header of the call served under
+// ctx, or "" if it carries none
+func IncomingPriority(ctx context.Context) string {
+	md, _ := grpcmd.FromIncomingContext(ctx)
+	return first(md, PriorityHeader)
+}
+
+// Request holds the custom headers a server received with a call
+type Request struct {
+	ID     string
+	Locale string
+}
+
+// FromIncoming reads the custom request headers of the call served under
+// ctx; missing headers are left empty
+func FromIncoming(ctx context.Context) Request {
+	md, _ := grpcmd.FromIncomingContext(ctx)
+	return Request{
+		ID:     first(md, RequestIDHeader),
+		Locale: first(md, LocaleHeader),
+	}
+}
+
+// ResponseHeader builds the response headers a server sends: its version
+// and, when set, the echoed request id
+func ResponseHeader(version, requestID string) grpcmd.MD {
+	md := grpcmd.Pairs(ServerVersionHeader, version)
+	if requestID != "" {
+		md.Set(RequestIDHeader, requestID)
+	}
+	return md
+}
+
+// ResponseTrailer builds the trailers a server sends once a call is done
+func ResponseTrailer(backend string, processing time.Duration) grpcmd.MD {
+	return grpcmd.Pairs(
+		BackendTrailer, backend,
+		ProcessingTimeTrailer, processing.String(),
+	)
+}
+
+// Response collects the headers and trailers a server sent for one call.
+// Pass CallOptions to the call, then read the fields once it returns.
+type Response struct {
+	Header  grpcmd.MD
+	Trailer grpcmd.MD
+}
+
+// CallOptions returns the options that capture the call's headers and
+// trailers into r
+func (r *Response) CallOptions() []grpc.CallOption {
+	return []grpc.CallOption{grpc.Header(&r.Header), grpc.Trailer(&r.Trailer)}
+}
+
+// RequestID returns the request id the server echoed, from the headers or,
+// for calls failing before any were sent, the trailers
+func (r *Response) RequestID() string {
+	if id := first(r.Header, RequestIDHeader); id != "" {
+		return id
+	}
+	return first(r.Trailer, RequestIDHeader)
+}
+
+// ServerVersion returns the version the server reported
+func (r *Response) ServerVersion() string {
+	return first(r.Header, ServerVersionHeader)
+}
+
+// Backend returns the server instance that handled the call
+func (r *Response) Backend() string {
+	return first(r.Trailer, BackendTrailer)
+}
+
+// Cache returns CacheHit if the server answered from its response cache
+// or replayed the response it recorded for the call's idempotency key,
+// CacheMiss if it ran the call, or "" for calls neither cache applies to
+func (r *Response) Cache() string {
+	return first(r.Trailer, CacheTrailer)
+}
+
+// Via returns the proxies the call passed through, the one nearest the
+// client first, or nil for calls made straight to a server
+func (r *Response) Via() []string {
+	return r.Trailer.Get(ViaTrailer)
+}
+
+// ProcessingTime returns how long the server reported spending on the
+// call, or zero if it didn't say
+func (r *Response) ProcessingTime() time.Duration {
+	d, err := time.ParseDuration(first(r.Trailer, ProcessingTimeTrailer))
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// RetryAfter returns how long the server asked the client to wait before
+// retrying, or zero if it didn't say
+func (r *Response) RetryAfter() time.Duration {
+	ms, err := strconv.ParseInt(first(r.Trailer, RetryAfterTrailer), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+func first(md grpcmd.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterAdmissionQueue registers gauges and counters reading q's load
+// with reg: grpc_server_admission_in_flight, and by priority label
+// grpc_server_admission_queued, _admitted_total and _rejected_total
+func RegisterAdmissionQueue(reg prometheus.Registerer, q *interceptors.AdmissionQueue) {
+	reg.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "grpc",
+		Subsystem: "server",
+		Name:      "admission_in_flight",
+		Help:      "Requests holding an admission queue slot.",
+	}, func() float64 { return float64(q.InFlight()) }))
+
+	for _, p := range interceptors.Priorities {
+		labels := prometheus.Labels{"priority": p.String()}
+		stats := func() interceptors.PriorityStats { return q.Stats()[p] }
+		reg.MustRegister(
+			prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+				Namespace:   "grpc",
+				Subsystem:   "server",
+				Name:        "admission_queued",
+				Help:        "Requests waiting for a slot, by priority.",
+				ConstLabels: labels,
+			}, func() float64 { return float64(stats().Queued) }),
+			prometheus.NewCounterFunc(prometheus.CounterOpts{
+				Namespace:   "grpc",
+				Subsystem:   "server",
+				Name:        "admission_admitted_total",
+				Help:        "Requests given a slot, at once or after queueing, by priority.",
+				ConstLabels: labels,
+			}, func() float64 { return float64(stats().Admitted) }),
+			prometheus.NewCounterFunc(prometheus.CounterOpts{
+				Namespace:   "grpc",
+				Subsystem:   "server",
+				Name:        "admission_rejected_total",
+				Help:        "Requests refused with ResourceExhausted because their priority's queue was full.",
+				ConstLabels: labels,
+			}, func() float64 { return float64(stats().Rejected) }),
+		)
+	}
+}
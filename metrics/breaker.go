@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// breakerStates are the states exported by BreakerMetrics
+var breakerStates = []interceptors.BreakerState{interceptors.BreakerClosed, interceptors.BreakerOpen, interceptors.BreakerHalfOpen}
+
+// BreakerMetrics exports the state of a client's circuit breaker
+type BreakerMetrics struct {
+	state       *prometheus.GaugeVec
+	transitions *prometheus.CounterVec
+}
+
+// NewBreakerMetrics registers the grpc_client_circuit_breaker_* metrics
+// with reg and starts out reporting the breaker closed
+func NewBreakerMetrics(reg prometheus.Registerer) *BreakerMetrics {
+	m := &BreakerMetrics{
+		state: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "grpc",
+			Subsystem: "client",
+			Name:      "circuit_breaker_state",
+			Help:      "1 for the circuit breaker's current state (closed, open or half-open), 0 for the others.",
+		}, []string{"state"}),
+		transitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "client",
+			Name:      "circuit_breaker_transitions_total",
+			Help:      "Circuit breaker state changes by the state entered.",
+		}, []string{"state"}),
+	}
+	reg.MustRegister(m.state, m.transitions)
+	m.set(interceptors.BreakerClosed)
+	return m
+}
+
+// StateChanged records a state change; pass it to
+// interceptors.CircuitBreaker.OnChange
+func (m *BreakerMetrics) StateChanged(c interceptors.BreakerChange) {
+	m.transitions.WithLabelValues(c.To.String()).Inc()
+	m.set(c.To)
+}
+
+func (m *BreakerMetrics) set(current interceptors.BreakerState) {
+	for _, s := range breakerStates {
+		value := 0.0
+		if s == current {
+			value = 1
+		}
+		m.state.WithLabelValues(s.String()).Set(value)
+	}
+}
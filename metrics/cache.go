@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// CacheMetrics counts response cache hits and misses. It implements
+// interceptors.CacheRecorder.
+type CacheMetrics struct {
+	lookups *prometheus.CounterVec
+}
+
+// NewCacheMetrics registers the grpc_server_cache_lookups_total metric
+// with reg
+func NewCacheMetrics(reg prometheus.Registerer) *CacheMetrics {
+	m := &CacheMetrics{
+		lookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "cache_lookups_total",
+			Help:      "Response cache lookups by method and result (hit or miss).",
+		}, []string{"method", "result"}),
+	}
+	reg.MustRegister(m.lookups)
+	return m
+}
+
+// CacheHit implements interceptors.CacheRecorder
+func (m *CacheMetrics) CacheHit(method string) {
+	m.lookups.WithLabelValues(method, "hit").Inc()
+}
+
+// CacheMiss implements interceptors.CacheRecorder
+func (m *CacheMetrics) CacheMiss(method string) {
+	m.lookups.WithLabelValues(method, "miss").Inc()
+}
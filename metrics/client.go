@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClientMetrics records metrics for RPCs made by a client
+type ClientMetrics struct {
+	m *rpcMetrics
+}
+
+// NewClientMetrics registers the grpc_client_* metrics with reg
+func NewClientMetrics(reg prometheus.Registerer) *ClientMetrics {
+	return &ClientMetrics{m: newRPCMetrics(reg, "client")}
+}
+
+// UnaryClientInterceptor records unary calls, including all retry attempts
+// when installed before the retry interceptor
+func (c *ClientMetrics) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		done := c.m.start("unary", method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		done(status.Code(err).String())
+		return err
+	}
+}
+
+// StreamClientInterceptor records streaming calls; a stream counts as in
+// flight until the client receives its final status
+func (c *ClientMetrics) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		done := c.m.start("stream", method)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			done(status.Code(err).String())
+			return nil, err
+		}
+		return &monitoredClientStream{ClientStream: cs, done: done}, nil
+	}
+}
+
+// monitoredClientStream records the stream's outcome the first time
+// RecvMsg reports the end of the stream
+type monitoredClientStream struct {
+	grpc.ClientStream
+	once sync.Once
+	done func(code string)
+}
+
+func (s *monitoredClientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		code := status.Code(err)
+		if err == io.EOF {
+			code = codes.OK
+		}
+		s.once.Do(func() { s.done(code.String()) })
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ConnMetrics tracks the server's client connections. It implements
+// transport.ConnRecorder.
+type ConnMetrics struct {
+	open     prometheus.Gauge
+	accepted prometheus.Counter
+	rejected prometheus.Counter
+}
+
+// NewConnMetrics registers the grpc_server_connections_open,
+// grpc_server_connections_accepted_total and
+// grpc_server_connections_rejected_total metrics with reg
+func NewConnMetrics(reg prometheus.Registerer) *ConnMetrics {
+	m := &ConnMetrics{
+		open: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "connections_open",
+			Help:      "Client connections currently open.",
+		}),
+		accepted: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "connections_accepted_total",
+			Help:      "Client connections accepted.",
+		}),
+		rejected: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "connections_rejected_total",
+			Help:      "Client connections closed on accept because their peer IP had as many open as allowed.",
+		}),
+	}
+	reg.MustRegister(m.open, m.accepted, m.rejected)
+	return m
+}
+
+// ConnOpened implements transport.ConnRecorder
+func (m *ConnMetrics) ConnOpened() {
+	m.accepted.Inc()
+	m.open.Inc()
+}
+
+// ConnClosed implements transport.ConnRecorder
+func (m *ConnMetrics) ConnClosed() {
+	m.open.Dec()
+}
+
+// ConnRejected implements transport.ConnRecorder
+func (m *ConnMetrics) ConnRejected() {
+	m.rejected.Inc()
+}
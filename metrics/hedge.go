@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HedgeMetrics counts the duplicate work caused by client hedging. It
+// implements interceptors.HedgeRecorder.
+type HedgeMetrics struct {
+	hedged    *prometheus.CounterVec
+	wins      *prometheus.CounterVec
+	cancelled *prometheus.CounterVec
+}
+
+// NewHedgeMetrics registers the grpc_client_hedge* metrics with reg
+func NewHedgeMetrics(reg prometheus.Registerer) *HedgeMetrics {
+	m := &HedgeMetrics{
+		hedged: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "client",
+			Name:      "hedged_total",
+			Help:      "Extra copies of calls sent by hedging.",
+		}, []string{"method"}),
+		wins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "client",
+			Name:      "hedge_wins_total",
+			Help:      "Hedgeable calls by the attempt that answered first (1 is the original).",
+		}, []string{"method", "attempt"}),
+		cancelled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "client",
+			Name:      "hedge_cancelled_total",
+			Help:      "Copies cancelled because another copy answered first, i.e. wasted work.",
+		}, []string{"method"}),
+	}
+	reg.MustRegister(m.hedged, m.wins, m.cancelled)
+	return m
+}
+
+// Hedged implements interceptors.HedgeRecorder
+func (m *HedgeMetrics) Hedged(method string) {
+	m.hedged.WithLabelValues(method).Inc()
+}
+
+// Won implements interceptors.HedgeRecorder
+func (m *HedgeMetrics) Won(method string, attempt, cancelled int) {
+	m.wins.WithLabelValues(method, strconv.Itoa(attempt)).Inc()
+	m.cancelled.WithLabelValues(method).Add(float64(cancelled))
+}
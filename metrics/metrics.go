@@ -0,0 +1,96 @@
+// Package metrics records Prometheus RPC metrics for both sides of a call
+// and serves them over HTTP. Server metrics are prefixed grpc_server_ and
+// client metrics grpc_client_, so one dashboard can compare the two.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// NewRegistry returns a registry preloaded with the Go runtime and process
+// collectors
+func NewRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return reg
+}
+
+// Handler serves reg in the Prometheus text format, for mounting on an
+// existing HTTP server
+func Handler(reg *prometheus.Registry) http.Handler {
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{})
+}
+
+// Serve exposes reg on http://addr/metrics, and the process's expvars as
+// JSON on /debug/vars, in the background and returns a function that shuts
+// the endpoint down
+func Serve(addr string, reg *prometheus.Registry) func(context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler(reg))
+	mux.Handle("/debug/vars", expvar.Handler())
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Metrics endpoint failed", logging.Err(err))
+		}
+	}()
+	return srv.Shutdown
+}
+
+// rpcMetrics is the set of metrics recorded for one side of a call
+type rpcMetrics struct {
+	handled  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+}
+
+func newRPCMetrics(reg prometheus.Registerer, side string) *rpcMetrics {
+	m := &rpcMetrics{
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: side,
+			Name:      "handled_total",
+			Help:      "Completed RPCs by method and status code.",
+		}, []string{"type", "method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "grpc",
+			Subsystem: side,
+			Name:      "handling_seconds",
+			Help:      "RPC latency by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"type", "method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "grpc",
+			Subsystem: side,
+			Name:      "in_flight",
+			Help:      "RPCs currently running, including open streams.",
+		}, []string{"type", "method"}),
+	}
+	reg.MustRegister(m.handled, m.duration, m.inFlight)
+	return m
+}
+
+// start marks an RPC as in flight and returns a func recording its outcome
+func (m *rpcMetrics) start(kind, method string) func(code string) {
+	begin := time.Now()
+	m.inFlight.WithLabelValues(kind, method).Inc()
+	return func(code string) {
+		m.inFlight.WithLabelValues(kind, method).Dec()
+		m.handled.WithLabelValues(kind, method, code).Inc()
+		m.duration.WithLabelValues(kind, method).Observe(time.Since(begin).Seconds())
+	}
+}
@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PanicMetrics counts handler panics the server recovered from. It
+// implements interceptors.PanicRecorder.
+type PanicMetrics struct {
+	panics *prometheus.CounterVec
+}
+
+// NewPanicMetrics registers the grpc_server_panics_total metric with reg
+func NewPanicMetrics(reg prometheus.Registerer) *PanicMetrics {
+	m := &PanicMetrics{
+		panics: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "panics_total",
+			Help:      "Panics in interceptors or handlers recovered as Internal errors.",
+		}, []string{"method"}),
+	}
+	reg.MustRegister(m.panics)
+	return m
+}
+
+// Panicked implements interceptors.PanicRecorder
+func (m *PanicMetrics) Panicked(method string) {
+	m.panics.WithLabelValues(method).Inc()
+}
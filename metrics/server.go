@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// ServerMetrics records metrics for RPCs handled by a server
+type ServerMetrics struct {
+	m *rpcMetrics
+}
+
+// NewServerMetrics registers the grpc_server_* metrics with reg
+func NewServerMetrics(reg prometheus.Registerer) *ServerMetrics {
+	return &ServerMetrics{m: newRPCMetrics(reg, "server")}
+}
+
+// UnaryServerInterceptor records unary calls
+func (s *ServerMetrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		done := s.m.start("unary", info.FullMethod)
+		resp, err := handler(ctx, req)
+		done(status.Code(err).String())
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records streaming calls; a stream counts as in
+// flight until its handler returns
+func (s *ServerMetrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		done := s.m.start("stream", info.FullMethod)
+		err := handler(srv, ss)
+		done(status.Code(err).String())
+		return err
+	}
+}
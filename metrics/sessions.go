@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/sessions"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterSessions registers a gauge and a counter reading m with reg:
+// grpc_server_sessions_active and grpc_server_sessions_evicted_total
+func RegisterSessions(reg prometheus.Registerer, m *sessions.Manager) {
+	reg.MustRegister(
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "sessions_active",
+			Help:      "Conversations in progress, expired ones not yet evicted included.",
+		}, func() float64 { return float64(m.Len()) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "sessions_evicted_total",
+			Help:      "Conversations that expired after going unused for the session TTL.",
+		}, func() float64 { return float64(m.Evicted()) }),
+	)
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// TenantMetrics counts calls and their latency by tenant. It implements
+// tenants.Recorder.
+type TenantMetrics struct {
+	handled  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewTenantMetrics registers the grpc_server_tenant_* metrics with reg
+func NewTenantMetrics(reg prometheus.Registerer) *TenantMetrics {
+	m := &TenantMetrics{
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "tenant_handled_total",
+			Help:      "Completed RPCs by tenant, method and status code; refused calls naming no known tenant count as tenant \"unknown\".",
+		}, []string{"tenant", "method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "tenant_handling_seconds",
+			Help:      "RPC latency by tenant and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"tenant", "method"}),
+	}
+	reg.MustRegister(m.handled, m.duration)
+	return m
+}
+
+// TenantCall implements tenants.Recorder
+func (m *TenantMetrics) TenantCall(tenant, method, code string, elapsed time.Duration) {
+	m.handled.WithLabelValues(tenant, method, code).Inc()
+	m.duration.WithLabelValues(tenant, method).Observe(elapsed.Seconds())
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/workers"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterWorkerPool registers gauges and counters reading p's load with
+// reg: grpc_server_worker_pool_workers, _busy, _queued, _queue_capacity,
+// _completed_total and _rejected_total
+func RegisterWorkerPool(reg prometheus.Registerer, p *workers.Pool) {
+	gauge := func(name, help string, value func(workers.Stats) int) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "worker_pool_" + name,
+			Help:      help,
+		}, func() float64 { return float64(value(p.Stats())) })
+	}
+	counter := func(name, help string, value func(workers.Stats) int64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "worker_pool_" + name,
+			Help:      help,
+		}, func() float64 { return float64(value(p.Stats())) })
+	}
+	reg.MustRegister(
+		gauge("workers", "Streamed greetings the worker pool can generate at once.", func(s workers.Stats) int { return s.Workers }),
+		gauge("busy", "Workers generating a greeting.", func(s workers.Stats) int { return s.Busy }),
+		gauge("queued", "Greetings waiting for a worker.", func(s workers.Stats) int { return s.Queued }),
+		gauge("queue_capacity", "Greetings that may wait for a worker before new ones are refused.", func(s workers.Stats) int { return s.QueueCapacity }),
+		counter("completed_total", "Greetings the worker pool generated.", func(s workers.Stats) int64 { return s.Completed }),
+		counter("rejected_total", "Greetings refused with Unavailable because the worker queue was full.", func(s workers.Stats) int64 { return s.Rejected }),
+	)
+}
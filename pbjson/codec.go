@@ -0,0 +1,52 @@
+package pbjson
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// CodecName is the content-subtype calls select the JSON codec with:
+// their content-type becomes application/grpc+json
+const CodecName = "json"
+
+// Codec marshals messages in the protojson mapping instead of the protobuf
+// binary format. It is registered with gRPC when this package is imported,
+// so a server importing it answers calls made with
+// grpc.CallContentSubtype(CodecName) in JSON too. Unknown fields are
+// skipped, as the binary format does, so old and new peers interoperate.
+type Codec struct{}
+
+func init() {
+	encoding.RegisterCodecV2(Codec{})
+}
+
+// Marshal implements encoding.CodecV2
+func (Codec) Marshal(v any) (mem.BufferSlice, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("pbjson: cannot marshal %T, not a proto.Message", v)
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return mem.BufferSlice{mem.SliceBuffer(b)}, nil
+}
+
+// Unmarshal implements encoding.CodecV2
+func (Codec) Unmarshal(data mem.BufferSlice, v any) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("pbjson: cannot unmarshal into %T, not a proto.Message", v)
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Materialize(), m)
+}
+
+// Name implements encoding.CodecV2
+func (Codec) Name() string {
+	return CodecName
+}
@@ -0,0 +1,207 @@
+// Package pbjson transcodes protobuf messages to and from JSON, in the
+// protojson mapping. A Dumper's interceptors pretty-print every message a
+// call exchanges, redacted, for debugging what goes over the wire:
+//
+//	▶ request /greeting.GreetingService/SayHello request_id=3f2a9c1e
+//	{
+//	  "name": "Alice"
+//	}
+//
+// ReadMessage reads messages from JSON files, so clients can send any
+// payload a script writes.
+package pbjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/audit"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Dumper writes the messages its interceptors see as indented JSON
+type Dumper struct {
+	redactions audit.Redactions
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// Option configures a Dumper
+type Option func(*Dumper)
+
+// WithRedactions redacts message fields as r says before dumping them
+func WithRedactions(r audit.Redactions) Option {
+	return func(d *Dumper) {
+		d.redactions = r
+	}
+}
+
+// New creates a Dumper writing to w
+func New(w io.Writer, opts ...Option) *Dumper {
+	d := &Dumper{w: w}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// UnaryServerInterceptor dumps every unary call's request and its response
+// or error. Install it after the request id interceptor, so dumps name the
+// call they belong to.
+func (d *Dumper) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		d.dump(ctx, "▶ request", info.FullMethod, req)
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		d.result(ctx, "◀ response", info.FullMethod, resp, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor dumps every message a stream receives and sends,
+// and the error it ends with
+func (d *Dumper) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, &dumpingServerStream{ServerStream: ss, d: d, method: info.FullMethod})
+		if err != nil {
+			d.result(ss.Context(), "", info.FullMethod, nil, err, time.Since(start))
+		}
+		return err
+	}
+}
+
+// UnaryClientInterceptor dumps every unary call's request and the response
+// or error it got
+func (d *Dumper) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		d.dump(ctx, "▶ request", method, req)
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		d.result(ctx, "◀ response", method, reply, err, time.Since(start))
+		return err
+	}
+}
+
+// StreamClientInterceptor dumps every message a stream sends and receives
+func (d *Dumper) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			d.result(ctx, "", method, nil, err, 0)
+			return nil, err
+		}
+		return &dumpingClientStream{ClientStream: cs, d: d, method: method}, nil
+	}
+}
+
+// result dumps how a call ended: its response under label, or its error
+func (d *Dumper) result(ctx context.Context, label, method string, resp any, err error, elapsed time.Duration) {
+	if err == nil {
+		d.dump(ctx, label, method, resp)
+		return
+	}
+	st := status.Convert(err)
+	fields := map[string]any{"code": st.Code().String(), "message": st.Message()}
+	if details := audit.Summarize(st.Proto(), nil)["details"]; details != nil {
+		fields["details"] = details
+	}
+	if elapsed > 0 {
+		fields["elapsed"] = elapsed.String()
+	}
+	d.write(ctx, "✖ error", method, fields)
+}
+
+func (d *Dumper) dump(ctx context.Context, label, method string, msg any) {
+	fields := audit.Summarize(msg, d.redactions)
+	if fields == nil {
+		fields = map[string]any{}
+	}
+	d.write(ctx, label, method, fields)
+}
+
+func (d *Dumper) write(ctx context.Context, label, method string, fields map[string]any) {
+	b, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		b = fmt.Appendf(nil, "<%v>", err)
+	}
+	header := label + " " + method
+	if id := logging.RequestID(ctx); id != "" {
+		header += " request_id=" + id
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	fmt.Fprintf(d.w, "%s\n%s\n", header, b)
+}
+
+type dumpingServerStream struct {
+	grpc.ServerStream
+	d      *Dumper
+	method string
+}
+
+func (s *dumpingServerStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.d.dump(s.Context(), "▶ received", s.method, m)
+	}
+	return err
+}
+
+func (s *dumpingServerStream) SendMsg(m any) error {
+	s.d.dump(s.Context(), "◀ sent", s.method, m)
+	return s.ServerStream.SendMsg(m)
+}
+
+type dumpingClientStream struct {
+	grpc.ClientStream
+	d      *Dumper
+	method string
+}
+
+func (s *dumpingClientStream) SendMsg(m any) error {
+	s.d.dump(s.Context(), "▶ sent", s.method, m)
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *dumpingClientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == nil:
+		s.d.dump(s.Context(), "◀ received", s.method, m)
+	case err != io.EOF:
+		s.d.result(s.Context(), "", s.method, nil, err, 0)
+	}
+	return err
+}
+
+// ReadMessage fills msg from the JSON file at path, or from stdin if path
+// is "-". Fields may be named as in the .proto file or in lowerCamelCase;
+// unknown fields are an error, so typos don't go unnoticed.
+func ReadMessage(path string, msg proto.Message) error {
+	var b []byte
+	var err error
+	if path == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(b, msg); err != nil {
+		return fmt.Errorf("pbjson: %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Package pool dials several greeting servers as one client connection and
+// spreads calls across them with gRPC's round_robin balancer.
+package pool
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// scheme names the manual resolver that feeds a static address list
+const scheme = "greeter-pool"
+
+// roundRobin is the service config selecting the round_robin balancer
+const roundRobin = `{"loadBalancingConfig": [{"round_robin": {}}]}`
+
+// Pool is a client connection balanced across a set of backends
+type Pool struct {
+	*grpc.ClientConn
+	resolver *manual.Resolver
+}
+
+// Dial connects to target with round-robin balancing. target is either a
+// comma separated list of addresses, which is resolved by a manual resolver
+// whose list can later be replaced with SetAddresses, or any gRPC target
+// that resolves to several addresses, e.g. "dns:///greeter.local:50051".
+// A service config passed in opts replaces the one selecting round_robin,
+// so it must select round_robin itself.
+func Dial(target string, opts ...grpc.DialOption) (*Pool, error) {
+	opts = append([]grpc.DialOption{grpc.WithDefaultServiceConfig(roundRobin)}, opts...)
+	if !strings.Contains(target, ",") {
+		conn, err := grpc.NewClient(target, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &Pool{ClientConn: conn}, nil
+	}
+
+	r := manual.NewBuilderWithScheme(scheme)
+	r.InitialState(resolver.State{Addresses: addresses(strings.Split(target, ","))})
+	conn, err := grpc.NewClient(scheme+":///backends", append(opts, grpc.WithResolvers(r))...)
+	if err != nil {
+		return nil, err
+	}
+	return &Pool{ClientConn: conn, resolver: r}, nil
+}
+
+// SetAddresses replaces the backends of a pool dialed with an address list
+func (p *Pool) SetAddresses(addrs []string) error {
+	if p.resolver == nil {
+		return fmt.Errorf("pool: addresses of %s are managed by its resolver", p.Target())
+	}
+	p.resolver.UpdateState(resolver.State{Addresses: addresses(addrs)})
+	return nil
+}
+
+func addresses(addrs []string) []resolver.Address {
+	var out []resolver.Address
+	for _, a := range addrs {
+		if a = strings.TrimSpace(a); a != "" {
+			out = append(out, resolver.Address{Addr: a})
+		}
+	}
+	return out
+}
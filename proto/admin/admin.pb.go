@@ -0,0 +1,1110 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: proto/admin/admin.proto
+
+package adminpb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The request message for changing the log level
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// The response message for changing the log level
+type SetLogLevelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The level logged before the change
+	PreviousLevel string `protobuf:"bytes,1,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetLogLevelResponse) GetPreviousLevel() string {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return ""
+}
+
+// The request message for changing fault injection
+type SetChaosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Faults in -chaos format, e.g. "latency=200ms,error-rate=0.1"; empty
+	// turns chaos off
+	Spec          string `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChaosRequest) Reset() {
+	*x = SetChaosRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChaosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChaosRequest) ProtoMessage() {}
+
+func (x *SetChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChaosRequest.ProtoReflect.Descriptor instead.
+func (*SetChaosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetChaosRequest) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+// The response message for changing fault injection
+type SetChaosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Summary of the faults now injected; empty when chaos is off
+	Chaos         string `protobuf:"bytes,1,opt,name=chaos,proto3" json:"chaos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChaosResponse) Reset() {
+	*x = SetChaosResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChaosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChaosResponse) ProtoMessage() {}
+
+func (x *SetChaosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChaosResponse.ProtoReflect.Descriptor instead.
+func (*SetChaosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetChaosResponse) GetChaos() string {
+	if x != nil {
+		return x.Chaos
+	}
+	return ""
+}
+
+// The request message for draining the server
+type DrainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainRequest) Reset() {
+	*x = DrainRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainRequest) ProtoMessage() {}
+
+func (x *DrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainRequest.ProtoReflect.Descriptor instead.
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{4}
+}
+
+// The response message for draining the server
+type DrainResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Calls, streaming or not, still in flight when the drain started; the
+	// Drain call itself is one of them
+	ActiveStreams int64 `protobuf:"varint,1,opt,name=active_streams,json=activeStreams,proto3" json:"active_streams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainResponse) Reset() {
+	*x = DrainResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainResponse) ProtoMessage() {}
+
+func (x *DrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainResponse.ProtoReflect.Descriptor instead.
+func (*DrainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DrainResponse) GetActiveStreams() int64 {
+	if x != nil {
+		return x.ActiveStreams
+	}
+	return 0
+}
+
+// The request message for reading the server's settings
+type GetConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{6}
+}
+
+// The response message for reading the server's settings
+type GetConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current value of every server flag, keyed by flag name
+	Settings      map[string]string `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigResponse) ProtoMessage() {}
+
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetConfigResponse) GetSettings() map[string]string {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// The request message for flushing the response cache
+type FlushCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushCacheRequest) Reset() {
+	*x = FlushCacheRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheRequest) ProtoMessage() {}
+
+func (x *FlushCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheRequest.ProtoReflect.Descriptor instead.
+func (*FlushCacheRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{8}
+}
+
+// The response message for flushing the response cache
+type FlushCacheResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Responses dropped from the cache
+	Flushed       int64 `protobuf:"varint,1,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushCacheResponse) Reset() {
+	*x = FlushCacheResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheResponse) ProtoMessage() {}
+
+func (x *FlushCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheResponse.ProtoReflect.Descriptor instead.
+func (*FlushCacheResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FlushCacheResponse) GetFlushed() int64 {
+	if x != nil {
+		return x.Flushed
+	}
+	return 0
+}
+
+// The request message for reading the worker pool's load
+type GetWorkerPoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerPoolRequest) Reset() {
+	*x = GetWorkerPoolRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerPoolRequest) ProtoMessage() {}
+
+func (x *GetWorkerPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerPoolRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkerPoolRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{10}
+}
+
+// The response message for reading the worker pool's load
+type GetWorkerPoolResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Greetings that can be generated at once
+	Workers int32 `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	// Workers generating a greeting right now
+	Busy int32 `protobuf:"varint,2,opt,name=busy,proto3" json:"busy,omitempty"`
+	// Greetings waiting for a worker
+	Queued int32 `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	// Greetings that may wait before new ones are refused
+	QueueCapacity int32 `protobuf:"varint,4,opt,name=queue_capacity,json=queueCapacity,proto3" json:"queue_capacity,omitempty"`
+	// Greetings generated since the server started
+	Completed int64 `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	// Greetings refused with Unavailable because the queue was full
+	Rejected      int64 `protobuf:"varint,6,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerPoolResponse) Reset() {
+	*x = GetWorkerPoolResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerPoolResponse) ProtoMessage() {}
+
+func (x *GetWorkerPoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerPoolResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkerPoolResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetWorkerPoolResponse) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *GetWorkerPoolResponse) GetBusy() int32 {
+	if x != nil {
+		return x.Busy
+	}
+	return 0
+}
+
+func (x *GetWorkerPoolResponse) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *GetWorkerPoolResponse) GetQueueCapacity() int32 {
+	if x != nil {
+		return x.QueueCapacity
+	}
+	return 0
+}
+
+func (x *GetWorkerPoolResponse) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *GetWorkerPoolResponse) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+// The request message for reloading the greeting templates
+type ReloadTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadTemplatesRequest) Reset() {
+	*x = ReloadTemplatesRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadTemplatesRequest) ProtoMessage() {}
+
+func (x *ReloadTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ReloadTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{12}
+}
+
+// The response message for reloading the greeting templates
+type ReloadTemplatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Directory the templates were read from
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// Templates read from files; the others are built in
+	Templates     []string `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadTemplatesResponse) Reset() {
+	*x = ReloadTemplatesResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadTemplatesResponse) ProtoMessage() {}
+
+func (x *ReloadTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ReloadTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReloadTemplatesResponse) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *ReloadTemplatesResponse) GetTemplates() []string {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// The request message for reading the server's call statistics
+type GetServerStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerStatsRequest) Reset() {
+	*x = GetServerStatsRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerStatsRequest) ProtoMessage() {}
+
+func (x *GetServerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetServerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{14}
+}
+
+// The response message for reading the server's call statistics
+type GetServerStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long the server has been running
+	Uptime *durationpb.Duration `protobuf:"bytes,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// One entry per method called at least once, sorted by method
+	Methods       []*MethodStats `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerStatsResponse) Reset() {
+	*x = GetServerStatsResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerStatsResponse) ProtoMessage() {}
+
+func (x *GetServerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetServerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetServerStatsResponse) GetUptime() *durationpb.Duration {
+	if x != nil {
+		return x.Uptime
+	}
+	return nil
+}
+
+func (x *GetServerStatsResponse) GetMethods() []*MethodStats {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+// The calls made to one method
+type MethodStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Full method name, e.g. /greeting.GreetingService/SayHello
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Calls completed
+	Calls int64 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	// Completed calls that ended with a status other than OK
+	Errors int64 `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	// Mean time a completed call took
+	AverageLatency *durationpb.Duration `protobuf:"bytes,4,opt,name=average_latency,json=averageLatency,proto3" json:"average_latency,omitempty"`
+	// Streams of the method open right now; always zero for unary methods
+	ActiveStreams int64 `protobuf:"varint,5,opt,name=active_streams,json=activeStreams,proto3" json:"active_streams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MethodStats) Reset() {
+	*x = MethodStats{}
+	mi := &file_proto_admin_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MethodStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodStats) ProtoMessage() {}
+
+func (x *MethodStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodStats.ProtoReflect.Descriptor instead.
+func (*MethodStats) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MethodStats) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *MethodStats) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *MethodStats) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *MethodStats) GetAverageLatency() *durationpb.Duration {
+	if x != nil {
+		return x.AverageLatency
+	}
+	return nil
+}
+
+func (x *MethodStats) GetActiveStreams() int64 {
+	if x != nil {
+		return x.ActiveStreams
+	}
+	return 0
+}
+
+// The request message for dumping the goroutines
+type DumpGoroutinesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List identical stacks once, with how many goroutines share them,
+	// instead of every goroutine on its own
+	Grouped       bool `protobuf:"varint,1,opt,name=grouped,proto3" json:"grouped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DumpGoroutinesRequest) Reset() {
+	*x = DumpGoroutinesRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DumpGoroutinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpGoroutinesRequest) ProtoMessage() {}
+
+func (x *DumpGoroutinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpGoroutinesRequest.ProtoReflect.Descriptor instead.
+func (*DumpGoroutinesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DumpGoroutinesRequest) GetGrouped() bool {
+	if x != nil {
+		return x.Grouped
+	}
+	return false
+}
+
+// The response message for dumping the goroutines
+type DumpGoroutinesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Goroutines running when the dump was taken
+	Goroutines int32 `protobuf:"varint,1,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	// The stacks, in the Go runtime's text format
+	Dump          string `protobuf:"bytes,2,opt,name=dump,proto3" json:"dump,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DumpGoroutinesResponse) Reset() {
+	*x = DumpGoroutinesResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DumpGoroutinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpGoroutinesResponse) ProtoMessage() {}
+
+func (x *DumpGoroutinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpGoroutinesResponse.ProtoReflect.Descriptor instead.
+func (*DumpGoroutinesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DumpGoroutinesResponse) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *DumpGoroutinesResponse) GetDump() string {
+	if x != nil {
+		return x.Dump
+	}
+	return ""
+}
+
+var File_proto_admin_admin_proto protoreflect.FileDescriptor
+
+const file_proto_admin_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/admin/admin.proto\x12\x05admin\x1a\x1egoogle/protobuf/duration.proto\x1a\x17validate/validate.proto\"K\n" +
+	"\x12SetLogLevelRequest\x125\n" +
+	"\x05level\x18\x01 \x01(\tB\x1f\xfaB\x1cr\x1aR\x05debugR\x04infoR\x04warnR\x05errorR\x05level\"<\n" +
+	"\x13SetLogLevelResponse\x12%\n" +
+	"\x0eprevious_level\x18\x01 \x01(\tR\rpreviousLevel\"%\n" +
+	"\x0fSetChaosRequest\x12\x12\n" +
+	"\x04spec\x18\x01 \x01(\tR\x04spec\"(\n" +
+	"\x10SetChaosResponse\x12\x14\n" +
+	"\x05chaos\x18\x01 \x01(\tR\x05chaos\"\x0e\n" +
+	"\fDrainRequest\"6\n" +
+	"\rDrainResponse\x12%\n" +
+	"\x0eactive_streams\x18\x01 \x01(\x03R\ractiveStreams\"\x12\n" +
+	"\x10GetConfigRequest\"\x94\x01\n" +
+	"\x11GetConfigResponse\x12B\n" +
+	"\bsettings\x18\x01 \x03(\v2&.admin.GetConfigResponse.SettingsEntryR\bsettings\x1a;\n" +
+	"\rSettingsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x13\n" +
+	"\x11FlushCacheRequest\".\n" +
+	"\x12FlushCacheResponse\x12\x18\n" +
+	"\aflushed\x18\x01 \x01(\x03R\aflushed\"\x16\n" +
+	"\x14GetWorkerPoolRequest\"\xbe\x01\n" +
+	"\x15GetWorkerPoolResponse\x12\x18\n" +
+	"\aworkers\x18\x01 \x01(\x05R\aworkers\x12\x12\n" +
+	"\x04busy\x18\x02 \x01(\x05R\x04busy\x12\x16\n" +
+	"\x06queued\x18\x03 \x01(\x05R\x06queued\x12%\n" +
+	"\x0equeue_capacity\x18\x04 \x01(\x05R\rqueueCapacity\x12\x1c\n" +
+	"\tcompleted\x18\x05 \x01(\x03R\tcompleted\x12\x1a\n" +
+	"\brejected\x18\x06 \x01(\x03R\brejected\"\x18\n" +
+	"\x16ReloadTemplatesRequest\"I\n" +
+	"\x17ReloadTemplatesResponse\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x12\x1c\n" +
+	"\ttemplates\x18\x02 \x03(\tR\ttemplates\"\x17\n" +
+	"\x15GetServerStatsRequest\"y\n" +
+	"\x16GetServerStatsResponse\x121\n" +
+	"\x06uptime\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06uptime\x12,\n" +
+	"\amethods\x18\x02 \x03(\v2\x12.admin.MethodStatsR\amethods\"\xbe\x01\n" +
+	"\vMethodStats\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x14\n" +
+	"\x05calls\x18\x02 \x01(\x03R\x05calls\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x03R\x06errors\x12B\n" +
+	"\x0faverage_latency\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0eaverageLatency\x12%\n" +
+	"\x0eactive_streams\x18\x05 \x01(\x03R\ractiveStreams\"1\n" +
+	"\x15DumpGoroutinesRequest\x12\x18\n" +
+	"\agrouped\x18\x01 \x01(\bR\agrouped\"L\n" +
+	"\x16DumpGoroutinesResponse\x12\x1e\n" +
+	"\n" +
+	"goroutines\x18\x01 \x01(\x05R\n" +
+	"goroutines\x12\x12\n" +
+	"\x04dump\x18\x02 \x01(\tR\x04dump2\x96\x05\n" +
+	"\fAdminService\x12F\n" +
+	"\vSetLogLevel\x12\x19.admin.SetLogLevelRequest\x1a\x1a.admin.SetLogLevelResponse\"\x00\x12=\n" +
+	"\bSetChaos\x12\x16.admin.SetChaosRequest\x1a\x17.admin.SetChaosResponse\"\x00\x124\n" +
+	"\x05Drain\x12\x13.admin.DrainRequest\x1a\x14.admin.DrainResponse\"\x00\x12@\n" +
+	"\tGetConfig\x12\x17.admin.GetConfigRequest\x1a\x18.admin.GetConfigResponse\"\x00\x12C\n" +
+	"\n" +
+	"FlushCache\x12\x18.admin.FlushCacheRequest\x1a\x19.admin.FlushCacheResponse\"\x00\x12L\n" +
+	"\rGetWorkerPool\x12\x1b.admin.GetWorkerPoolRequest\x1a\x1c.admin.GetWorkerPoolResponse\"\x00\x12R\n" +
+	"\x0fReloadTemplates\x12\x1d.admin.ReloadTemplatesRequest\x1a\x1e.admin.ReloadTemplatesResponse\"\x00\x12O\n" +
+	"\x0eGetServerStats\x12\x1c.admin.GetServerStatsRequest\x1a\x1d.admin.GetServerStatsResponse\"\x00\x12O\n" +
+	"\x0eDumpGoroutines\x12\x1c.admin.DumpGoroutinesRequest\x1a\x1d.admin.DumpGoroutinesResponse\"\x00BJZHgithub.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/admin;adminpbb\x06proto3"
+
+var (
+	file_proto_admin_admin_proto_rawDescOnce sync.Once
+	file_proto_admin_admin_proto_rawDescData []byte
+)
+
+func file_proto_admin_admin_proto_rawDescGZIP() []byte {
+	file_proto_admin_admin_proto_rawDescOnce.Do(func() {
+		file_proto_admin_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_admin_admin_proto_rawDesc), len(file_proto_admin_admin_proto_rawDesc)))
+	})
+	return file_proto_admin_admin_proto_rawDescData
+}
+
+var file_proto_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_proto_admin_admin_proto_goTypes = []any{
+	(*SetLogLevelRequest)(nil),      // 0: admin.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),     // 1: admin.SetLogLevelResponse
+	(*SetChaosRequest)(nil),         // 2: admin.SetChaosRequest
+	(*SetChaosResponse)(nil),        // 3: admin.SetChaosResponse
+	(*DrainRequest)(nil),            // 4: admin.DrainRequest
+	(*DrainResponse)(nil),           // 5: admin.DrainResponse
+	(*GetConfigRequest)(nil),        // 6: admin.GetConfigRequest
+	(*GetConfigResponse)(nil),       // 7: admin.GetConfigResponse
+	(*FlushCacheRequest)(nil),       // 8: admin.FlushCacheRequest
+	(*FlushCacheResponse)(nil),      // 9: admin.FlushCacheResponse
+	(*GetWorkerPoolRequest)(nil),    // 10: admin.GetWorkerPoolRequest
+	(*GetWorkerPoolResponse)(nil),   // 11: admin.GetWorkerPoolResponse
+	(*ReloadTemplatesRequest)(nil),  // 12: admin.ReloadTemplatesRequest
+	(*ReloadTemplatesResponse)(nil), // 13: admin.ReloadTemplatesResponse
+	(*GetServerStatsRequest)(nil),   // 14: admin.GetServerStatsRequest
+	(*GetServerStatsResponse)(nil),  // 15: admin.GetServerStatsResponse
+	(*MethodStats)(nil),             // 16: admin.MethodStats
+	(*DumpGoroutinesRequest)(nil),   // 17: admin.DumpGoroutinesRequest
+	(*DumpGoroutinesResponse)(nil),  // 18: admin.DumpGoroutinesResponse
+	nil,                             // 19: admin.GetConfigResponse.SettingsEntry
+	(*durationpb.Duration)(nil),     // 20: google.protobuf.Duration
+}
+var file_proto_admin_admin_proto_depIdxs = []int32{
+	19, // 0: admin.GetConfigResponse.settings:type_name -> admin.GetConfigResponse.SettingsEntry
+	20, // 1: admin.GetServerStatsResponse.uptime:type_name -> google.protobuf.Duration
+	16, // 2: admin.GetServerStatsResponse.methods:type_name -> admin.MethodStats
+	20, // 3: admin.MethodStats.average_latency:type_name -> google.protobuf.Duration
+	0,  // 4: admin.AdminService.SetLogLevel:input_type -> admin.SetLogLevelRequest
+	2,  // 5: admin.AdminService.SetChaos:input_type -> admin.SetChaosRequest
+	4,  // 6: admin.AdminService.Drain:input_type -> admin.DrainRequest
+	6,  // 7: admin.AdminService.GetConfig:input_type -> admin.GetConfigRequest
+	8,  // 8: admin.AdminService.FlushCache:input_type -> admin.FlushCacheRequest
+	10, // 9: admin.AdminService.GetWorkerPool:input_type -> admin.GetWorkerPoolRequest
+	12, // 10: admin.AdminService.ReloadTemplates:input_type -> admin.ReloadTemplatesRequest
+	14, // 11: admin.AdminService.GetServerStats:input_type -> admin.GetServerStatsRequest
+	17, // 12: admin.AdminService.DumpGoroutines:input_type -> admin.DumpGoroutinesRequest
+	1,  // 13: admin.AdminService.SetLogLevel:output_type -> admin.SetLogLevelResponse
+	3,  // 14: admin.AdminService.SetChaos:output_type -> admin.SetChaosResponse
+	5,  // 15: admin.AdminService.Drain:output_type -> admin.DrainResponse
+	7,  // 16: admin.AdminService.GetConfig:output_type -> admin.GetConfigResponse
+	9,  // 17: admin.AdminService.FlushCache:output_type -> admin.FlushCacheResponse
+	11, // 18: admin.AdminService.GetWorkerPool:output_type -> admin.GetWorkerPoolResponse
+	13, // 19: admin.AdminService.ReloadTemplates:output_type -> admin.ReloadTemplatesResponse
+	15, // 20: admin.AdminService.GetServerStats:output_type -> admin.GetServerStatsResponse
+	18, // 21: admin.AdminService.DumpGoroutines:output_type -> admin.DumpGoroutinesResponse
+	13, // [13:22] is the sub-list for method output_type
+	4,  // [4:13] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_admin_admin_proto_init() }
+func file_proto_admin_admin_proto_init() {
+	if File_proto_admin_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_admin_admin_proto_rawDesc), len(file_proto_admin_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_admin_admin_proto_goTypes,
+		DependencyIndexes: file_proto_admin_admin_proto_depIdxs,
+		MessageInfos:      file_proto_admin_admin_proto_msgTypes,
+	}.Build()
+	File_proto_admin_admin_proto = out.File
+	file_proto_admin_admin_proto_goTypes = nil
+	file_proto_admin_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,2110 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/admin/admin.proto
+
+package adminpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SetLogLevelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetLogLevelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetLogLevelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetLogLevelRequestMultiError, or nil if none found.
+func (m *SetLogLevelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetLogLevelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _SetLogLevelRequest_Level_InLookup[m.GetLevel()]; !ok {
+		err := SetLogLevelRequestValidationError{
+			field:  "Level",
+			reason: "value must be in list [debug info warn error]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetLogLevelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetLogLevelRequestMultiError is an error wrapping multiple validation errors
+// returned by SetLogLevelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetLogLevelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetLogLevelRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetLogLevelRequestMultiError) AllErrors() []error { return m }
+
+// SetLogLevelRequestValidationError is the validation error returned by
+// SetLogLevelRequest.Validate if the designated constraints aren't met.
+type SetLogLevelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLogLevelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLogLevelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLogLevelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLogLevelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLogLevelRequestValidationError) ErrorName() string {
+	return "SetLogLevelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLogLevelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLogLevelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLogLevelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLogLevelRequestValidationError{}
+
+var _SetLogLevelRequest_Level_InLookup = map[string]struct{}{
+	"debug": {},
+	"info":  {},
+	"warn":  {},
+	"error": {},
+}
+
+// Validate checks the field values on SetLogLevelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetLogLevelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetLogLevelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetLogLevelResponseMultiError, or nil if none found.
+func (m *SetLogLevelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetLogLevelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PreviousLevel
+
+	if len(errors) > 0 {
+		return SetLogLevelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetLogLevelResponseMultiError is an error wrapping multiple validation
+// errors returned by SetLogLevelResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetLogLevelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetLogLevelResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetLogLevelResponseMultiError) AllErrors() []error { return m }
+
+// SetLogLevelResponseValidationError is the validation error returned by
+// SetLogLevelResponse.Validate if the designated constraints aren't met.
+type SetLogLevelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLogLevelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLogLevelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLogLevelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLogLevelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLogLevelResponseValidationError) ErrorName() string {
+	return "SetLogLevelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLogLevelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLogLevelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLogLevelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLogLevelResponseValidationError{}
+
+// Validate checks the field values on SetChaosRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetChaosRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetChaosRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetChaosRequestMultiError, or nil if none found.
+func (m *SetChaosRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetChaosRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Spec
+
+	if len(errors) > 0 {
+		return SetChaosRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetChaosRequestMultiError is an error wrapping multiple validation errors
+// returned by SetChaosRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetChaosRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetChaosRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetChaosRequestMultiError) AllErrors() []error { return m }
+
+// SetChaosRequestValidationError is the validation error returned by
+// SetChaosRequest.Validate if the designated constraints aren't met.
+type SetChaosRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetChaosRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetChaosRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetChaosRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetChaosRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetChaosRequestValidationError) ErrorName() string { return "SetChaosRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetChaosRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetChaosRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetChaosRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetChaosRequestValidationError{}
+
+// Validate checks the field values on SetChaosResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetChaosResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetChaosResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetChaosResponseMultiError, or nil if none found.
+func (m *SetChaosResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetChaosResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Chaos
+
+	if len(errors) > 0 {
+		return SetChaosResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetChaosResponseMultiError is an error wrapping multiple validation errors
+// returned by SetChaosResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SetChaosResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetChaosResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetChaosResponseMultiError) AllErrors() []error { return m }
+
+// SetChaosResponseValidationError is the validation error returned by
+// SetChaosResponse.Validate if the designated constraints aren't met.
+type SetChaosResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetChaosResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetChaosResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetChaosResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetChaosResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetChaosResponseValidationError) ErrorName() string { return "SetChaosResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetChaosResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetChaosResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetChaosResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetChaosResponseValidationError{}
+
+// Validate checks the field values on DrainRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DrainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DrainRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DrainRequestMultiError, or
+// nil if none found.
+func (m *DrainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DrainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DrainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DrainRequestMultiError is an error wrapping multiple validation errors
+// returned by DrainRequest.ValidateAll() if the designated constraints aren't met.
+type DrainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DrainRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DrainRequestMultiError) AllErrors() []error { return m }
+
+// DrainRequestValidationError is the validation error returned by
+// DrainRequest.Validate if the designated constraints aren't met.
+type DrainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DrainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DrainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DrainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DrainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DrainRequestValidationError) ErrorName() string { return "DrainRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DrainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDrainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DrainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DrainRequestValidationError{}
+
+// Validate checks the field values on DrainResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DrainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DrainResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DrainResponseMultiError, or
+// nil if none found.
+func (m *DrainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DrainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ActiveStreams
+
+	if len(errors) > 0 {
+		return DrainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DrainResponseMultiError is an error wrapping multiple validation errors
+// returned by DrainResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DrainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DrainResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DrainResponseMultiError) AllErrors() []error { return m }
+
+// DrainResponseValidationError is the validation error returned by
+// DrainResponse.Validate if the designated constraints aren't met.
+type DrainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DrainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DrainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DrainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DrainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DrainResponseValidationError) ErrorName() string { return "DrainResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DrainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDrainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DrainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DrainResponseValidationError{}
+
+// Validate checks the field values on GetConfigRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigRequestMultiError, or nil if none found.
+func (m *GetConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConfigRequestMultiError is an error wrapping multiple validation errors
+// returned by GetConfigRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConfigRequestMultiError) AllErrors() []error { return m }
+
+// GetConfigRequestValidationError is the validation error returned by
+// GetConfigRequest.Validate if the designated constraints aren't met.
+type GetConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigRequestValidationError) ErrorName() string { return "GetConfigRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigRequestValidationError{}
+
+// Validate checks the field values on GetConfigResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigResponseMultiError, or nil if none found.
+func (m *GetConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Settings
+
+	if len(errors) > 0 {
+		return GetConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConfigResponseMultiError is an error wrapping multiple validation errors
+// returned by GetConfigResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConfigResponseMultiError) AllErrors() []error { return m }
+
+// GetConfigResponseValidationError is the validation error returned by
+// GetConfigResponse.Validate if the designated constraints aren't met.
+type GetConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigResponseValidationError) ErrorName() string {
+	return "GetConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigResponseValidationError{}
+
+// Validate checks the field values on FlushCacheRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FlushCacheRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlushCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlushCacheRequestMultiError, or nil if none found.
+func (m *FlushCacheRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlushCacheRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FlushCacheRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlushCacheRequestMultiError is an error wrapping multiple validation errors
+// returned by FlushCacheRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FlushCacheRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlushCacheRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlushCacheRequestMultiError) AllErrors() []error { return m }
+
+// FlushCacheRequestValidationError is the validation error returned by
+// FlushCacheRequest.Validate if the designated constraints aren't met.
+type FlushCacheRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlushCacheRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlushCacheRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlushCacheRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlushCacheRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlushCacheRequestValidationError) ErrorName() string {
+	return "FlushCacheRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlushCacheRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlushCacheRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlushCacheRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlushCacheRequestValidationError{}
+
+// Validate checks the field values on FlushCacheResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FlushCacheResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlushCacheResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlushCacheResponseMultiError, or nil if none found.
+func (m *FlushCacheResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlushCacheResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Flushed
+
+	if len(errors) > 0 {
+		return FlushCacheResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlushCacheResponseMultiError is an error wrapping multiple validation errors
+// returned by FlushCacheResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FlushCacheResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlushCacheResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlushCacheResponseMultiError) AllErrors() []error { return m }
+
+// FlushCacheResponseValidationError is the validation error returned by
+// FlushCacheResponse.Validate if the designated constraints aren't met.
+type FlushCacheResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlushCacheResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlushCacheResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlushCacheResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlushCacheResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlushCacheResponseValidationError) ErrorName() string {
+	return "FlushCacheResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlushCacheResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlushCacheResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlushCacheResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlushCacheResponseValidationError{}
+
+// Validate checks the field values on GetWorkerPoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkerPoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkerPoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkerPoolRequestMultiError, or nil if none found.
+func (m *GetWorkerPoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkerPoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetWorkerPoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkerPoolRequestMultiError is an error wrapping multiple validation
+// errors returned by GetWorkerPoolRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkerPoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkerPoolRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkerPoolRequestMultiError) AllErrors() []error { return m }
+
+// GetWorkerPoolRequestValidationError is the validation error returned by
+// GetWorkerPoolRequest.Validate if the designated constraints aren't met.
+type GetWorkerPoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkerPoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkerPoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkerPoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkerPoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkerPoolRequestValidationError) ErrorName() string {
+	return "GetWorkerPoolRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkerPoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkerPoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkerPoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkerPoolRequestValidationError{}
+
+// Validate checks the field values on GetWorkerPoolResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkerPoolResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkerPoolResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkerPoolResponseMultiError, or nil if none found.
+func (m *GetWorkerPoolResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkerPoolResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Workers
+
+	// no validation rules for Busy
+
+	// no validation rules for Queued
+
+	// no validation rules for QueueCapacity
+
+	// no validation rules for Completed
+
+	// no validation rules for Rejected
+
+	if len(errors) > 0 {
+		return GetWorkerPoolResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkerPoolResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWorkerPoolResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkerPoolResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkerPoolResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkerPoolResponseMultiError) AllErrors() []error { return m }
+
+// GetWorkerPoolResponseValidationError is the validation error returned by
+// GetWorkerPoolResponse.Validate if the designated constraints aren't met.
+type GetWorkerPoolResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkerPoolResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkerPoolResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkerPoolResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkerPoolResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkerPoolResponseValidationError) ErrorName() string {
+	return "GetWorkerPoolResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkerPoolResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkerPoolResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkerPoolResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkerPoolResponseValidationError{}
+
+// Validate checks the field values on ReloadTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReloadTemplatesRequestMultiError, or nil if none found.
+func (m *ReloadTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReloadTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ReloadTemplatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReloadTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadTemplatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ReloadTemplatesRequestValidationError is the validation error returned by
+// ReloadTemplatesRequest.Validate if the designated constraints aren't met.
+type ReloadTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadTemplatesRequestValidationError) ErrorName() string {
+	return "ReloadTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadTemplatesRequestValidationError{}
+
+// Validate checks the field values on ReloadTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReloadTemplatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReloadTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReloadTemplatesResponseMultiError, or nil if none found.
+func (m *ReloadTemplatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReloadTemplatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dir
+
+	if len(errors) > 0 {
+		return ReloadTemplatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReloadTemplatesResponseMultiError is an error wrapping multiple validation
+// errors returned by ReloadTemplatesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReloadTemplatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReloadTemplatesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReloadTemplatesResponseMultiError) AllErrors() []error { return m }
+
+// ReloadTemplatesResponseValidationError is the validation error returned by
+// ReloadTemplatesResponse.Validate if the designated constraints aren't met.
+type ReloadTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReloadTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReloadTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReloadTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReloadTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReloadTemplatesResponseValidationError) ErrorName() string {
+	return "ReloadTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReloadTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReloadTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReloadTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReloadTemplatesResponseValidationError{}
+
+// Validate checks the field values on GetServerStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetServerStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetServerStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetServerStatsRequestMultiError, or nil if none found.
+func (m *GetServerStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetServerStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetServerStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetServerStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetServerStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetServerStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetServerStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetServerStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetServerStatsRequestValidationError is the validation error returned by
+// GetServerStatsRequest.Validate if the designated constraints aren't met.
+type GetServerStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetServerStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetServerStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetServerStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetServerStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetServerStatsRequestValidationError) ErrorName() string {
+	return "GetServerStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetServerStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetServerStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetServerStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetServerStatsRequestValidationError{}
+
+// Validate checks the field values on GetServerStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetServerStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetServerStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetServerStatsResponseMultiError, or nil if none found.
+func (m *GetServerStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetServerStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUptime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetServerStatsResponseValidationError{
+					field:  "Uptime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetServerStatsResponseValidationError{
+					field:  "Uptime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUptime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetServerStatsResponseValidationError{
+				field:  "Uptime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMethods() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetServerStatsResponseValidationError{
+						field:  fmt.Sprintf("Methods[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetServerStatsResponseValidationError{
+						field:  fmt.Sprintf("Methods[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetServerStatsResponseValidationError{
+					field:  fmt.Sprintf("Methods[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetServerStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetServerStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetServerStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetServerStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetServerStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetServerStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetServerStatsResponseValidationError is the validation error returned by
+// GetServerStatsResponse.Validate if the designated constraints aren't met.
+type GetServerStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetServerStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetServerStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetServerStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetServerStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetServerStatsResponseValidationError) ErrorName() string {
+	return "GetServerStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetServerStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetServerStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetServerStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetServerStatsResponseValidationError{}
+
+// Validate checks the field values on MethodStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MethodStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MethodStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MethodStatsMultiError, or
+// nil if none found.
+func (m *MethodStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MethodStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Calls
+
+	// no validation rules for Errors
+
+	if all {
+		switch v := interface{}(m.GetAverageLatency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MethodStatsValidationError{
+					field:  "AverageLatency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MethodStatsValidationError{
+					field:  "AverageLatency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAverageLatency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MethodStatsValidationError{
+				field:  "AverageLatency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ActiveStreams
+
+	if len(errors) > 0 {
+		return MethodStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MethodStatsMultiError is an error wrapping multiple validation errors
+// returned by MethodStats.ValidateAll() if the designated constraints aren't met.
+type MethodStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MethodStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MethodStatsMultiError) AllErrors() []error { return m }
+
+// MethodStatsValidationError is the validation error returned by
+// MethodStats.Validate if the designated constraints aren't met.
+type MethodStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MethodStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MethodStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MethodStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MethodStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MethodStatsValidationError) ErrorName() string { return "MethodStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MethodStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMethodStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MethodStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MethodStatsValidationError{}
+
+// Validate checks the field values on DumpGoroutinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DumpGoroutinesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DumpGoroutinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DumpGoroutinesRequestMultiError, or nil if none found.
+func (m *DumpGoroutinesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DumpGoroutinesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Grouped
+
+	if len(errors) > 0 {
+		return DumpGoroutinesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DumpGoroutinesRequestMultiError is an error wrapping multiple validation
+// errors returned by DumpGoroutinesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DumpGoroutinesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DumpGoroutinesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DumpGoroutinesRequestMultiError) AllErrors() []error { return m }
+
+// DumpGoroutinesRequestValidationError is the validation error returned by
+// DumpGoroutinesRequest.Validate if the designated constraints aren't met.
+type DumpGoroutinesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DumpGoroutinesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DumpGoroutinesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DumpGoroutinesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DumpGoroutinesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DumpGoroutinesRequestValidationError) ErrorName() string {
+	return "DumpGoroutinesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DumpGoroutinesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDumpGoroutinesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DumpGoroutinesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DumpGoroutinesRequestValidationError{}
+
+// Validate checks the field values on DumpGoroutinesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DumpGoroutinesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DumpGoroutinesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DumpGoroutinesResponseMultiError, or nil if none found.
+func (m *DumpGoroutinesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DumpGoroutinesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Goroutines
+
+	// no validation rules for Dump
+
+	if len(errors) > 0 {
+		return DumpGoroutinesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DumpGoroutinesResponseMultiError is an error wrapping multiple validation
+// errors returned by DumpGoroutinesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DumpGoroutinesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DumpGoroutinesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DumpGoroutinesResponseMultiError) AllErrors() []error { return m }
+
+// DumpGoroutinesResponseValidationError is the validation error returned by
+// DumpGoroutinesResponse.Validate if the designated constraints aren't met.
+type DumpGoroutinesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DumpGoroutinesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DumpGoroutinesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DumpGoroutinesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DumpGoroutinesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DumpGoroutinesResponseValidationError) ErrorName() string {
+	return "DumpGoroutinesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DumpGoroutinesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDumpGoroutinesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DumpGoroutinesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DumpGoroutinesResponseValidationError{}
@@ -1,187 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.10
-// 	protoc        v6.33.1
-// source: proto/greeting.proto
-
-package greeting
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// The request message containing the user's name
-type HelloRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *HelloRequest) Reset() {
-	*x = HelloRequest{}
-	mi := &file_proto_greeting_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *HelloRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*HelloRequest) ProtoMessage() {}
-
-func (x *HelloRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_greeting_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HelloRequest.ProtoReflect.Descriptor instead.
-func (*HelloRequest) Descriptor() ([]byte, []int) {
-	return file_proto_greeting_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *HelloRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-// The response message containing the greeting
-type HelloResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *HelloResponse) Reset() {
-	*x = HelloResponse{}
-	mi := &file_proto_greeting_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *HelloResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*HelloResponse) ProtoMessage() {}
-
-func (x *HelloResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_greeting_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HelloResponse.ProtoReflect.Descriptor instead.
-func (*HelloResponse) Descriptor() ([]byte, []int) {
-	return file_proto_greeting_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *HelloResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *HelloResponse) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-var File_proto_greeting_proto protoreflect.FileDescriptor
-
-const file_proto_greeting_proto_rawDesc = "" +
-	"\n" +
-	"\x14proto/greeting.proto\x12\bgreeting\"\"\n" +
-	"\fHelloRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"?\n" +
-	"\rHelloResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count2\x99\x01\n" +
-	"\x0fGreetingService\x12=\n" +
-	"\bSayHello\x12\x16.greeting.HelloRequest\x1a\x17.greeting.HelloResponse\"\x00\x12G\n" +
-	"\x10SayHelloMultiple\x12\x16.greeting.HelloRequest\x1a\x17.greeting.HelloResponse\"\x000\x01BEZCgithub.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greetingb\x06proto3"
-
-var (
-	file_proto_greeting_proto_rawDescOnce sync.Once
-	file_proto_greeting_proto_rawDescData []byte
-)
-
-func file_proto_greeting_proto_rawDescGZIP() []byte {
-	file_proto_greeting_proto_rawDescOnce.Do(func() {
-		file_proto_greeting_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_greeting_proto_rawDesc), len(file_proto_greeting_proto_rawDesc)))
-	})
-	return file_proto_greeting_proto_rawDescData
-}
-
-var file_proto_greeting_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_proto_greeting_proto_goTypes = []any{
-	(*HelloRequest)(nil),  // 0: greeting.HelloRequest
-	(*HelloResponse)(nil), // 1: greeting.HelloResponse
-}
-var file_proto_greeting_proto_depIdxs = []int32{
-	0, // 0: greeting.GreetingService.SayHello:input_type -> greeting.HelloRequest
-	0, // 1: greeting.GreetingService.SayHelloMultiple:input_type -> greeting.HelloRequest
-	1, // 2: greeting.GreetingService.SayHello:output_type -> greeting.HelloResponse
-	1, // 3: greeting.GreetingService.SayHelloMultiple:output_type -> greeting.HelloResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
-}
-
-func init() { file_proto_greeting_proto_init() }
-func file_proto_greeting_proto_init() {
-	if File_proto_greeting_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_greeting_proto_rawDesc), len(file_proto_greeting_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proto_greeting_proto_goTypes,
-		DependencyIndexes: file_proto_greeting_proto_depIdxs,
-		MessageInfos:      file_proto_greeting_proto_msgTypes,
-	}.Build()
-	File_proto_greeting_proto = out.File
-	file_proto_greeting_proto_goTypes = nil
-	file_proto_greeting_proto_depIdxs = nil
-}
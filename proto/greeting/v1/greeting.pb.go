@@ -0,0 +1,2329 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: proto/greeting/v1/greeting.proto
+
+// Version 1 of the greeting API. The package keeps its original name,
+// rather than greeting.v1, so clients built before the versioned layout
+// still reach the same service.
+
+package greetingv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The request message identifying who to greet, either directly by name
+// or by a user id the server resolves from its directory
+//
+// The (validate.rules) options are checked by the server before a request
+// reaches the service; see interceptors/validate.go.
+type HelloRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identity:
+	//
+	//	*HelloRequest_Name
+	//	*HelloRequest_UserId
+	Identity isHelloRequest_Identity `protobuf_oneof:"identity"`
+	// SayHelloMultiple only: how many greetings to stream and the pause
+	// between them in milliseconds. Zero keeps the server's defaults; the
+	// server rejects values above its limits, which can't be raised past
+	// the bounds given here.
+	Count      int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	IntervalMs int32 `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	// Preferred language of the greeting as a BCP 47 tag ("de-CH") or an
+	// Accept-Language list ("fr-CA, de;q=0.8"). When empty the locale
+	// header is used; unsupported languages fall back to English.
+	Language      string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HelloRequest) Reset() {
+	*x = HelloRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HelloRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelloRequest) ProtoMessage() {}
+
+func (x *HelloRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelloRequest.ProtoReflect.Descriptor instead.
+func (*HelloRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HelloRequest) GetIdentity() isHelloRequest_Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *HelloRequest) GetName() string {
+	if x != nil {
+		if x, ok := x.Identity.(*HelloRequest_Name); ok {
+			return x.Name
+		}
+	}
+	return ""
+}
+
+func (x *HelloRequest) GetUserId() int64 {
+	if x != nil {
+		if x, ok := x.Identity.(*HelloRequest_UserId); ok {
+			return x.UserId
+		}
+	}
+	return 0
+}
+
+func (x *HelloRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *HelloRequest) GetIntervalMs() int32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *HelloRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type isHelloRequest_Identity interface {
+	isHelloRequest_Identity()
+}
+
+type HelloRequest_Name struct {
+	// 1 to 64 characters, without control characters
+	Name string `protobuf:"bytes,1,opt,name=name,proto3,oneof"`
+}
+
+type HelloRequest_UserId struct {
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+func (*HelloRequest_Name) isHelloRequest_Identity() {}
+
+func (*HelloRequest_UserId) isHelloRequest_Identity() {}
+
+// The response message containing the greeting
+type HelloResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// How many times the name has been greeted, including this greeting.
+	// Streaming RPCs use it to number their messages instead.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// The parts the message is built from, so clients can restyle it.
+	// message is "<salutation>, <subject><punctuation>", though some
+	// languages join salutation and subject differently (Japanese uses "、").
+	// They are empty when a greeting template rewrote the message.
+	Salutation  string `protobuf:"bytes,3,opt,name=salutation,proto3" json:"salutation,omitempty"`
+	Subject     string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Punctuation string `protobuf:"bytes,5,opt,name=punctuation,proto3" json:"punctuation,omitempty"`
+	// Set with every other field but count empty when the request carried
+	// an if-none-match header equal to the current greeting-hash, meaning the
+	// client's cached response is still valid apart from its count
+	NotModified bool `protobuf:"varint,6,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	// BCP 47 tag of the language the greeting is in
+	Language string `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	// SayHelloMultiple and ResumeStream only: an opaque cursor to pass to
+	// ResumeStream to continue the stream after this greeting
+	ResumeToken   string `protobuf:"bytes,8,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HelloResponse) Reset() {
+	*x = HelloResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HelloResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelloResponse) ProtoMessage() {}
+
+func (x *HelloResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelloResponse.ProtoReflect.Descriptor instead.
+func (*HelloResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HelloResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *HelloResponse) GetSalutation() string {
+	if x != nil {
+		return x.Salutation
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetPunctuation() string {
+	if x != nil {
+		return x.Punctuation
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+func (x *HelloResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// The request message for greeting several names at once
+type SayHelloBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Names to greet, 1 to 100. Each is checked on its own, as SayHello
+	// would, so an invalid one fails only its own result.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// Preferred language of every greeting, as in HelloRequest
+	Language      string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloBatchRequest) Reset() {
+	*x = SayHelloBatchRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloBatchRequest) ProtoMessage() {}
+
+func (x *SayHelloBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloBatchRequest.ProtoReflect.Descriptor instead.
+func (*SayHelloBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SayHelloBatchRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *SayHelloBatchRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// The greetings of a batch
+type SayHelloBatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One per requested name, in request order
+	Results []*HelloResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// How many results failed
+	Failed        int32 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloBatchResponse) Reset() {
+	*x = SayHelloBatchResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloBatchResponse) ProtoMessage() {}
+
+func (x *SayHelloBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloBatchResponse.ProtoReflect.Descriptor instead.
+func (*SayHelloBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SayHelloBatchResponse) GetResults() []*HelloResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SayHelloBatchResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// The outcome of greeting one name of a batch
+type HelloResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*HelloResult_Response
+	//	*HelloResult_Error
+	Result        isHelloResult_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HelloResult) Reset() {
+	*x = HelloResult{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HelloResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelloResult) ProtoMessage() {}
+
+func (x *HelloResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelloResult.ProtoReflect.Descriptor instead.
+func (*HelloResult) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HelloResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HelloResult) GetResult() isHelloResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *HelloResult) GetResponse() *HelloResponse {
+	if x != nil {
+		if x, ok := x.Result.(*HelloResult_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *HelloResult) GetError() *status.Status {
+	if x != nil {
+		if x, ok := x.Result.(*HelloResult_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isHelloResult_Result interface {
+	isHelloResult_Result()
+}
+
+type HelloResult_Response struct {
+	Response *HelloResponse `protobuf:"bytes,2,opt,name=response,proto3,oneof"`
+}
+
+type HelloResult_Error struct {
+	// The status SayHello would have failed with
+	Error *status.Status `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*HelloResult_Response) isHelloResult_Result() {}
+
+func (*HelloResult_Error) isHelloResult_Result() {}
+
+// The request message for continuing a stream
+type ResumeStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resume_token of the last greeting received
+	ResumeToken   string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeStreamRequest) Reset() {
+	*x = ResumeStreamRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeStreamRequest) ProtoMessage() {}
+
+func (x *ResumeStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeStreamRequest.ProtoReflect.Descriptor instead.
+func (*ResumeStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResumeStreamRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// The request message for tailing server logs
+type StreamLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of buffered lines to replay before streaming new ones
+	// (0 replays everything buffered)
+	Tail          int32 `protobuf:"varint,1,opt,name=tail,proto3" json:"tail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamLogsRequest) GetTail() int32 {
+	if x != nil {
+		return x.Tail
+	}
+	return 0
+}
+
+// A single server log line
+type LogLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogLine) Reset() {
+	*x = LogLine{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLine) ProtoMessage() {}
+
+func (x *LogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
+func (*LogLine) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LogLine) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+// The request message for server statistics
+type StatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{8}
+}
+
+// The response message describing current server load
+type StatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Requests waiting for a free slot
+	QueueDepth int32 `protobuf:"varint,1,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	// Maximum number of waiting requests before new ones are rejected
+	QueueCapacity int32 `protobuf:"varint,2,opt,name=queue_capacity,json=queueCapacity,proto3" json:"queue_capacity,omitempty"`
+	// Requests currently being handled
+	InFlight int32 `protobuf:"varint,3,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	// Requests rejected with ResourceExhausted because the queue was full
+	Rejected      int64 `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StatsResponse) GetQueueDepth() int32 {
+	if x != nil {
+		return x.QueueDepth
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetQueueCapacity() int32 {
+	if x != nil {
+		return x.QueueCapacity
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetInFlight() int32 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+// The request message for per-name greeting statistics
+type NameStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NameStatsRequest) Reset() {
+	*x = NameStatsRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NameStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameStatsRequest) ProtoMessage() {}
+
+func (x *NameStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameStatsRequest.ProtoReflect.Descriptor instead.
+func (*NameStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NameStatsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The response message describing how a name has been greeted
+type NameStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GreetCount     int64                  `protobuf:"varint,2,opt,name=greet_count,json=greetCount,proto3" json:"greet_count,omitempty"`
+	FirstGreetedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=first_greeted_at,json=firstGreetedAt,proto3" json:"first_greeted_at,omitempty"`
+	LastGreetedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_greeted_at,json=lastGreetedAt,proto3" json:"last_greeted_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NameStatsResponse) Reset() {
+	*x = NameStatsResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NameStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameStatsResponse) ProtoMessage() {}
+
+func (x *NameStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameStatsResponse.ProtoReflect.Descriptor instead.
+func (*NameStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NameStatsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NameStatsResponse) GetGreetCount() int64 {
+	if x != nil {
+		return x.GreetCount
+	}
+	return 0
+}
+
+func (x *NameStatsResponse) GetFirstGreetedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstGreetedAt
+	}
+	return nil
+}
+
+func (x *NameStatsResponse) GetLastGreetedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastGreetedAt
+	}
+	return nil
+}
+
+// The request message for listing recorded greetings
+type ListGreetingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only list greetings to this name; empty lists everyone
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Greetings per page; 0 uses the server default of 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token from the previous page; empty starts at the oldest
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGreetingsRequest) Reset() {
+	*x = ListGreetingsRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGreetingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGreetingsRequest) ProtoMessage() {}
+
+func (x *ListGreetingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGreetingsRequest.ProtoReflect.Descriptor instead.
+func (*ListGreetingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListGreetingsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListGreetingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGreetingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// A greeting the server has sent
+type GreetingRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	GreetedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=greeted_at,json=greetedAt,proto3" json:"greeted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GreetingRecord) Reset() {
+	*x = GreetingRecord{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GreetingRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GreetingRecord) ProtoMessage() {}
+
+func (x *GreetingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GreetingRecord.ProtoReflect.Descriptor instead.
+func (*GreetingRecord) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GreetingRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GreetingRecord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GreetingRecord) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GreetingRecord) GetGreetedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GreetedAt
+	}
+	return nil
+}
+
+// One recorded greeting in a ListGreetings page
+type ListGreetingsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Greeting *GreetingRecord        `protobuf:"bytes,1,opt,name=greeting,proto3" json:"greeting,omitempty"`
+	// Set on the last message of a page when more greetings follow
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGreetingsResponse) Reset() {
+	*x = ListGreetingsResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGreetingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGreetingsResponse) ProtoMessage() {}
+
+func (x *ListGreetingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGreetingsResponse.ProtoReflect.Descriptor instead.
+func (*ListGreetingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListGreetingsResponse) GetGreeting() *GreetingRecord {
+	if x != nil {
+		return x.Greeting
+	}
+	return nil
+}
+
+func (x *ListGreetingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request message for a name's greeting count
+type GetGreetingCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGreetingCountRequest) Reset() {
+	*x = GetGreetingCountRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGreetingCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGreetingCountRequest) ProtoMessage() {}
+
+func (x *GetGreetingCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGreetingCountRequest.ProtoReflect.Descriptor instead.
+func (*GetGreetingCountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetGreetingCountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The response message carrying a name's greeting count
+type GetGreetingCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGreetingCountResponse) Reset() {
+	*x = GetGreetingCountResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGreetingCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGreetingCountResponse) ProtoMessage() {}
+
+func (x *GetGreetingCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGreetingCountResponse.ProtoReflect.Descriptor instead.
+func (*GetGreetingCountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetGreetingCountResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGreetingCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// The request message for subscribing to greetings
+type SubscribeGreetingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only push greetings to this name; empty pushes everyone's
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeGreetingsRequest) Reset() {
+	*x = SubscribeGreetingsRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGreetingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGreetingsRequest) ProtoMessage() {}
+
+func (x *SubscribeGreetingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGreetingsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeGreetingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SubscribeGreetingsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A greeting SayHello has just sent
+type GreetingEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// How many times the name has been greeted, including this greeting
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// BCP 47 tag of the language the greeting is in
+	Language  string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	GreetedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=greeted_at,json=greetedAt,proto3" json:"greeted_at,omitempty"`
+	// Events dropped for this subscriber since the previous one it received
+	Missed        int64 `protobuf:"varint,6,opt,name=missed,proto3" json:"missed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GreetingEvent) Reset() {
+	*x = GreetingEvent{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GreetingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GreetingEvent) ProtoMessage() {}
+
+func (x *GreetingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GreetingEvent.ProtoReflect.Descriptor instead.
+func (*GreetingEvent) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GreetingEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GreetingEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GreetingEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GreetingEvent) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GreetingEvent) GetGreetedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GreetedAt
+	}
+	return nil
+}
+
+func (x *GreetingEvent) GetMissed() int64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+// The request message for listing supported languages
+type ListSupportedLanguagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupportedLanguagesRequest) Reset() {
+	*x = ListSupportedLanguagesRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupportedLanguagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportedLanguagesRequest) ProtoMessage() {}
+
+func (x *ListSupportedLanguagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportedLanguagesRequest.ProtoReflect.Descriptor instead.
+func (*ListSupportedLanguagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{19}
+}
+
+// A language SayHello can greet in
+type Language struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// BCP 47 tag, e.g. "de"
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// English name, e.g. "German"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The language's own name, e.g. "Deutsch"
+	NativeName    string `protobuf:"bytes,3,opt,name=native_name,json=nativeName,proto3" json:"native_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Language) Reset() {
+	*x = Language{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Language) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Language) ProtoMessage() {}
+
+func (x *Language) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Language.ProtoReflect.Descriptor instead.
+func (*Language) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Language) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Language) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Language) GetNativeName() string {
+	if x != nil {
+		return x.NativeName
+	}
+	return ""
+}
+
+// The response message listing supported languages
+type ListSupportedLanguagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Languages     []*Language            `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupportedLanguagesResponse) Reset() {
+	*x = ListSupportedLanguagesResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupportedLanguagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportedLanguagesResponse) ProtoMessage() {}
+
+func (x *ListSupportedLanguagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportedLanguagesResponse.ProtoReflect.Descriptor instead.
+func (*ListSupportedLanguagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSupportedLanguagesResponse) GetLanguages() []*Language {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+// The request message for large greetings
+type SayHelloLargeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Payload size in bytes, at most 64 MiB
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// StreamHelloLarge only: payload bytes per message, at most 4 MiB.
+	// Keep it a little under the receive limit, as each message also
+	// carries its other fields. Zero uses 64 KiB.
+	ChunkBytes    int32 `protobuf:"varint,3,opt,name=chunk_bytes,json=chunkBytes,proto3" json:"chunk_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloLargeRequest) Reset() {
+	*x = SayHelloLargeRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloLargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloLargeRequest) ProtoMessage() {}
+
+func (x *SayHelloLargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloLargeRequest.ProtoReflect.Descriptor instead.
+func (*SayHelloLargeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SayHelloLargeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SayHelloLargeRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *SayHelloLargeRequest) GetChunkBytes() int32 {
+	if x != nil {
+		return x.ChunkBytes
+	}
+	return 0
+}
+
+// A large greeting, or one chunk of it
+type SayHelloLargeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set on the first message only
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Position of payload within the whole payload
+	Offset        int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloLargeResponse) Reset() {
+	*x = SayHelloLargeResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloLargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloLargeResponse) ProtoMessage() {}
+
+func (x *SayHelloLargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloLargeResponse.ProtoReflect.Descriptor instead.
+func (*SayHelloLargeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SayHelloLargeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SayHelloLargeResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SayHelloLargeResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// One message of a document upload
+type UploadDocumentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadDocumentRequest_Info
+	//	*UploadDocumentRequest_Chunk
+	Data          isUploadDocumentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDocumentRequest) Reset() {
+	*x = UploadDocumentRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentRequest) ProtoMessage() {}
+
+func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UploadDocumentRequest) GetData() isUploadDocumentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadDocumentRequest) GetInfo() *DocumentInfo {
+	if x != nil {
+		if x, ok := x.Data.(*UploadDocumentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadDocumentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadDocumentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadDocumentRequest_Data interface {
+	isUploadDocumentRequest_Data()
+}
+
+type UploadDocumentRequest_Info struct {
+	// The first message only
+	Info *DocumentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadDocumentRequest_Chunk struct {
+	// The document's next bytes, at most 1 MiB per message
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadDocumentRequest_Info) isUploadDocumentRequest_Data() {}
+
+func (*UploadDocumentRequest_Chunk) isUploadDocumentRequest_Data() {}
+
+// What the server needs to know about a document before its bytes
+type DocumentInfo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Filename    string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Total size, when the client knows it, so an oversize document is
+	// refused before its bytes are sent; zero when unknown
+	SizeBytes     int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentInfo) Reset() {
+	*x = DocumentInfo{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentInfo) ProtoMessage() {}
+
+func (x *DocumentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentInfo.ProtoReflect.Descriptor instead.
+func (*DocumentInfo) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DocumentInfo) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DocumentInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DocumentInfo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// The stored document
+type UploadDocumentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the stored document
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Bytes received
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Hex SHA-256 checksum of the bytes received, for the client to compare
+	// with its own
+	Sha256        string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDocumentResponse) Reset() {
+	*x = UploadDocumentResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentResponse) ProtoMessage() {}
+
+func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UploadDocumentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadDocumentResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *UploadDocumentResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// The request message for starting a conversation
+type StartConversationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1 to 64 characters, without control characters, as in HelloRequest
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Preferred language of the conversation's greetings, as in HelloRequest
+	Language      string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartConversationRequest) Reset() {
+	*x = StartConversationRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartConversationRequest) ProtoMessage() {}
+
+func (x *StartConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartConversationRequest.ProtoReflect.Descriptor instead.
+func (*StartConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StartConversationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartConversationRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// The request message for greeting again within a conversation
+type ContinueConversationRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// Switches the conversation to this language from this greeting on;
+	// empty keeps the one it has
+	Language      string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContinueConversationRequest) Reset() {
+	*x = ContinueConversationRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContinueConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContinueConversationRequest) ProtoMessage() {}
+
+func (x *ContinueConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContinueConversationRequest.ProtoReflect.Descriptor instead.
+func (*ContinueConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ContinueConversationRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ContinueConversationRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// A greeting within a conversation
+type ConversationResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Message      string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Which greeting of the conversation this is, 1 for StartConversation
+	Turn int32 `protobuf:"varint,3,opt,name=turn,proto3" json:"turn,omitempty"`
+	// The conversation's latest greetings, oldest first, this one included;
+	// the server keeps a bounded number
+	History []string `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`
+	// The conversation's preferred language, empty for the server's default
+	Language string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	// When the session expires unless used again
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationResponse) Reset() {
+	*x = ConversationResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationResponse) ProtoMessage() {}
+
+func (x *ConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationResponse.ProtoReflect.Descriptor instead.
+func (*ConversationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ConversationResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ConversationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConversationResponse) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *ConversationResponse) GetHistory() []string {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *ConversationResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ConversationResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// The request message for ending a conversation
+type EndConversationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndConversationRequest) Reset() {
+	*x = EndConversationRequest{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndConversationRequest) ProtoMessage() {}
+
+func (x *EndConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndConversationRequest.ProtoReflect.Descriptor instead.
+func (*EndConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EndConversationRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+// The conversation that ended
+type EndConversationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How many greetings the conversation had
+	Turns         int32 `protobuf:"varint,1,opt,name=turns,proto3" json:"turns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndConversationResponse) Reset() {
+	*x = EndConversationResponse{}
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndConversationResponse) ProtoMessage() {}
+
+func (x *EndConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v1_greeting_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndConversationResponse.ProtoReflect.Descriptor instead.
+func (*EndConversationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v1_greeting_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EndConversationResponse) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+var File_proto_greeting_v1_greeting_proto protoreflect.FileDescriptor
+
+const file_proto_greeting_v1_greeting_proto_rawDesc = "" +
+	"\n" +
+	" proto/greeting/v1/greeting.proto\x12\bgreeting\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/rpc/status.proto\x1a\x17validate/validate.proto\"\xe7\x01\n" +
+	"\fHelloRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x17\xfaB\x14r\x12\x10\x01\x18@2\f^[^\\p{Cc}]*$H\x00R\x04name\x12\"\n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x00R\x06userId\x12 \n" +
+	"\x05count\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05count\x12,\n" +
+	"\vinterval_ms\x18\x04 \x01(\x05B\v\xfaB\b\x1a\x06\x18\xe0\xd4\x03(\x00R\n" +
+	"intervalMs\x12#\n" +
+	"\blanguage\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18dR\blanguageB\x0f\n" +
+	"\bidentity\x12\x03\xf8B\x01\"\xfd\x01\n" +
+	"\rHelloResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1e\n" +
+	"\n" +
+	"salutation\x18\x03 \x01(\tR\n" +
+	"salutation\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12 \n" +
+	"\vpunctuation\x18\x05 \x01(\tR\vpunctuation\x12!\n" +
+	"\fnot_modified\x18\x06 \x01(\bR\vnotModified\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\x12!\n" +
+	"\fresume_token\x18\b \x01(\tR\vresumeToken\"]\n" +
+	"\x14SayHelloBatchRequest\x12 \n" +
+	"\x05names\x18\x01 \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10dR\x05names\x12#\n" +
+	"\blanguage\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18dR\blanguage\"`\n" +
+	"\x15SayHelloBatchResponse\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.greeting.HelloResultR\aresults\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\"\x8e\x01\n" +
+	"\vHelloResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\bresponse\x18\x02 \x01(\v2\x17.greeting.HelloResponseH\x00R\bresponse\x12*\n" +
+	"\x05error\x18\x03 \x01(\v2\x12.google.rpc.StatusH\x00R\x05errorB\b\n" +
+	"\x06result\"D\n" +
+	"\x13ResumeStreamRequest\x12-\n" +
+	"\fresume_token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\bR\vresumeToken\"'\n" +
+	"\x11StreamLogsRequest\x12\x12\n" +
+	"\x04tail\x18\x01 \x01(\x05R\x04tail\"\x1d\n" +
+	"\aLogLine\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\tR\x04line\"\x0e\n" +
+	"\fStatsRequest\"\x90\x01\n" +
+	"\rStatsResponse\x12\x1f\n" +
+	"\vqueue_depth\x18\x01 \x01(\x05R\n" +
+	"queueDepth\x12%\n" +
+	"\x0equeue_capacity\x18\x02 \x01(\x05R\rqueueCapacity\x12\x1b\n" +
+	"\tin_flight\x18\x03 \x01(\x05R\binFlight\x12\x1a\n" +
+	"\brejected\x18\x04 \x01(\x03R\brejected\"&\n" +
+	"\x10NameStatsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xd2\x01\n" +
+	"\x11NameStatsResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vgreet_count\x18\x02 \x01(\x03R\n" +
+	"greetCount\x12D\n" +
+	"\x10first_greeted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0efirstGreetedAt\x12B\n" +
+	"\x0flast_greeted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastGreetedAt\"o\n" +
+	"\x14ListGreetingsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x89\x01\n" +
+	"\x0eGreetingRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"greeted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tgreetedAt\"u\n" +
+	"\x15ListGreetingsResponse\x124\n" +
+	"\bgreeting\x18\x01 \x01(\v2\x18.greeting.GreetingRecordR\bgreeting\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"-\n" +
+	"\x17GetGreetingCountRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"D\n" +
+	"\x18GetGreetingCountResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"8\n" +
+	"\x19SubscribeGreetingsRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18@R\x04name\"\xc2\x01\n" +
+	"\rGreetingEvent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x129\n" +
+	"\n" +
+	"greeted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tgreetedAt\x12\x16\n" +
+	"\x06missed\x18\x06 \x01(\x03R\x06missed\"\x1f\n" +
+	"\x1dListSupportedLanguagesRequest\"S\n" +
+	"\bLanguage\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vnative_name\x18\x03 \x01(\tR\n" +
+	"nativeName\"R\n" +
+	"\x1eListSupportedLanguagesResponse\x120\n" +
+	"\tlanguages\x18\x01 \x03(\v2\x12.greeting.LanguageR\tlanguages\"\x91\x01\n" +
+	"\x14SayHelloLargeRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12+\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03B\f\xfaB\t\"\a\x18\x80\x80\x80 (\x00R\tsizeBytes\x12-\n" +
+	"\vchunk_bytes\x18\x03 \x01(\x05B\f\xfaB\t\x1a\a\x18\x80\x80\x80\x02(\x00R\n" +
+	"chunkBytes\"c\n" +
+	"\x15SayHelloLargeResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\"u\n" +
+	"\x15UploadDocumentRequest\x12,\n" +
+	"\x04info\x18\x01 \x01(\v2\x16.greeting.DocumentInfoH\x00R\x04info\x12!\n" +
+	"\x05chunk\x18\x02 \x01(\fB\t\xfaB\x06z\x04\x18\x80\x80@H\x00R\x05chunkB\v\n" +
+	"\x04data\x12\x03\xf8B\x01\"\x8b\x01\n" +
+	"\fDocumentInfo\x12&\n" +
+	"\bfilename\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\bfilename\x12+\n" +
+	"\fcontent_type\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\vcontentType\x12&\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tsizeBytes\"_\n" +
+	"\x16UploadDocumentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\"l\n" +
+	"\x18StartConversationRequest\x12+\n" +
+	"\x04name\x18\x01 \x01(\tB\x17\xfaB\x14r\x12\x10\x01\x18@2\f^[^\\p{Cc}]*$R\x04name\x12#\n" +
+	"\blanguage\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18dR\blanguage\"s\n" +
+	"\x1bContinueConversationRequest\x12/\n" +
+	"\rsession_token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\fsessionToken\x12#\n" +
+	"\blanguage\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18dR\blanguage\"\xda\x01\n" +
+	"\x14ConversationResponse\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04turn\x18\x03 \x01(\x05R\x04turn\x12\x18\n" +
+	"\ahistory\x18\x04 \x03(\tR\ahistory\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"I\n" +
+	"\x16EndConversationRequest\x12/\n" +
+	"\rsession_token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\fsessionToken\"/\n" +
+	"\x17EndConversationResponse\x12\x14\n" +
+	"\x05turns\x18\x01 \x01(\x05R\x05turns2\x98\r\n" +
+	"\x0fGreetingService\x12r\n" +
+	"\bSayHello\x12\x16.greeting.HelloRequest\x1a\x17.greeting.HelloResponse\"5\x82\xd3\xe4\x93\x02/Z\x1b\x12\x19/v1/users/{user_id}/hello\x12\x10/v1/hello/{name}\x12R\n" +
+	"\rSayHelloBatch\x12\x1e.greeting.SayHelloBatchRequest\x1a\x1f.greeting.SayHelloBatchResponse\"\x00\x12f\n" +
+	"\x10SayHelloMultiple\x12\x16.greeting.HelloRequest\x1a\x17.greeting.HelloResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/hello/{name}/stream0\x01\x12J\n" +
+	"\fResumeStream\x12\x1d.greeting.ResumeStreamRequest\x1a\x17.greeting.HelloResponse\"\x000\x01\x12I\n" +
+	"\x12SayHelloToEveryone\x12\x16.greeting.HelloRequest\x1a\x17.greeting.HelloResponse\"\x00(\x01\x12F\n" +
+	"\rGreetEveryone\x12\x16.greeting.HelloRequest\x1a\x17.greeting.HelloResponse\"\x00(\x010\x01\x12@\n" +
+	"\n" +
+	"StreamLogs\x12\x1b.greeting.StreamLogsRequest\x1a\x11.greeting.LogLine\"\x000\x01\x12=\n" +
+	"\bGetStats\x12\x16.greeting.StatsRequest\x1a\x17.greeting.StatsResponse\"\x00\x12I\n" +
+	"\fGetNameStats\x12\x1a.greeting.NameStatsRequest\x1a\x1b.greeting.NameStatsResponse\"\x00\x12T\n" +
+	"\rListGreetings\x12\x1e.greeting.ListGreetingsRequest\x1a\x1f.greeting.ListGreetingsResponse\"\x000\x01\x12[\n" +
+	"\x10GetGreetingCount\x12!.greeting.GetGreetingCountRequest\x1a\".greeting.GetGreetingCountResponse\"\x00\x12V\n" +
+	"\x12SubscribeGreetings\x12#.greeting.SubscribeGreetingsRequest\x1a\x17.greeting.GreetingEvent\"\x000\x01\x12R\n" +
+	"\rSayHelloLarge\x12\x1e.greeting.SayHelloLargeRequest\x1a\x1f.greeting.SayHelloLargeResponse\"\x00\x12W\n" +
+	"\x10StreamHelloLarge\x12\x1e.greeting.SayHelloLargeRequest\x1a\x1f.greeting.SayHelloLargeResponse\"\x000\x01\x12W\n" +
+	"\x0eUploadDocument\x12\x1f.greeting.UploadDocumentRequest\x1a .greeting.UploadDocumentResponse\"\x00(\x01\x12Y\n" +
+	"\x11StartConversation\x12\".greeting.StartConversationRequest\x1a\x1e.greeting.ConversationResponse\"\x00\x12_\n" +
+	"\x14ContinueConversation\x12%.greeting.ContinueConversationRequest\x1a\x1e.greeting.ConversationResponse\"\x00\x12X\n" +
+	"\x0fEndConversation\x12 .greeting.EndConversationRequest\x1a!.greeting.EndConversationResponse\"\x00\x12\x82\x01\n" +
+	"\x16ListSupportedLanguages\x12'.greeting.ListSupportedLanguagesRequest\x1a(.greeting.ListSupportedLanguagesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/languagesBSZQgithub.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1;greetingv1b\x06proto3"
+
+var (
+	file_proto_greeting_v1_greeting_proto_rawDescOnce sync.Once
+	file_proto_greeting_v1_greeting_proto_rawDescData []byte
+)
+
+func file_proto_greeting_v1_greeting_proto_rawDescGZIP() []byte {
+	file_proto_greeting_v1_greeting_proto_rawDescOnce.Do(func() {
+		file_proto_greeting_v1_greeting_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_greeting_v1_greeting_proto_rawDesc), len(file_proto_greeting_v1_greeting_proto_rawDesc)))
+	})
+	return file_proto_greeting_v1_greeting_proto_rawDescData
+}
+
+var file_proto_greeting_v1_greeting_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_proto_greeting_v1_greeting_proto_goTypes = []any{
+	(*HelloRequest)(nil),                   // 0: greeting.HelloRequest
+	(*HelloResponse)(nil),                  // 1: greeting.HelloResponse
+	(*SayHelloBatchRequest)(nil),           // 2: greeting.SayHelloBatchRequest
+	(*SayHelloBatchResponse)(nil),          // 3: greeting.SayHelloBatchResponse
+	(*HelloResult)(nil),                    // 4: greeting.HelloResult
+	(*ResumeStreamRequest)(nil),            // 5: greeting.ResumeStreamRequest
+	(*StreamLogsRequest)(nil),              // 6: greeting.StreamLogsRequest
+	(*LogLine)(nil),                        // 7: greeting.LogLine
+	(*StatsRequest)(nil),                   // 8: greeting.StatsRequest
+	(*StatsResponse)(nil),                  // 9: greeting.StatsResponse
+	(*NameStatsRequest)(nil),               // 10: greeting.NameStatsRequest
+	(*NameStatsResponse)(nil),              // 11: greeting.NameStatsResponse
+	(*ListGreetingsRequest)(nil),           // 12: greeting.ListGreetingsRequest
+	(*GreetingRecord)(nil),                 // 13: greeting.GreetingRecord
+	(*ListGreetingsResponse)(nil),          // 14: greeting.ListGreetingsResponse
+	(*GetGreetingCountRequest)(nil),        // 15: greeting.GetGreetingCountRequest
+	(*GetGreetingCountResponse)(nil),       // 16: greeting.GetGreetingCountResponse
+	(*SubscribeGreetingsRequest)(nil),      // 17: greeting.SubscribeGreetingsRequest
+	(*GreetingEvent)(nil),                  // 18: greeting.GreetingEvent
+	(*ListSupportedLanguagesRequest)(nil),  // 19: greeting.ListSupportedLanguagesRequest
+	(*Language)(nil),                       // 20: greeting.Language
+	(*ListSupportedLanguagesResponse)(nil), // 21: greeting.ListSupportedLanguagesResponse
+	(*SayHelloLargeRequest)(nil),           // 22: greeting.SayHelloLargeRequest
+	(*SayHelloLargeResponse)(nil),          // 23: greeting.SayHelloLargeResponse
+	(*UploadDocumentRequest)(nil),          // 24: greeting.UploadDocumentRequest
+	(*DocumentInfo)(nil),                   // 25: greeting.DocumentInfo
+	(*UploadDocumentResponse)(nil),         // 26: greeting.UploadDocumentResponse
+	(*StartConversationRequest)(nil),       // 27: greeting.StartConversationRequest
+	(*ContinueConversationRequest)(nil),    // 28: greeting.ContinueConversationRequest
+	(*ConversationResponse)(nil),           // 29: greeting.ConversationResponse
+	(*EndConversationRequest)(nil),         // 30: greeting.EndConversationRequest
+	(*EndConversationResponse)(nil),        // 31: greeting.EndConversationResponse
+	(*status.Status)(nil),                  // 32: google.rpc.Status
+	(*timestamppb.Timestamp)(nil),          // 33: google.protobuf.Timestamp
+}
+var file_proto_greeting_v1_greeting_proto_depIdxs = []int32{
+	4,  // 0: greeting.SayHelloBatchResponse.results:type_name -> greeting.HelloResult
+	1,  // 1: greeting.HelloResult.response:type_name -> greeting.HelloResponse
+	32, // 2: greeting.HelloResult.error:type_name -> google.rpc.Status
+	33, // 3: greeting.NameStatsResponse.first_greeted_at:type_name -> google.protobuf.Timestamp
+	33, // 4: greeting.NameStatsResponse.last_greeted_at:type_name -> google.protobuf.Timestamp
+	33, // 5: greeting.GreetingRecord.greeted_at:type_name -> google.protobuf.Timestamp
+	13, // 6: greeting.ListGreetingsResponse.greeting:type_name -> greeting.GreetingRecord
+	33, // 7: greeting.GreetingEvent.greeted_at:type_name -> google.protobuf.Timestamp
+	20, // 8: greeting.ListSupportedLanguagesResponse.languages:type_name -> greeting.Language
+	25, // 9: greeting.UploadDocumentRequest.info:type_name -> greeting.DocumentInfo
+	33, // 10: greeting.ConversationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: greeting.GreetingService.SayHello:input_type -> greeting.HelloRequest
+	2,  // 12: greeting.GreetingService.SayHelloBatch:input_type -> greeting.SayHelloBatchRequest
+	0,  // 13: greeting.GreetingService.SayHelloMultiple:input_type -> greeting.HelloRequest
+	5,  // 14: greeting.GreetingService.ResumeStream:input_type -> greeting.ResumeStreamRequest
+	0,  // 15: greeting.GreetingService.SayHelloToEveryone:input_type -> greeting.HelloRequest
+	0,  // 16: greeting.GreetingService.GreetEveryone:input_type -> greeting.HelloRequest
+	6,  // 17: greeting.GreetingService.StreamLogs:input_type -> greeting.StreamLogsRequest
+	8,  // 18: greeting.GreetingService.GetStats:input_type -> greeting.StatsRequest
+	10, // 19: greeting.GreetingService.GetNameStats:input_type -> greeting.NameStatsRequest
+	12, // 20: greeting.GreetingService.ListGreetings:input_type -> greeting.ListGreetingsRequest
+	15, // 21: greeting.GreetingService.GetGreetingCount:input_type -> greeting.GetGreetingCountRequest
+	17, // 22: greeting.GreetingService.SubscribeGreetings:input_type -> greeting.SubscribeGreetingsRequest
+	22, // 23: greeting.GreetingService.SayHelloLarge:input_type -> greeting.SayHelloLargeRequest
+	22, // 24: greeting.GreetingService.StreamHelloLarge:input_type -> greeting.SayHelloLargeRequest
+	24, // 25: greeting.GreetingService.UploadDocument:input_type -> greeting.UploadDocumentRequest
+	27, // 26: greeting.GreetingService.StartConversation:input_type -> greeting.StartConversationRequest
+	28, // 27: greeting.GreetingService.ContinueConversation:input_type -> greeting.ContinueConversationRequest
+	30, // 28: greeting.GreetingService.EndConversation:input_type -> greeting.EndConversationRequest
+	19, // 29: greeting.GreetingService.ListSupportedLanguages:input_type -> greeting.ListSupportedLanguagesRequest
+	1,  // 30: greeting.GreetingService.SayHello:output_type -> greeting.HelloResponse
+	3,  // 31: greeting.GreetingService.SayHelloBatch:output_type -> greeting.SayHelloBatchResponse
+	1,  // 32: greeting.GreetingService.SayHelloMultiple:output_type -> greeting.HelloResponse
+	1,  // 33: greeting.GreetingService.ResumeStream:output_type -> greeting.HelloResponse
+	1,  // 34: greeting.GreetingService.SayHelloToEveryone:output_type -> greeting.HelloResponse
+	1,  // 35: greeting.GreetingService.GreetEveryone:output_type -> greeting.HelloResponse
+	7,  // 36: greeting.GreetingService.StreamLogs:output_type -> greeting.LogLine
+	9,  // 37: greeting.GreetingService.GetStats:output_type -> greeting.StatsResponse
+	11, // 38: greeting.GreetingService.GetNameStats:output_type -> greeting.NameStatsResponse
+	14, // 39: greeting.GreetingService.ListGreetings:output_type -> greeting.ListGreetingsResponse
+	16, // 40: greeting.GreetingService.GetGreetingCount:output_type -> greeting.GetGreetingCountResponse
+	18, // 41: greeting.GreetingService.SubscribeGreetings:output_type -> greeting.GreetingEvent
+	23, // 42: greeting.GreetingService.SayHelloLarge:output_type -> greeting.SayHelloLargeResponse
+	23, // 43: greeting.GreetingService.StreamHelloLarge:output_type -> greeting.SayHelloLargeResponse
+	26, // 44: greeting.GreetingService.UploadDocument:output_type -> greeting.UploadDocumentResponse
+	29, // 45: greeting.GreetingService.StartConversation:output_type -> greeting.ConversationResponse
+	29, // 46: greeting.GreetingService.ContinueConversation:output_type -> greeting.ConversationResponse
+	31, // 47: greeting.GreetingService.EndConversation:output_type -> greeting.EndConversationResponse
+	21, // 48: greeting.GreetingService.ListSupportedLanguages:output_type -> greeting.ListSupportedLanguagesResponse
+	30, // [30:49] is the sub-list for method output_type
+	11, // [11:30] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_proto_greeting_v1_greeting_proto_init() }
+func file_proto_greeting_v1_greeting_proto_init() {
+	if File_proto_greeting_v1_greeting_proto != nil {
+		return
+	}
+	file_proto_greeting_v1_greeting_proto_msgTypes[0].OneofWrappers = []any{
+		(*HelloRequest_Name)(nil),
+		(*HelloRequest_UserId)(nil),
+	}
+	file_proto_greeting_v1_greeting_proto_msgTypes[4].OneofWrappers = []any{
+		(*HelloResult_Response)(nil),
+		(*HelloResult_Error)(nil),
+	}
+	file_proto_greeting_v1_greeting_proto_msgTypes[24].OneofWrappers = []any{
+		(*UploadDocumentRequest_Info)(nil),
+		(*UploadDocumentRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_greeting_v1_greeting_proto_rawDesc), len(file_proto_greeting_v1_greeting_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_greeting_v1_greeting_proto_goTypes,
+		DependencyIndexes: file_proto_greeting_v1_greeting_proto_depIdxs,
+		MessageInfos:      file_proto_greeting_v1_greeting_proto_msgTypes,
+	}.Build()
+	File_proto_greeting_v1_greeting_proto = out.File
+	file_proto_greeting_v1_greeting_proto_goTypes = nil
+	file_proto_greeting_v1_greeting_proto_depIdxs = nil
+}
@@ -0,0 +1,4078 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/greeting/v1/greeting.proto
+
+package greetingv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on HelloRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HelloRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HelloRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HelloRequestMultiError, or
+// nil if none found.
+func (m *HelloRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HelloRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetCount(); val < 0 || val > 1000 {
+		err := HelloRequestValidationError{
+			field:  "Count",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetIntervalMs(); val < 0 || val > 60000 {
+		err := HelloRequestValidationError{
+			field:  "IntervalMs",
+			reason: "value must be inside range [0, 60000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 100 {
+		err := HelloRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofIdentityPresent := false
+	switch v := m.Identity.(type) {
+	case *HelloRequest_Name:
+		if v == nil {
+			err := HelloRequestValidationError{
+				field:  "Identity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofIdentityPresent = true
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+			err := HelloRequestValidationError{
+				field:  "Name",
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_HelloRequest_Name_Pattern.MatchString(m.GetName()) {
+			err := HelloRequestValidationError{
+				field:  "Name",
+				reason: "value does not match regex pattern \"^[^\\\\p{Cc}]*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *HelloRequest_UserId:
+		if v == nil {
+			err := HelloRequestValidationError{
+				field:  "Identity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofIdentityPresent = true
+
+		if m.GetUserId() <= 0 {
+			err := HelloRequestValidationError{
+				field:  "UserId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofIdentityPresent {
+		err := HelloRequestValidationError{
+			field:  "Identity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return HelloRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HelloRequestMultiError is an error wrapping multiple validation errors
+// returned by HelloRequest.ValidateAll() if the designated constraints aren't met.
+type HelloRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HelloRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HelloRequestMultiError) AllErrors() []error { return m }
+
+// HelloRequestValidationError is the validation error returned by
+// HelloRequest.Validate if the designated constraints aren't met.
+type HelloRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HelloRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HelloRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HelloRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HelloRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HelloRequestValidationError) ErrorName() string { return "HelloRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HelloRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHelloRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HelloRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HelloRequestValidationError{}
+
+var _HelloRequest_Name_Pattern = regexp.MustCompile("^[^\\p{Cc}]*$")
+
+// Validate checks the field values on HelloResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HelloResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HelloResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HelloResponseMultiError, or
+// nil if none found.
+func (m *HelloResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HelloResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for Count
+
+	// no validation rules for Salutation
+
+	// no validation rules for Subject
+
+	// no validation rules for Punctuation
+
+	// no validation rules for NotModified
+
+	// no validation rules for Language
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return HelloResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// HelloResponseMultiError is an error wrapping multiple validation errors
+// returned by HelloResponse.ValidateAll() if the designated constraints
+// aren't met.
+type HelloResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HelloResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HelloResponseMultiError) AllErrors() []error { return m }
+
+// HelloResponseValidationError is the validation error returned by
+// HelloResponse.Validate if the designated constraints aren't met.
+type HelloResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HelloResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HelloResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HelloResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HelloResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HelloResponseValidationError) ErrorName() string { return "HelloResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HelloResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHelloResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HelloResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HelloResponseValidationError{}
+
+// Validate checks the field values on SayHelloBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SayHelloBatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SayHelloBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SayHelloBatchRequestMultiError, or nil if none found.
+func (m *SayHelloBatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SayHelloBatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetNames()); l < 1 || l > 100 {
+		err := SayHelloBatchRequestValidationError{
+			field:  "Names",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 100 {
+		err := SayHelloBatchRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SayHelloBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SayHelloBatchRequestMultiError is an error wrapping multiple validation
+// errors returned by SayHelloBatchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SayHelloBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SayHelloBatchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SayHelloBatchRequestMultiError) AllErrors() []error { return m }
+
+// SayHelloBatchRequestValidationError is the validation error returned by
+// SayHelloBatchRequest.Validate if the designated constraints aren't met.
+type SayHelloBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SayHelloBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SayHelloBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SayHelloBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SayHelloBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SayHelloBatchRequestValidationError) ErrorName() string {
+	return "SayHelloBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SayHelloBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSayHelloBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SayHelloBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SayHelloBatchRequestValidationError{}
+
+// Validate checks the field values on SayHelloBatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SayHelloBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SayHelloBatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SayHelloBatchResponseMultiError, or nil if none found.
+func (m *SayHelloBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SayHelloBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SayHelloBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SayHelloBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SayHelloBatchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return SayHelloBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SayHelloBatchResponseMultiError is an error wrapping multiple validation
+// errors returned by SayHelloBatchResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SayHelloBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SayHelloBatchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SayHelloBatchResponseMultiError) AllErrors() []error { return m }
+
+// SayHelloBatchResponseValidationError is the validation error returned by
+// SayHelloBatchResponse.Validate if the designated constraints aren't met.
+type SayHelloBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SayHelloBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SayHelloBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SayHelloBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SayHelloBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SayHelloBatchResponseValidationError) ErrorName() string {
+	return "SayHelloBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SayHelloBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSayHelloBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SayHelloBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SayHelloBatchResponseValidationError{}
+
+// Validate checks the field values on HelloResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HelloResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HelloResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HelloResultMultiError, or
+// nil if none found.
+func (m *HelloResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HelloResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	switch v := m.Result.(type) {
+	case *HelloResult_Response:
+		if v == nil {
+			err := HelloResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HelloResultValidationError{
+						field:  "Response",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HelloResultValidationError{
+						field:  "Response",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HelloResultValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *HelloResult_Error:
+		if v == nil {
+			err := HelloResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HelloResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HelloResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HelloResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return HelloResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// HelloResultMultiError is an error wrapping multiple validation errors
+// returned by HelloResult.ValidateAll() if the designated constraints aren't met.
+type HelloResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HelloResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HelloResultMultiError) AllErrors() []error { return m }
+
+// HelloResultValidationError is the validation error returned by
+// HelloResult.Validate if the designated constraints aren't met.
+type HelloResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HelloResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HelloResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HelloResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HelloResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HelloResultValidationError) ErrorName() string { return "HelloResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HelloResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHelloResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HelloResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HelloResultValidationError{}
+
+// Validate checks the field values on ResumeStreamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeStreamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeStreamRequestMultiError, or nil if none found.
+func (m *ResumeStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetResumeToken()); l < 1 || l > 1024 {
+		err := ResumeStreamRequestValidationError{
+			field:  "ResumeToken",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeStreamRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeStreamRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeStreamRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeStreamRequestMultiError) AllErrors() []error { return m }
+
+// ResumeStreamRequestValidationError is the validation error returned by
+// ResumeStreamRequest.Validate if the designated constraints aren't met.
+type ResumeStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeStreamRequestValidationError) ErrorName() string {
+	return "ResumeStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeStreamRequestValidationError{}
+
+// Validate checks the field values on StreamLogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StreamLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamLogsRequestMultiError, or nil if none found.
+func (m *StreamLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tail
+
+	if len(errors) > 0 {
+		return StreamLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by StreamLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StreamLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamLogsRequestMultiError) AllErrors() []error { return m }
+
+// StreamLogsRequestValidationError is the validation error returned by
+// StreamLogsRequest.Validate if the designated constraints aren't met.
+type StreamLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamLogsRequestValidationError) ErrorName() string {
+	return "StreamLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamLogsRequestValidationError{}
+
+// Validate checks the field values on LogLine with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogLine with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LogLineMultiError, or nil if none found.
+func (m *LogLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	if len(errors) > 0 {
+		return LogLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogLineMultiError is an error wrapping multiple validation errors returned
+// by LogLine.ValidateAll() if the designated constraints aren't met.
+type LogLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogLineMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogLineMultiError) AllErrors() []error { return m }
+
+// LogLineValidationError is the validation error returned by LogLine.Validate
+// if the designated constraints aren't met.
+type LogLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogLineValidationError) ErrorName() string { return "LogLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogLineValidationError{}
+
+// Validate checks the field values on StatsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatsRequestMultiError, or
+// nil if none found.
+func (m *StatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsRequestMultiError is an error wrapping multiple validation errors
+// returned by StatsRequest.ValidateAll() if the designated constraints aren't met.
+type StatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsRequestMultiError) AllErrors() []error { return m }
+
+// StatsRequestValidationError is the validation error returned by
+// StatsRequest.Validate if the designated constraints aren't met.
+type StatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsRequestValidationError) ErrorName() string { return "StatsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsRequestValidationError{}
+
+// Validate checks the field values on StatsResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatsResponseMultiError, or
+// nil if none found.
+func (m *StatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QueueDepth
+
+	// no validation rules for QueueCapacity
+
+	// no validation rules for InFlight
+
+	// no validation rules for Rejected
+
+	if len(errors) > 0 {
+		return StatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsResponseMultiError is an error wrapping multiple validation errors
+// returned by StatsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsResponseMultiError) AllErrors() []error { return m }
+
+// StatsResponseValidationError is the validation error returned by
+// StatsResponse.Validate if the designated constraints aren't met.
+type StatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsResponseValidationError) ErrorName() string { return "StatsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsResponseValidationError{}
+
+// Validate checks the field values on NameStatsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NameStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NameStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NameStatsRequestMultiError, or nil if none found.
+func (m *NameStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NameStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return NameStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// NameStatsRequestMultiError is an error wrapping multiple validation errors
+// returned by NameStatsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type NameStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NameStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NameStatsRequestMultiError) AllErrors() []error { return m }
+
+// NameStatsRequestValidationError is the validation error returned by
+// NameStatsRequest.Validate if the designated constraints aren't met.
+type NameStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NameStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NameStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NameStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NameStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NameStatsRequestValidationError) ErrorName() string { return "NameStatsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NameStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNameStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NameStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NameStatsRequestValidationError{}
+
+// Validate checks the field values on NameStatsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NameStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NameStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NameStatsResponseMultiError, or nil if none found.
+func (m *NameStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NameStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for GreetCount
+
+	if all {
+		switch v := interface{}(m.GetFirstGreetedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NameStatsResponseValidationError{
+					field:  "FirstGreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NameStatsResponseValidationError{
+					field:  "FirstGreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstGreetedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NameStatsResponseValidationError{
+				field:  "FirstGreetedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastGreetedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NameStatsResponseValidationError{
+					field:  "LastGreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NameStatsResponseValidationError{
+					field:  "LastGreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastGreetedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NameStatsResponseValidationError{
+				field:  "LastGreetedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NameStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// NameStatsResponseMultiError is an error wrapping multiple validation errors
+// returned by NameStatsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type NameStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NameStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NameStatsResponseMultiError) AllErrors() []error { return m }
+
+// NameStatsResponseValidationError is the validation error returned by
+// NameStatsResponse.Validate if the designated constraints aren't met.
+type NameStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NameStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NameStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NameStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NameStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NameStatsResponseValidationError) ErrorName() string {
+	return "NameStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NameStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNameStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NameStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NameStatsResponseValidationError{}
+
+// Validate checks the field values on ListGreetingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGreetingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGreetingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGreetingsRequestMultiError, or nil if none found.
+func (m *ListGreetingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGreetingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if m.GetPageSize() < 0 {
+		err := ListGreetingsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListGreetingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGreetingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListGreetingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListGreetingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGreetingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGreetingsRequestMultiError) AllErrors() []error { return m }
+
+// ListGreetingsRequestValidationError is the validation error returned by
+// ListGreetingsRequest.Validate if the designated constraints aren't met.
+type ListGreetingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGreetingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGreetingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGreetingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGreetingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGreetingsRequestValidationError) ErrorName() string {
+	return "ListGreetingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGreetingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGreetingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGreetingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGreetingsRequestValidationError{}
+
+// Validate checks the field values on GreetingRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GreetingRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetingRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GreetingRecordMultiError,
+// or nil if none found.
+func (m *GreetingRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetingRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetGreetedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetingRecordValidationError{
+					field:  "GreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetingRecordValidationError{
+					field:  "GreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreetedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetingRecordValidationError{
+				field:  "GreetedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GreetingRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetingRecordMultiError is an error wrapping multiple validation errors
+// returned by GreetingRecord.ValidateAll() if the designated constraints
+// aren't met.
+type GreetingRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetingRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetingRecordMultiError) AllErrors() []error { return m }
+
+// GreetingRecordValidationError is the validation error returned by
+// GreetingRecord.Validate if the designated constraints aren't met.
+type GreetingRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetingRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetingRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetingRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetingRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetingRecordValidationError) ErrorName() string { return "GreetingRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GreetingRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetingRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetingRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetingRecordValidationError{}
+
+// Validate checks the field values on ListGreetingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGreetingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGreetingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGreetingsResponseMultiError, or nil if none found.
+func (m *ListGreetingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGreetingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListGreetingsResponseValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListGreetingsResponseValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListGreetingsResponseValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListGreetingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGreetingsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListGreetingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListGreetingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGreetingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGreetingsResponseMultiError) AllErrors() []error { return m }
+
+// ListGreetingsResponseValidationError is the validation error returned by
+// ListGreetingsResponse.Validate if the designated constraints aren't met.
+type ListGreetingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGreetingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGreetingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGreetingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGreetingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGreetingsResponseValidationError) ErrorName() string {
+	return "ListGreetingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGreetingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGreetingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGreetingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGreetingsResponseValidationError{}
+
+// Validate checks the field values on GetGreetingCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGreetingCountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetGreetingCountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGreetingCountRequestMultiError, or nil if none found.
+func (m *GetGreetingCountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetGreetingCountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetGreetingCountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGreetingCountRequestMultiError is an error wrapping multiple validation
+// errors returned by GetGreetingCountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetGreetingCountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGreetingCountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGreetingCountRequestMultiError) AllErrors() []error { return m }
+
+// GetGreetingCountRequestValidationError is the validation error returned by
+// GetGreetingCountRequest.Validate if the designated constraints aren't met.
+type GetGreetingCountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGreetingCountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGreetingCountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGreetingCountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGreetingCountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGreetingCountRequestValidationError) ErrorName() string {
+	return "GetGreetingCountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGreetingCountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGreetingCountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGreetingCountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGreetingCountRequestValidationError{}
+
+// Validate checks the field values on GetGreetingCountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGreetingCountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetGreetingCountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGreetingCountResponseMultiError, or nil if none found.
+func (m *GetGreetingCountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetGreetingCountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GetGreetingCountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGreetingCountResponseMultiError is an error wrapping multiple validation
+// errors returned by GetGreetingCountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetGreetingCountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGreetingCountResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGreetingCountResponseMultiError) AllErrors() []error { return m }
+
+// GetGreetingCountResponseValidationError is the validation error returned by
+// GetGreetingCountResponse.Validate if the designated constraints aren't met.
+type GetGreetingCountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGreetingCountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGreetingCountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGreetingCountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGreetingCountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGreetingCountResponseValidationError) ErrorName() string {
+	return "GetGreetingCountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGreetingCountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGreetingCountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGreetingCountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGreetingCountResponseValidationError{}
+
+// Validate checks the field values on SubscribeGreetingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubscribeGreetingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeGreetingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscribeGreetingsRequestMultiError, or nil if none found.
+func (m *SubscribeGreetingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeGreetingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := SubscribeGreetingsRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubscribeGreetingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeGreetingsRequestMultiError is an error wrapping multiple validation
+// errors returned by SubscribeGreetingsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SubscribeGreetingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeGreetingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeGreetingsRequestMultiError) AllErrors() []error { return m }
+
+// SubscribeGreetingsRequestValidationError is the validation error returned by
+// SubscribeGreetingsRequest.Validate if the designated constraints aren't met.
+type SubscribeGreetingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeGreetingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeGreetingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeGreetingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeGreetingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeGreetingsRequestValidationError) ErrorName() string {
+	return "SubscribeGreetingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubscribeGreetingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeGreetingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeGreetingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeGreetingsRequestValidationError{}
+
+// Validate checks the field values on GreetingEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GreetingEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetingEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GreetingEventMultiError, or
+// nil if none found.
+func (m *GreetingEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetingEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Message
+
+	// no validation rules for Count
+
+	// no validation rules for Language
+
+	if all {
+		switch v := interface{}(m.GetGreetedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetingEventValidationError{
+					field:  "GreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetingEventValidationError{
+					field:  "GreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreetedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetingEventValidationError{
+				field:  "GreetedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Missed
+
+	if len(errors) > 0 {
+		return GreetingEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetingEventMultiError is an error wrapping multiple validation errors
+// returned by GreetingEvent.ValidateAll() if the designated constraints
+// aren't met.
+type GreetingEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetingEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetingEventMultiError) AllErrors() []error { return m }
+
+// GreetingEventValidationError is the validation error returned by
+// GreetingEvent.Validate if the designated constraints aren't met.
+type GreetingEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetingEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetingEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetingEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetingEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetingEventValidationError) ErrorName() string { return "GreetingEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GreetingEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetingEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetingEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetingEventValidationError{}
+
+// Validate checks the field values on ListSupportedLanguagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSupportedLanguagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSupportedLanguagesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListSupportedLanguagesRequestMultiError, or nil if none found.
+func (m *ListSupportedLanguagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSupportedLanguagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSupportedLanguagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSupportedLanguagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListSupportedLanguagesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListSupportedLanguagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSupportedLanguagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSupportedLanguagesRequestMultiError) AllErrors() []error { return m }
+
+// ListSupportedLanguagesRequestValidationError is the validation error
+// returned by ListSupportedLanguagesRequest.Validate if the designated
+// constraints aren't met.
+type ListSupportedLanguagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSupportedLanguagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSupportedLanguagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSupportedLanguagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSupportedLanguagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSupportedLanguagesRequestValidationError) ErrorName() string {
+	return "ListSupportedLanguagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSupportedLanguagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSupportedLanguagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSupportedLanguagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSupportedLanguagesRequestValidationError{}
+
+// Validate checks the field values on Language with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Language) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Language with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LanguageMultiError, or nil
+// if none found.
+func (m *Language) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Language) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	// no validation rules for NativeName
+
+	if len(errors) > 0 {
+		return LanguageMultiError(errors)
+	}
+
+	return nil
+}
+
+// LanguageMultiError is an error wrapping multiple validation errors returned
+// by Language.ValidateAll() if the designated constraints aren't met.
+type LanguageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LanguageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LanguageMultiError) AllErrors() []error { return m }
+
+// LanguageValidationError is the validation error returned by
+// Language.Validate if the designated constraints aren't met.
+type LanguageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LanguageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LanguageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LanguageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LanguageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LanguageValidationError) ErrorName() string { return "LanguageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LanguageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLanguage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LanguageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LanguageValidationError{}
+
+// Validate checks the field values on ListSupportedLanguagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSupportedLanguagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSupportedLanguagesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListSupportedLanguagesResponseMultiError, or nil if none found.
+func (m *ListSupportedLanguagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSupportedLanguagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLanguages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSupportedLanguagesResponseValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSupportedLanguagesResponseValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSupportedLanguagesResponseValidationError{
+					field:  fmt.Sprintf("Languages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSupportedLanguagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSupportedLanguagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListSupportedLanguagesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListSupportedLanguagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSupportedLanguagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSupportedLanguagesResponseMultiError) AllErrors() []error { return m }
+
+// ListSupportedLanguagesResponseValidationError is the validation error
+// returned by ListSupportedLanguagesResponse.Validate if the designated
+// constraints aren't met.
+type ListSupportedLanguagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSupportedLanguagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSupportedLanguagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSupportedLanguagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSupportedLanguagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSupportedLanguagesResponseValidationError) ErrorName() string {
+	return "ListSupportedLanguagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSupportedLanguagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSupportedLanguagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSupportedLanguagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSupportedLanguagesResponseValidationError{}
+
+// Validate checks the field values on SayHelloLargeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SayHelloLargeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SayHelloLargeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SayHelloLargeRequestMultiError, or nil if none found.
+func (m *SayHelloLargeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SayHelloLargeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := SayHelloLargeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSizeBytes(); val < 0 || val > 67108864 {
+		err := SayHelloLargeRequestValidationError{
+			field:  "SizeBytes",
+			reason: "value must be inside range [0, 67108864]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetChunkBytes(); val < 0 || val > 4194304 {
+		err := SayHelloLargeRequestValidationError{
+			field:  "ChunkBytes",
+			reason: "value must be inside range [0, 4194304]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SayHelloLargeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SayHelloLargeRequestMultiError is an error wrapping multiple validation
+// errors returned by SayHelloLargeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SayHelloLargeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SayHelloLargeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SayHelloLargeRequestMultiError) AllErrors() []error { return m }
+
+// SayHelloLargeRequestValidationError is the validation error returned by
+// SayHelloLargeRequest.Validate if the designated constraints aren't met.
+type SayHelloLargeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SayHelloLargeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SayHelloLargeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SayHelloLargeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SayHelloLargeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SayHelloLargeRequestValidationError) ErrorName() string {
+	return "SayHelloLargeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SayHelloLargeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSayHelloLargeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SayHelloLargeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SayHelloLargeRequestValidationError{}
+
+// Validate checks the field values on SayHelloLargeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SayHelloLargeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SayHelloLargeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SayHelloLargeResponseMultiError, or nil if none found.
+func (m *SayHelloLargeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SayHelloLargeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for Payload
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return SayHelloLargeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SayHelloLargeResponseMultiError is an error wrapping multiple validation
+// errors returned by SayHelloLargeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SayHelloLargeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SayHelloLargeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SayHelloLargeResponseMultiError) AllErrors() []error { return m }
+
+// SayHelloLargeResponseValidationError is the validation error returned by
+// SayHelloLargeResponse.Validate if the designated constraints aren't met.
+type SayHelloLargeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SayHelloLargeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SayHelloLargeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SayHelloLargeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SayHelloLargeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SayHelloLargeResponseValidationError) ErrorName() string {
+	return "SayHelloLargeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SayHelloLargeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSayHelloLargeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SayHelloLargeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SayHelloLargeResponseValidationError{}
+
+// Validate checks the field values on UploadDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadDocumentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadDocumentRequestMultiError, or nil if none found.
+func (m *UploadDocumentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadDocumentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofDataPresent := false
+	switch v := m.Data.(type) {
+	case *UploadDocumentRequest_Info:
+		if v == nil {
+			err := UploadDocumentRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDataPresent = true
+
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadDocumentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadDocumentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadDocumentRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadDocumentRequest_Chunk:
+		if v == nil {
+			err := UploadDocumentRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDataPresent = true
+
+		if len(m.GetChunk()) > 1048576 {
+			err := UploadDocumentRequestValidationError{
+				field:  "Chunk",
+				reason: "value length must be at most 1048576 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofDataPresent {
+		err := UploadDocumentRequestValidationError{
+			field:  "Data",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadDocumentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadDocumentRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadDocumentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadDocumentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadDocumentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadDocumentRequestMultiError) AllErrors() []error { return m }
+
+// UploadDocumentRequestValidationError is the validation error returned by
+// UploadDocumentRequest.Validate if the designated constraints aren't met.
+type UploadDocumentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadDocumentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadDocumentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadDocumentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadDocumentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadDocumentRequestValidationError) ErrorName() string {
+	return "UploadDocumentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadDocumentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadDocumentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadDocumentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadDocumentRequestValidationError{}
+
+// Validate checks the field values on DocumentInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DocumentInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DocumentInfoMultiError, or
+// nil if none found.
+func (m *DocumentInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetFilename()); l < 1 || l > 255 {
+		err := DocumentInfoValidationError{
+			field:  "Filename",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContentType()) > 255 {
+		err := DocumentInfoValidationError{
+			field:  "ContentType",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSizeBytes() < 0 {
+		err := DocumentInfoValidationError{
+			field:  "SizeBytes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DocumentInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentInfoMultiError is an error wrapping multiple validation errors
+// returned by DocumentInfo.ValidateAll() if the designated constraints aren't met.
+type DocumentInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentInfoMultiError) AllErrors() []error { return m }
+
+// DocumentInfoValidationError is the validation error returned by
+// DocumentInfo.Validate if the designated constraints aren't met.
+type DocumentInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentInfoValidationError) ErrorName() string { return "DocumentInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DocumentInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentInfoValidationError{}
+
+// Validate checks the field values on UploadDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadDocumentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadDocumentResponseMultiError, or nil if none found.
+func (m *UploadDocumentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadDocumentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Sha256
+
+	if len(errors) > 0 {
+		return UploadDocumentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadDocumentResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadDocumentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadDocumentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadDocumentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadDocumentResponseMultiError) AllErrors() []error { return m }
+
+// UploadDocumentResponseValidationError is the validation error returned by
+// UploadDocumentResponse.Validate if the designated constraints aren't met.
+type UploadDocumentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadDocumentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadDocumentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadDocumentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadDocumentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadDocumentResponseValidationError) ErrorName() string {
+	return "UploadDocumentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadDocumentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadDocumentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadDocumentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadDocumentResponseValidationError{}
+
+// Validate checks the field values on StartConversationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartConversationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartConversationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartConversationRequestMultiError, or nil if none found.
+func (m *StartConversationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartConversationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := StartConversationRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StartConversationRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := StartConversationRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^\\\\p{Cc}]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 100 {
+		err := StartConversationRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StartConversationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartConversationRequestMultiError is an error wrapping multiple validation
+// errors returned by StartConversationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartConversationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartConversationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartConversationRequestMultiError) AllErrors() []error { return m }
+
+// StartConversationRequestValidationError is the validation error returned by
+// StartConversationRequest.Validate if the designated constraints aren't met.
+type StartConversationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartConversationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartConversationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartConversationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartConversationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartConversationRequestValidationError) ErrorName() string {
+	return "StartConversationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartConversationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartConversationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartConversationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartConversationRequestValidationError{}
+
+var _StartConversationRequest_Name_Pattern = regexp.MustCompile("^[^\\p{Cc}]*$")
+
+// Validate checks the field values on ContinueConversationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ContinueConversationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContinueConversationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ContinueConversationRequestMultiError, or nil if none found.
+func (m *ContinueConversationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContinueConversationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetSessionToken()); l < 1 || l > 128 {
+		err := ContinueConversationRequestValidationError{
+			field:  "SessionToken",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 100 {
+		err := ContinueConversationRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ContinueConversationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContinueConversationRequestMultiError is an error wrapping multiple
+// validation errors returned by ContinueConversationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ContinueConversationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContinueConversationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContinueConversationRequestMultiError) AllErrors() []error { return m }
+
+// ContinueConversationRequestValidationError is the validation error returned
+// by ContinueConversationRequest.Validate if the designated constraints
+// aren't met.
+type ContinueConversationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContinueConversationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContinueConversationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContinueConversationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContinueConversationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContinueConversationRequestValidationError) ErrorName() string {
+	return "ContinueConversationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ContinueConversationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContinueConversationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContinueConversationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContinueConversationRequestValidationError{}
+
+// Validate checks the field values on ConversationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConversationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConversationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConversationResponseMultiError, or nil if none found.
+func (m *ConversationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConversationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionToken
+
+	// no validation rules for Message
+
+	// no validation rules for Turn
+
+	// no validation rules for Language
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConversationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationResponseMultiError is an error wrapping multiple validation
+// errors returned by ConversationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConversationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationResponseMultiError) AllErrors() []error { return m }
+
+// ConversationResponseValidationError is the validation error returned by
+// ConversationResponse.Validate if the designated constraints aren't met.
+type ConversationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationResponseValidationError) ErrorName() string {
+	return "ConversationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConversationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationResponseValidationError{}
+
+// Validate checks the field values on EndConversationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EndConversationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EndConversationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EndConversationRequestMultiError, or nil if none found.
+func (m *EndConversationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EndConversationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetSessionToken()); l < 1 || l > 128 {
+		err := EndConversationRequestValidationError{
+			field:  "SessionToken",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EndConversationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EndConversationRequestMultiError is an error wrapping multiple validation
+// errors returned by EndConversationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EndConversationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EndConversationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EndConversationRequestMultiError) AllErrors() []error { return m }
+
+// EndConversationRequestValidationError is the validation error returned by
+// EndConversationRequest.Validate if the designated constraints aren't met.
+type EndConversationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndConversationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndConversationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndConversationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndConversationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndConversationRequestValidationError) ErrorName() string {
+	return "EndConversationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndConversationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndConversationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndConversationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndConversationRequestValidationError{}
+
+// Validate checks the field values on EndConversationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EndConversationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EndConversationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EndConversationResponseMultiError, or nil if none found.
+func (m *EndConversationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EndConversationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Turns
+
+	if len(errors) > 0 {
+		return EndConversationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EndConversationResponseMultiError is an error wrapping multiple validation
+// errors returned by EndConversationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EndConversationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EndConversationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EndConversationResponseMultiError) AllErrors() []error { return m }
+
+// EndConversationResponseValidationError is the validation error returned by
+// EndConversationResponse.Validate if the designated constraints aren't met.
+type EndConversationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndConversationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndConversationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndConversationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndConversationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndConversationResponseValidationError) ErrorName() string {
+	return "EndConversationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndConversationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndConversationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndConversationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndConversationResponseValidationError{}
@@ -0,0 +1,908 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/greeting/v1/greeting.proto
+
+// Version 1 of the greeting API. The package keeps its original name,
+// rather than greeting.v1, so clients built before the versioned layout
+// still reach the same service.
+
+package greetingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GreetingService_SayHello_FullMethodName               = "/greeting.GreetingService/SayHello"
+	GreetingService_SayHelloBatch_FullMethodName          = "/greeting.GreetingService/SayHelloBatch"
+	GreetingService_SayHelloMultiple_FullMethodName       = "/greeting.GreetingService/SayHelloMultiple"
+	GreetingService_ResumeStream_FullMethodName           = "/greeting.GreetingService/ResumeStream"
+	GreetingService_SayHelloToEveryone_FullMethodName     = "/greeting.GreetingService/SayHelloToEveryone"
+	GreetingService_GreetEveryone_FullMethodName          = "/greeting.GreetingService/GreetEveryone"
+	GreetingService_StreamLogs_FullMethodName             = "/greeting.GreetingService/StreamLogs"
+	GreetingService_GetStats_FullMethodName               = "/greeting.GreetingService/GetStats"
+	GreetingService_GetNameStats_FullMethodName           = "/greeting.GreetingService/GetNameStats"
+	GreetingService_ListGreetings_FullMethodName          = "/greeting.GreetingService/ListGreetings"
+	GreetingService_GetGreetingCount_FullMethodName       = "/greeting.GreetingService/GetGreetingCount"
+	GreetingService_SubscribeGreetings_FullMethodName     = "/greeting.GreetingService/SubscribeGreetings"
+	GreetingService_SayHelloLarge_FullMethodName          = "/greeting.GreetingService/SayHelloLarge"
+	GreetingService_StreamHelloLarge_FullMethodName       = "/greeting.GreetingService/StreamHelloLarge"
+	GreetingService_UploadDocument_FullMethodName         = "/greeting.GreetingService/UploadDocument"
+	GreetingService_StartConversation_FullMethodName      = "/greeting.GreetingService/StartConversation"
+	GreetingService_ContinueConversation_FullMethodName   = "/greeting.GreetingService/ContinueConversation"
+	GreetingService_EndConversation_FullMethodName        = "/greeting.GreetingService/EndConversation"
+	GreetingService_ListSupportedLanguages_FullMethodName = "/greeting.GreetingService/ListSupportedLanguages"
+)
+
+// GreetingServiceClient is the client API for GreetingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The greeting service definition
+type GreetingServiceClient interface {
+	// Sends a greeting. Also served over REST by the gateway as
+	// GET /v1/hello/{name} and GET /v1/users/{user_id}/hello.
+	SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error)
+	// Greets a batch of up to 100 names in one call. Each name succeeds or
+	// fails on its own: results come back in request order, each with its
+	// greeting or the status it failed with, so one invalid name doesn't
+	// fail the others. Names are greeted concurrently, a few at a time; once
+	// the call's deadline is close, names not yet started fail with
+	// DEADLINE_EXCEEDED so the greetings already made still arrive in time.
+	SayHelloBatch(ctx context.Context, in *SayHelloBatchRequest, opts ...grpc.CallOption) (*SayHelloBatchResponse, error)
+	// Sends multiple greetings. Over REST, GET /v1/hello/{name}/stream
+	// returns one JSON object per line.
+	SayHelloMultiple(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HelloResponse], error)
+	// Continues a SayHelloMultiple stream after the greeting whose
+	// resume_token it is given, e.g. once a client has reconnected after the
+	// stream broke off. Tokens outlive the server process, so streams may
+	// resume on a restarted or different server.
+	ResumeStream(ctx context.Context, in *ResumeStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HelloResponse], error)
+	// Greets everyone the client streams in with a single aggregated reply
+	SayHelloToEveryone(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[HelloRequest, HelloResponse], error)
+	// Replies to each streamed request with a greeting as soon as it arrives
+	GreetEveryone(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[HelloRequest, HelloResponse], error)
+	// Streams recent server log lines followed by new ones as they are
+	// written. Only available when the server runs with -debug.
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogLine], error)
+	// Reports server load, such as the admission queue depth
+	GetStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	// Reports how often and when a name has been greeted by SayHello
+	GetNameStats(ctx context.Context, in *NameStatsRequest, opts ...grpc.CallOption) (*NameStatsResponse, error)
+	// Streams recorded greetings, oldest first, one page per call. The last
+	// message of a page carries the token for the next one.
+	ListGreetings(ctx context.Context, in *ListGreetingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListGreetingsResponse], error)
+	// Reports how many times a name has been greeted
+	GetGreetingCount(ctx context.Context, in *GetGreetingCountRequest, opts ...grpc.CallOption) (*GetGreetingCountResponse, error)
+	// Pushes an event for every SayHello anyone makes from now on, until
+	// the client cancels or the server shuts down (Unavailable). Events a
+	// slow client can't keep up with are dropped and counted in the next
+	// event's missed field.
+	SubscribeGreetings(ctx context.Context, in *SubscribeGreetingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GreetingEvent], error)
+	// Returns a greeting padded with size_bytes of payload in one message,
+	// to show message size limits: a payload beyond the server's
+	// -max-send-msg-size or the client's -max-recv-msg-size (4 MiB by
+	// default) fails with ResourceExhausted.
+	SayHelloLarge(ctx context.Context, in *SayHelloLargeRequest, opts ...grpc.CallOption) (*SayHelloLargeResponse, error)
+	// Streams the same payload in chunks of chunk_bytes, so each message
+	// stays under the limits however large the payload is. The server sends
+	// chunks as fast as HTTP/2 flow control lets the client take them.
+	StreamHelloLarge(ctx context.Context, in *SayHelloLargeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SayHelloLargeResponse], error)
+	// Uploads a document in chunks: the first message carries its info, the
+	// rest its bytes. The server reassembles and stores it, then answers
+	// with its size and checksum once the client closes its side. Documents
+	// over the server's -max-upload-size fail with ResourceExhausted.
+	UploadDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDocumentRequest, UploadDocumentResponse], error)
+	// Starts a conversation: greets the name and keeps a session for it on
+	// the server, holding the conversation's greetings and preferred
+	// language, until it goes unused for the server's session TTL. The
+	// response carries the session token the other conversation RPCs take.
+	StartConversation(ctx context.Context, in *StartConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// Greets again within a conversation, in its preferred language, which
+	// the request may change. Unknown or expired session tokens fail with
+	// NOT_FOUND; the client may start a new conversation.
+	ContinueConversation(ctx context.Context, in *ContinueConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// Ends a conversation, forgetting its session
+	EndConversation(ctx context.Context, in *EndConversationRequest, opts ...grpc.CallOption) (*EndConversationResponse, error)
+	// Lists the languages SayHello can greet in, the fallback first. Over
+	// REST, GET /v1/languages.
+	ListSupportedLanguages(ctx context.Context, in *ListSupportedLanguagesRequest, opts ...grpc.CallOption) (*ListSupportedLanguagesResponse, error)
+}
+
+type greetingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGreetingServiceClient(cc grpc.ClientConnInterface) GreetingServiceClient {
+	return &greetingServiceClient{cc}
+}
+
+func (c *greetingServiceClient) SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HelloResponse)
+	err := c.cc.Invoke(ctx, GreetingService_SayHello_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceClient) SayHelloBatch(ctx context.Context, in *SayHelloBatchRequest, opts ...grpc.CallOption) (*SayHelloBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SayHelloBatchResponse)
+	err := c.cc.Invoke(ctx, GreetingService_SayHelloBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceClient) SayHelloMultiple(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HelloResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[0], GreetingService_SayHelloMultiple_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[HelloRequest, HelloResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_SayHelloMultipleClient = grpc.ServerStreamingClient[HelloResponse]
+
+func (c *greetingServiceClient) ResumeStream(ctx context.Context, in *ResumeStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HelloResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[1], GreetingService_ResumeStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ResumeStreamRequest, HelloResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_ResumeStreamClient = grpc.ServerStreamingClient[HelloResponse]
+
+func (c *greetingServiceClient) SayHelloToEveryone(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[HelloRequest, HelloResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[2], GreetingService_SayHelloToEveryone_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[HelloRequest, HelloResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_SayHelloToEveryoneClient = grpc.ClientStreamingClient[HelloRequest, HelloResponse]
+
+func (c *greetingServiceClient) GreetEveryone(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[HelloRequest, HelloResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[3], GreetingService_GreetEveryone_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[HelloRequest, HelloResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_GreetEveryoneClient = grpc.BidiStreamingClient[HelloRequest, HelloResponse]
+
+func (c *greetingServiceClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogLine], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[4], GreetingService_StreamLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamLogsRequest, LogLine]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_StreamLogsClient = grpc.ServerStreamingClient[LogLine]
+
+func (c *greetingServiceClient) GetStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, GreetingService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceClient) GetNameStats(ctx context.Context, in *NameStatsRequest, opts ...grpc.CallOption) (*NameStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NameStatsResponse)
+	err := c.cc.Invoke(ctx, GreetingService_GetNameStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceClient) ListGreetings(ctx context.Context, in *ListGreetingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListGreetingsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[5], GreetingService_ListGreetings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListGreetingsRequest, ListGreetingsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_ListGreetingsClient = grpc.ServerStreamingClient[ListGreetingsResponse]
+
+func (c *greetingServiceClient) GetGreetingCount(ctx context.Context, in *GetGreetingCountRequest, opts ...grpc.CallOption) (*GetGreetingCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGreetingCountResponse)
+	err := c.cc.Invoke(ctx, GreetingService_GetGreetingCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceClient) SubscribeGreetings(ctx context.Context, in *SubscribeGreetingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GreetingEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[6], GreetingService_SubscribeGreetings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeGreetingsRequest, GreetingEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_SubscribeGreetingsClient = grpc.ServerStreamingClient[GreetingEvent]
+
+func (c *greetingServiceClient) SayHelloLarge(ctx context.Context, in *SayHelloLargeRequest, opts ...grpc.CallOption) (*SayHelloLargeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SayHelloLargeResponse)
+	err := c.cc.Invoke(ctx, GreetingService_SayHelloLarge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceClient) StreamHelloLarge(ctx context.Context, in *SayHelloLargeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SayHelloLargeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[7], GreetingService_StreamHelloLarge_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SayHelloLargeRequest, SayHelloLargeResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_StreamHelloLargeClient = grpc.ServerStreamingClient[SayHelloLargeResponse]
+
+func (c *greetingServiceClient) UploadDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDocumentRequest, UploadDocumentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[8], GreetingService_UploadDocument_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadDocumentRequest, UploadDocumentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_UploadDocumentClient = grpc.ClientStreamingClient[UploadDocumentRequest, UploadDocumentResponse]
+
+func (c *greetingServiceClient) StartConversation(ctx context.Context, in *StartConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, GreetingService_StartConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceClient) ContinueConversation(ctx context.Context, in *ContinueConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, GreetingService_ContinueConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceClient) EndConversation(ctx context.Context, in *EndConversationRequest, opts ...grpc.CallOption) (*EndConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndConversationResponse)
+	err := c.cc.Invoke(ctx, GreetingService_EndConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceClient) ListSupportedLanguages(ctx context.Context, in *ListSupportedLanguagesRequest, opts ...grpc.CallOption) (*ListSupportedLanguagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSupportedLanguagesResponse)
+	err := c.cc.Invoke(ctx, GreetingService_ListSupportedLanguages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GreetingServiceServer is the server API for GreetingService service.
+// All implementations must embed UnimplementedGreetingServiceServer
+// for forward compatibility.
+//
+// The greeting service definition
+type GreetingServiceServer interface {
+	// Sends a greeting. Also served over REST by the gateway as
+	// GET /v1/hello/{name} and GET /v1/users/{user_id}/hello.
+	SayHello(context.Context, *HelloRequest) (*HelloResponse, error)
+	// Greets a batch of up to 100 names in one call. Each name succeeds or
+	// fails on its own: results come back in request order, each with its
+	// greeting or the status it failed with, so one invalid name doesn't
+	// fail the others. Names are greeted concurrently, a few at a time; once
+	// the call's deadline is close, names not yet started fail with
+	// DEADLINE_EXCEEDED so the greetings already made still arrive in time.
+	SayHelloBatch(context.Context, *SayHelloBatchRequest) (*SayHelloBatchResponse, error)
+	// Sends multiple greetings. Over REST, GET /v1/hello/{name}/stream
+	// returns one JSON object per line.
+	SayHelloMultiple(*HelloRequest, grpc.ServerStreamingServer[HelloResponse]) error
+	// Continues a SayHelloMultiple stream after the greeting whose
+	// resume_token it is given, e.g. once a client has reconnected after the
+	// stream broke off. Tokens outlive the server process, so streams may
+	// resume on a restarted or different server.
+	ResumeStream(*ResumeStreamRequest, grpc.ServerStreamingServer[HelloResponse]) error
+	// Greets everyone the client streams in with a single aggregated reply
+	SayHelloToEveryone(grpc.ClientStreamingServer[HelloRequest, HelloResponse]) error
+	// Replies to each streamed request with a greeting as soon as it arrives
+	GreetEveryone(grpc.BidiStreamingServer[HelloRequest, HelloResponse]) error
+	// Streams recent server log lines followed by new ones as they are
+	// written. Only available when the server runs with -debug.
+	StreamLogs(*StreamLogsRequest, grpc.ServerStreamingServer[LogLine]) error
+	// Reports server load, such as the admission queue depth
+	GetStats(context.Context, *StatsRequest) (*StatsResponse, error)
+	// Reports how often and when a name has been greeted by SayHello
+	GetNameStats(context.Context, *NameStatsRequest) (*NameStatsResponse, error)
+	// Streams recorded greetings, oldest first, one page per call. The last
+	// message of a page carries the token for the next one.
+	ListGreetings(*ListGreetingsRequest, grpc.ServerStreamingServer[ListGreetingsResponse]) error
+	// Reports how many times a name has been greeted
+	GetGreetingCount(context.Context, *GetGreetingCountRequest) (*GetGreetingCountResponse, error)
+	// Pushes an event for every SayHello anyone makes from now on, until
+	// the client cancels or the server shuts down (Unavailable). Events a
+	// slow client can't keep up with are dropped and counted in the next
+	// event's missed field.
+	SubscribeGreetings(*SubscribeGreetingsRequest, grpc.ServerStreamingServer[GreetingEvent]) error
+	// Returns a greeting padded with size_bytes of payload in one message,
+	// to show message size limits: a payload beyond the server's
+	// -max-send-msg-size or the client's -max-recv-msg-size (4 MiB by
+	// default) fails with ResourceExhausted.
+	SayHelloLarge(context.Context, *SayHelloLargeRequest) (*SayHelloLargeResponse, error)
+	// Streams the same payload in chunks of chunk_bytes, so each message
+	// stays under the limits however large the payload is. The server sends
+	// chunks as fast as HTTP/2 flow control lets the client take them.
+	StreamHelloLarge(*SayHelloLargeRequest, grpc.ServerStreamingServer[SayHelloLargeResponse]) error
+	// Uploads a document in chunks: the first message carries its info, the
+	// rest its bytes. The server reassembles and stores it, then answers
+	// with its size and checksum once the client closes its side. Documents
+	// over the server's -max-upload-size fail with ResourceExhausted.
+	UploadDocument(grpc.ClientStreamingServer[UploadDocumentRequest, UploadDocumentResponse]) error
+	// Starts a conversation: greets the name and keeps a session for it on
+	// the server, holding the conversation's greetings and preferred
+	// language, until it goes unused for the server's session TTL. The
+	// response carries the session token the other conversation RPCs take.
+	StartConversation(context.Context, *StartConversationRequest) (*ConversationResponse, error)
+	// Greets again within a conversation, in its preferred language, which
+	// the request may change. Unknown or expired session tokens fail with
+	// NOT_FOUND; the client may start a new conversation.
+	ContinueConversation(context.Context, *ContinueConversationRequest) (*ConversationResponse, error)
+	// Ends a conversation, forgetting its session
+	EndConversation(context.Context, *EndConversationRequest) (*EndConversationResponse, error)
+	// Lists the languages SayHello can greet in, the fallback first. Over
+	// REST, GET /v1/languages.
+	ListSupportedLanguages(context.Context, *ListSupportedLanguagesRequest) (*ListSupportedLanguagesResponse, error)
+	mustEmbedUnimplementedGreetingServiceServer()
+}
+
+// UnimplementedGreetingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGreetingServiceServer struct{}
+
+func (UnimplementedGreetingServiceServer) SayHello(context.Context, *HelloRequest) (*HelloResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SayHello not implemented")
+}
+func (UnimplementedGreetingServiceServer) SayHelloBatch(context.Context, *SayHelloBatchRequest) (*SayHelloBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SayHelloBatch not implemented")
+}
+func (UnimplementedGreetingServiceServer) SayHelloMultiple(*HelloRequest, grpc.ServerStreamingServer[HelloResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SayHelloMultiple not implemented")
+}
+func (UnimplementedGreetingServiceServer) ResumeStream(*ResumeStreamRequest, grpc.ServerStreamingServer[HelloResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ResumeStream not implemented")
+}
+func (UnimplementedGreetingServiceServer) SayHelloToEveryone(grpc.ClientStreamingServer[HelloRequest, HelloResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SayHelloToEveryone not implemented")
+}
+func (UnimplementedGreetingServiceServer) GreetEveryone(grpc.BidiStreamingServer[HelloRequest, HelloResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GreetEveryone not implemented")
+}
+func (UnimplementedGreetingServiceServer) StreamLogs(*StreamLogsRequest, grpc.ServerStreamingServer[LogLine]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (UnimplementedGreetingServiceServer) GetStats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedGreetingServiceServer) GetNameStats(context.Context, *NameStatsRequest) (*NameStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNameStats not implemented")
+}
+func (UnimplementedGreetingServiceServer) ListGreetings(*ListGreetingsRequest, grpc.ServerStreamingServer[ListGreetingsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListGreetings not implemented")
+}
+func (UnimplementedGreetingServiceServer) GetGreetingCount(context.Context, *GetGreetingCountRequest) (*GetGreetingCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGreetingCount not implemented")
+}
+func (UnimplementedGreetingServiceServer) SubscribeGreetings(*SubscribeGreetingsRequest, grpc.ServerStreamingServer[GreetingEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeGreetings not implemented")
+}
+func (UnimplementedGreetingServiceServer) SayHelloLarge(context.Context, *SayHelloLargeRequest) (*SayHelloLargeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SayHelloLarge not implemented")
+}
+func (UnimplementedGreetingServiceServer) StreamHelloLarge(*SayHelloLargeRequest, grpc.ServerStreamingServer[SayHelloLargeResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamHelloLarge not implemented")
+}
+func (UnimplementedGreetingServiceServer) UploadDocument(grpc.ClientStreamingServer[UploadDocumentRequest, UploadDocumentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadDocument not implemented")
+}
+func (UnimplementedGreetingServiceServer) StartConversation(context.Context, *StartConversationRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartConversation not implemented")
+}
+func (UnimplementedGreetingServiceServer) ContinueConversation(context.Context, *ContinueConversationRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinueConversation not implemented")
+}
+func (UnimplementedGreetingServiceServer) EndConversation(context.Context, *EndConversationRequest) (*EndConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndConversation not implemented")
+}
+func (UnimplementedGreetingServiceServer) ListSupportedLanguages(context.Context, *ListSupportedLanguagesRequest) (*ListSupportedLanguagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedLanguages not implemented")
+}
+func (UnimplementedGreetingServiceServer) mustEmbedUnimplementedGreetingServiceServer() {}
+func (UnimplementedGreetingServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeGreetingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GreetingServiceServer will
+// result in compilation errors.
+type UnsafeGreetingServiceServer interface {
+	mustEmbedUnimplementedGreetingServiceServer()
+}
+
+func RegisterGreetingServiceServer(s grpc.ServiceRegistrar, srv GreetingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGreetingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GreetingService_ServiceDesc, srv)
+}
+
+func _GreetingService_SayHello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HelloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).SayHello(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_SayHello_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).SayHello(ctx, req.(*HelloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingService_SayHelloBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SayHelloBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).SayHelloBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_SayHelloBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).SayHelloBatch(ctx, req.(*SayHelloBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingService_SayHelloMultiple_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HelloRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreetingServiceServer).SayHelloMultiple(m, &grpc.GenericServerStream[HelloRequest, HelloResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_SayHelloMultipleServer = grpc.ServerStreamingServer[HelloResponse]
+
+func _GreetingService_ResumeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreetingServiceServer).ResumeStream(m, &grpc.GenericServerStream[ResumeStreamRequest, HelloResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_ResumeStreamServer = grpc.ServerStreamingServer[HelloResponse]
+
+func _GreetingService_SayHelloToEveryone_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GreetingServiceServer).SayHelloToEveryone(&grpc.GenericServerStream[HelloRequest, HelloResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_SayHelloToEveryoneServer = grpc.ClientStreamingServer[HelloRequest, HelloResponse]
+
+func _GreetingService_GreetEveryone_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GreetingServiceServer).GreetEveryone(&grpc.GenericServerStream[HelloRequest, HelloResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_GreetEveryoneServer = grpc.BidiStreamingServer[HelloRequest, HelloResponse]
+
+func _GreetingService_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreetingServiceServer).StreamLogs(m, &grpc.GenericServerStream[StreamLogsRequest, LogLine]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_StreamLogsServer = grpc.ServerStreamingServer[LogLine]
+
+func _GreetingService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).GetStats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingService_GetNameStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).GetNameStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_GetNameStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).GetNameStats(ctx, req.(*NameStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingService_ListGreetings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListGreetingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreetingServiceServer).ListGreetings(m, &grpc.GenericServerStream[ListGreetingsRequest, ListGreetingsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_ListGreetingsServer = grpc.ServerStreamingServer[ListGreetingsResponse]
+
+func _GreetingService_GetGreetingCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGreetingCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).GetGreetingCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_GetGreetingCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).GetGreetingCount(ctx, req.(*GetGreetingCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingService_SubscribeGreetings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeGreetingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreetingServiceServer).SubscribeGreetings(m, &grpc.GenericServerStream[SubscribeGreetingsRequest, GreetingEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_SubscribeGreetingsServer = grpc.ServerStreamingServer[GreetingEvent]
+
+func _GreetingService_SayHelloLarge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SayHelloLargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).SayHelloLarge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_SayHelloLarge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).SayHelloLarge(ctx, req.(*SayHelloLargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingService_StreamHelloLarge_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SayHelloLargeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreetingServiceServer).StreamHelloLarge(m, &grpc.GenericServerStream[SayHelloLargeRequest, SayHelloLargeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_StreamHelloLargeServer = grpc.ServerStreamingServer[SayHelloLargeResponse]
+
+func _GreetingService_UploadDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GreetingServiceServer).UploadDocument(&grpc.GenericServerStream[UploadDocumentRequest, UploadDocumentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingService_UploadDocumentServer = grpc.ClientStreamingServer[UploadDocumentRequest, UploadDocumentResponse]
+
+func _GreetingService_StartConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).StartConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_StartConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).StartConversation(ctx, req.(*StartConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingService_ContinueConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContinueConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).ContinueConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_ContinueConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).ContinueConversation(ctx, req.(*ContinueConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingService_EndConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).EndConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_EndConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).EndConversation(ctx, req.(*EndConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingService_ListSupportedLanguages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupportedLanguagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceServer).ListSupportedLanguages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingService_ListSupportedLanguages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceServer).ListSupportedLanguages(ctx, req.(*ListSupportedLanguagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GreetingService_ServiceDesc is the grpc.ServiceDesc for GreetingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GreetingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "greeting.GreetingService",
+	HandlerType: (*GreetingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SayHello",
+			Handler:    _GreetingService_SayHello_Handler,
+		},
+		{
+			MethodName: "SayHelloBatch",
+			Handler:    _GreetingService_SayHelloBatch_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _GreetingService_GetStats_Handler,
+		},
+		{
+			MethodName: "GetNameStats",
+			Handler:    _GreetingService_GetNameStats_Handler,
+		},
+		{
+			MethodName: "GetGreetingCount",
+			Handler:    _GreetingService_GetGreetingCount_Handler,
+		},
+		{
+			MethodName: "SayHelloLarge",
+			Handler:    _GreetingService_SayHelloLarge_Handler,
+		},
+		{
+			MethodName: "StartConversation",
+			Handler:    _GreetingService_StartConversation_Handler,
+		},
+		{
+			MethodName: "ContinueConversation",
+			Handler:    _GreetingService_ContinueConversation_Handler,
+		},
+		{
+			MethodName: "EndConversation",
+			Handler:    _GreetingService_EndConversation_Handler,
+		},
+		{
+			MethodName: "ListSupportedLanguages",
+			Handler:    _GreetingService_ListSupportedLanguages_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SayHelloMultiple",
+			Handler:       _GreetingService_SayHelloMultiple_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ResumeStream",
+			Handler:       _GreetingService_ResumeStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SayHelloToEveryone",
+			Handler:       _GreetingService_SayHelloToEveryone_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GreetEveryone",
+			Handler:       _GreetingService_GreetEveryone_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _GreetingService_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListGreetings",
+			Handler:       _GreetingService_ListGreetings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeGreetings",
+			Handler:       _GreetingService_SubscribeGreetings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamHelloLarge",
+			Handler:       _GreetingService_StreamHelloLarge_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadDocument",
+			Handler:       _GreetingService_UploadDocument_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/greeting/v1/greeting.proto",
+}
@@ -0,0 +1,561 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: proto/greeting/v2/greeting.proto
+
+package greetingv2
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// How the server worked out who it was greeting
+type IdentitySource int32
+
+const (
+	IdentitySource_IDENTITY_SOURCE_UNSPECIFIED IdentitySource = 0
+	// The caller supplied a name directly
+	IdentitySource_IDENTITY_SOURCE_NAME IdentitySource = 1
+	// The name was looked up from a user id in the server's directory
+	IdentitySource_IDENTITY_SOURCE_DIRECTORY IdentitySource = 2
+)
+
+// Enum value maps for IdentitySource.
+var (
+	IdentitySource_name = map[int32]string{
+		0: "IDENTITY_SOURCE_UNSPECIFIED",
+		1: "IDENTITY_SOURCE_NAME",
+		2: "IDENTITY_SOURCE_DIRECTORY",
+	}
+	IdentitySource_value = map[string]int32{
+		"IDENTITY_SOURCE_UNSPECIFIED": 0,
+		"IDENTITY_SOURCE_NAME":        1,
+		"IDENTITY_SOURCE_DIRECTORY":   2,
+	}
+)
+
+func (x IdentitySource) Enum() *IdentitySource {
+	p := new(IdentitySource)
+	*p = x
+	return p
+}
+
+func (x IdentitySource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdentitySource) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_greeting_v2_greeting_proto_enumTypes[0].Descriptor()
+}
+
+func (IdentitySource) Type() protoreflect.EnumType {
+	return &file_proto_greeting_v2_greeting_proto_enumTypes[0]
+}
+
+func (x IdentitySource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdentitySource.Descriptor instead.
+func (IdentitySource) EnumDescriptor() ([]byte, []int) {
+	return file_proto_greeting_v2_greeting_proto_rawDescGZIP(), []int{0}
+}
+
+// The request message identifying who to greet
+type SayHelloRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identity:
+	//
+	//	*SayHelloRequest_Name
+	//	*SayHelloRequest_UserId
+	Identity isSayHelloRequest_Identity `protobuf_oneof:"identity"`
+	// StreamGreetings only: how many greetings to stream and the pause
+	// between them in milliseconds. Zero keeps the server's defaults.
+	Count      int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	IntervalMs int32 `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	// Preferred language as a BCP 47 tag or Accept-Language list; the
+	// locale header is used when empty
+	Language      string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloRequest) Reset() {
+	*x = SayHelloRequest{}
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloRequest) ProtoMessage() {}
+
+func (x *SayHelloRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloRequest.ProtoReflect.Descriptor instead.
+func (*SayHelloRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v2_greeting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SayHelloRequest) GetIdentity() isSayHelloRequest_Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *SayHelloRequest) GetName() string {
+	if x != nil {
+		if x, ok := x.Identity.(*SayHelloRequest_Name); ok {
+			return x.Name
+		}
+	}
+	return ""
+}
+
+func (x *SayHelloRequest) GetUserId() int64 {
+	if x != nil {
+		if x, ok := x.Identity.(*SayHelloRequest_UserId); ok {
+			return x.UserId
+		}
+	}
+	return 0
+}
+
+func (x *SayHelloRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SayHelloRequest) GetIntervalMs() int32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *SayHelloRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type isSayHelloRequest_Identity interface {
+	isSayHelloRequest_Identity()
+}
+
+type SayHelloRequest_Name struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3,oneof"`
+}
+
+type SayHelloRequest_UserId struct {
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+func (*SayHelloRequest_Name) isSayHelloRequest_Identity() {}
+
+func (*SayHelloRequest_UserId) isSayHelloRequest_Identity() {}
+
+// The response message carrying the greeting and how it was produced
+type SayHelloResponse struct {
+	state     protoimpl.MessageState      `protogen:"open.v1"`
+	Greeting  *SayHelloResponse_Greeting  `protobuf:"bytes,1,opt,name=greeting,proto3" json:"greeting,omitempty"`
+	Recipient *SayHelloResponse_Recipient `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// When the server produced the greeting
+	GreetedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=greeted_at,json=greetedAt,proto3" json:"greeted_at,omitempty"`
+	// StreamGreetings and ResumeGreetings only: an opaque cursor to pass to
+	// ResumeGreetings to continue the stream after this greeting
+	ResumeToken   string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloResponse) Reset() {
+	*x = SayHelloResponse{}
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloResponse) ProtoMessage() {}
+
+func (x *SayHelloResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloResponse.ProtoReflect.Descriptor instead.
+func (*SayHelloResponse) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v2_greeting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SayHelloResponse) GetGreeting() *SayHelloResponse_Greeting {
+	if x != nil {
+		return x.Greeting
+	}
+	return nil
+}
+
+func (x *SayHelloResponse) GetRecipient() *SayHelloResponse_Recipient {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *SayHelloResponse) GetGreetedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GreetedAt
+	}
+	return nil
+}
+
+func (x *SayHelloResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// The request message for continuing a stream
+type ResumeGreetingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resume_token of the last greeting received
+	ResumeToken   string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeGreetingsRequest) Reset() {
+	*x = ResumeGreetingsRequest{}
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeGreetingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeGreetingsRequest) ProtoMessage() {}
+
+func (x *ResumeGreetingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeGreetingsRequest.ProtoReflect.Descriptor instead.
+func (*ResumeGreetingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v2_greeting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResumeGreetingsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// The rendered greeting. message is "<salutation>, <subject><punctuation>"
+// when the server's greeting provider reports the parts, though some
+// languages join salutation and subject differently. The parts are empty
+// when a greeting template rewrote the message.
+type SayHelloResponse_Greeting struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Message     string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Count       int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Salutation  string                 `protobuf:"bytes,3,opt,name=salutation,proto3" json:"salutation,omitempty"`
+	Subject     string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Punctuation string                 `protobuf:"bytes,5,opt,name=punctuation,proto3" json:"punctuation,omitempty"`
+	// BCP 47 tag of the language the greeting is in
+	Language      string `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloResponse_Greeting) Reset() {
+	*x = SayHelloResponse_Greeting{}
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloResponse_Greeting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloResponse_Greeting) ProtoMessage() {}
+
+func (x *SayHelloResponse_Greeting) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloResponse_Greeting.ProtoReflect.Descriptor instead.
+func (*SayHelloResponse_Greeting) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v2_greeting_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SayHelloResponse_Greeting) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SayHelloResponse_Greeting) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SayHelloResponse_Greeting) GetSalutation() string {
+	if x != nil {
+		return x.Salutation
+	}
+	return ""
+}
+
+func (x *SayHelloResponse_Greeting) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SayHelloResponse_Greeting) GetPunctuation() string {
+	if x != nil {
+		return x.Punctuation
+	}
+	return ""
+}
+
+func (x *SayHelloResponse_Greeting) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Details about the greeted caller
+type SayHelloResponse_Recipient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source        IdentitySource         `protobuf:"varint,2,opt,name=source,proto3,enum=greeting.v2.IdentitySource" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SayHelloResponse_Recipient) Reset() {
+	*x = SayHelloResponse_Recipient{}
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SayHelloResponse_Recipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayHelloResponse_Recipient) ProtoMessage() {}
+
+func (x *SayHelloResponse_Recipient) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_greeting_v2_greeting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayHelloResponse_Recipient.ProtoReflect.Descriptor instead.
+func (*SayHelloResponse_Recipient) Descriptor() ([]byte, []int) {
+	return file_proto_greeting_v2_greeting_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *SayHelloResponse_Recipient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SayHelloResponse_Recipient) GetSource() IdentitySource {
+	if x != nil {
+		return x.Source
+	}
+	return IdentitySource_IDENTITY_SOURCE_UNSPECIFIED
+}
+
+var File_proto_greeting_v2_greeting_proto protoreflect.FileDescriptor
+
+const file_proto_greeting_v2_greeting_proto_rawDesc = "" +
+	"\n" +
+	" proto/greeting/v2/greeting.proto\x12\vgreeting.v2\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xea\x01\n" +
+	"\x0fSayHelloRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x17\xfaB\x14r\x12\x10\x01\x18@2\f^[^\\p{Cc}]*$H\x00R\x04name\x12\"\n" +
+	"\auser_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x00R\x06userId\x12 \n" +
+	"\x05count\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05count\x12,\n" +
+	"\vinterval_ms\x18\x04 \x01(\x05B\v\xfaB\b\x1a\x06\x18\xe0\xd4\x03(\x00R\n" +
+	"intervalMs\x12#\n" +
+	"\blanguage\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18dR\blanguageB\x0f\n" +
+	"\bidentity\x12\x03\xf8B\x01\"\x86\x04\n" +
+	"\x10SayHelloResponse\x12B\n" +
+	"\bgreeting\x18\x01 \x01(\v2&.greeting.v2.SayHelloResponse.GreetingR\bgreeting\x12E\n" +
+	"\trecipient\x18\x02 \x01(\v2'.greeting.v2.SayHelloResponse.RecipientR\trecipient\x129\n" +
+	"\n" +
+	"greeted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tgreetedAt\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\x1a\xb2\x01\n" +
+	"\bGreeting\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1e\n" +
+	"\n" +
+	"salutation\x18\x03 \x01(\tR\n" +
+	"salutation\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12 \n" +
+	"\vpunctuation\x18\x05 \x01(\tR\vpunctuation\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\x1aT\n" +
+	"\tRecipient\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x123\n" +
+	"\x06source\x18\x02 \x01(\x0e2\x1b.greeting.v2.IdentitySourceR\x06source\"G\n" +
+	"\x16ResumeGreetingsRequest\x12-\n" +
+	"\fresume_token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\bR\vresumeToken*j\n" +
+	"\x0eIdentitySource\x12\x1f\n" +
+	"\x1bIDENTITY_SOURCE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14IDENTITY_SOURCE_NAME\x10\x01\x12\x1d\n" +
+	"\x19IDENTITY_SOURCE_DIRECTORY\x10\x022\x8d\x02\n" +
+	"\x11GreetingServiceV2\x12I\n" +
+	"\bSayHello\x12\x1c.greeting.v2.SayHelloRequest\x1a\x1d.greeting.v2.SayHelloResponse\"\x00\x12R\n" +
+	"\x0fStreamGreetings\x12\x1c.greeting.v2.SayHelloRequest\x1a\x1d.greeting.v2.SayHelloResponse\"\x000\x01\x12Y\n" +
+	"\x0fResumeGreetings\x12#.greeting.v2.ResumeGreetingsRequest\x1a\x1d.greeting.v2.SayHelloResponse\"\x000\x01BSZQgithub.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2;greetingv2b\x06proto3"
+
+var (
+	file_proto_greeting_v2_greeting_proto_rawDescOnce sync.Once
+	file_proto_greeting_v2_greeting_proto_rawDescData []byte
+)
+
+func file_proto_greeting_v2_greeting_proto_rawDescGZIP() []byte {
+	file_proto_greeting_v2_greeting_proto_rawDescOnce.Do(func() {
+		file_proto_greeting_v2_greeting_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_greeting_v2_greeting_proto_rawDesc), len(file_proto_greeting_v2_greeting_proto_rawDesc)))
+	})
+	return file_proto_greeting_v2_greeting_proto_rawDescData
+}
+
+var file_proto_greeting_v2_greeting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_greeting_v2_greeting_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_greeting_v2_greeting_proto_goTypes = []any{
+	(IdentitySource)(0),                // 0: greeting.v2.IdentitySource
+	(*SayHelloRequest)(nil),            // 1: greeting.v2.SayHelloRequest
+	(*SayHelloResponse)(nil),           // 2: greeting.v2.SayHelloResponse
+	(*ResumeGreetingsRequest)(nil),     // 3: greeting.v2.ResumeGreetingsRequest
+	(*SayHelloResponse_Greeting)(nil),  // 4: greeting.v2.SayHelloResponse.Greeting
+	(*SayHelloResponse_Recipient)(nil), // 5: greeting.v2.SayHelloResponse.Recipient
+	(*timestamppb.Timestamp)(nil),      // 6: google.protobuf.Timestamp
+}
+var file_proto_greeting_v2_greeting_proto_depIdxs = []int32{
+	4, // 0: greeting.v2.SayHelloResponse.greeting:type_name -> greeting.v2.SayHelloResponse.Greeting
+	5, // 1: greeting.v2.SayHelloResponse.recipient:type_name -> greeting.v2.SayHelloResponse.Recipient
+	6, // 2: greeting.v2.SayHelloResponse.greeted_at:type_name -> google.protobuf.Timestamp
+	0, // 3: greeting.v2.SayHelloResponse.Recipient.source:type_name -> greeting.v2.IdentitySource
+	1, // 4: greeting.v2.GreetingServiceV2.SayHello:input_type -> greeting.v2.SayHelloRequest
+	1, // 5: greeting.v2.GreetingServiceV2.StreamGreetings:input_type -> greeting.v2.SayHelloRequest
+	3, // 6: greeting.v2.GreetingServiceV2.ResumeGreetings:input_type -> greeting.v2.ResumeGreetingsRequest
+	2, // 7: greeting.v2.GreetingServiceV2.SayHello:output_type -> greeting.v2.SayHelloResponse
+	2, // 8: greeting.v2.GreetingServiceV2.StreamGreetings:output_type -> greeting.v2.SayHelloResponse
+	2, // 9: greeting.v2.GreetingServiceV2.ResumeGreetings:output_type -> greeting.v2.SayHelloResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_greeting_v2_greeting_proto_init() }
+func file_proto_greeting_v2_greeting_proto_init() {
+	if File_proto_greeting_v2_greeting_proto != nil {
+		return
+	}
+	file_proto_greeting_v2_greeting_proto_msgTypes[0].OneofWrappers = []any{
+		(*SayHelloRequest_Name)(nil),
+		(*SayHelloRequest_UserId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_greeting_v2_greeting_proto_rawDesc), len(file_proto_greeting_v2_greeting_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_greeting_v2_greeting_proto_goTypes,
+		DependencyIndexes: file_proto_greeting_v2_greeting_proto_depIdxs,
+		EnumInfos:         file_proto_greeting_v2_greeting_proto_enumTypes,
+		MessageInfos:      file_proto_greeting_v2_greeting_proto_msgTypes,
+	}.Build()
+	File_proto_greeting_v2_greeting_proto = out.File
+	file_proto_greeting_v2_greeting_proto_goTypes = nil
+	file_proto_greeting_v2_greeting_proto_depIdxs = nil
+}
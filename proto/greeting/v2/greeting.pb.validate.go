@@ -0,0 +1,768 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/greeting/v2/greeting.proto
+
+package greetingv2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SayHelloRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SayHelloRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SayHelloRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SayHelloRequestMultiError, or nil if none found.
+func (m *SayHelloRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SayHelloRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetCount(); val < 0 || val > 1000 {
+		err := SayHelloRequestValidationError{
+			field:  "Count",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetIntervalMs(); val < 0 || val > 60000 {
+		err := SayHelloRequestValidationError{
+			field:  "IntervalMs",
+			reason: "value must be inside range [0, 60000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 100 {
+		err := SayHelloRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofIdentityPresent := false
+	switch v := m.Identity.(type) {
+	case *SayHelloRequest_Name:
+		if v == nil {
+			err := SayHelloRequestValidationError{
+				field:  "Identity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofIdentityPresent = true
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+			err := SayHelloRequestValidationError{
+				field:  "Name",
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SayHelloRequest_Name_Pattern.MatchString(m.GetName()) {
+			err := SayHelloRequestValidationError{
+				field:  "Name",
+				reason: "value does not match regex pattern \"^[^\\\\p{Cc}]*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *SayHelloRequest_UserId:
+		if v == nil {
+			err := SayHelloRequestValidationError{
+				field:  "Identity",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofIdentityPresent = true
+
+		if m.GetUserId() <= 0 {
+			err := SayHelloRequestValidationError{
+				field:  "UserId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofIdentityPresent {
+		err := SayHelloRequestValidationError{
+			field:  "Identity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SayHelloRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SayHelloRequestMultiError is an error wrapping multiple validation errors
+// returned by SayHelloRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SayHelloRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SayHelloRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SayHelloRequestMultiError) AllErrors() []error { return m }
+
+// SayHelloRequestValidationError is the validation error returned by
+// SayHelloRequest.Validate if the designated constraints aren't met.
+type SayHelloRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SayHelloRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SayHelloRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SayHelloRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SayHelloRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SayHelloRequestValidationError) ErrorName() string { return "SayHelloRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SayHelloRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSayHelloRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SayHelloRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SayHelloRequestValidationError{}
+
+var _SayHelloRequest_Name_Pattern = regexp.MustCompile("^[^\\p{Cc}]*$")
+
+// Validate checks the field values on SayHelloResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SayHelloResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SayHelloResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SayHelloResponseMultiError, or nil if none found.
+func (m *SayHelloResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SayHelloResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SayHelloResponseValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SayHelloResponseValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SayHelloResponseValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecipient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SayHelloResponseValidationError{
+					field:  "Recipient",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SayHelloResponseValidationError{
+					field:  "Recipient",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecipient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SayHelloResponseValidationError{
+				field:  "Recipient",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreetedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SayHelloResponseValidationError{
+					field:  "GreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SayHelloResponseValidationError{
+					field:  "GreetedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreetedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SayHelloResponseValidationError{
+				field:  "GreetedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return SayHelloResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SayHelloResponseMultiError is an error wrapping multiple validation errors
+// returned by SayHelloResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SayHelloResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SayHelloResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SayHelloResponseMultiError) AllErrors() []error { return m }
+
+// SayHelloResponseValidationError is the validation error returned by
+// SayHelloResponse.Validate if the designated constraints aren't met.
+type SayHelloResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SayHelloResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SayHelloResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SayHelloResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SayHelloResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SayHelloResponseValidationError) ErrorName() string { return "SayHelloResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SayHelloResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSayHelloResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SayHelloResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SayHelloResponseValidationError{}
+
+// Validate checks the field values on ResumeGreetingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeGreetingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeGreetingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeGreetingsRequestMultiError, or nil if none found.
+func (m *ResumeGreetingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeGreetingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetResumeToken()); l < 1 || l > 1024 {
+		err := ResumeGreetingsRequestValidationError{
+			field:  "ResumeToken",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeGreetingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeGreetingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeGreetingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeGreetingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeGreetingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeGreetingsRequestMultiError) AllErrors() []error { return m }
+
+// ResumeGreetingsRequestValidationError is the validation error returned by
+// ResumeGreetingsRequest.Validate if the designated constraints aren't met.
+type ResumeGreetingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeGreetingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeGreetingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeGreetingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeGreetingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeGreetingsRequestValidationError) ErrorName() string {
+	return "ResumeGreetingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeGreetingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeGreetingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeGreetingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeGreetingsRequestValidationError{}
+
+// Validate checks the field values on SayHelloResponse_Greeting with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SayHelloResponse_Greeting) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SayHelloResponse_Greeting with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SayHelloResponse_GreetingMultiError, or nil if none found.
+func (m *SayHelloResponse_Greeting) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SayHelloResponse_Greeting) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for Count
+
+	// no validation rules for Salutation
+
+	// no validation rules for Subject
+
+	// no validation rules for Punctuation
+
+	// no validation rules for Language
+
+	if len(errors) > 0 {
+		return SayHelloResponse_GreetingMultiError(errors)
+	}
+
+	return nil
+}
+
+// SayHelloResponse_GreetingMultiError is an error wrapping multiple validation
+// errors returned by SayHelloResponse_Greeting.ValidateAll() if the
+// designated constraints aren't met.
+type SayHelloResponse_GreetingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SayHelloResponse_GreetingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SayHelloResponse_GreetingMultiError) AllErrors() []error { return m }
+
+// SayHelloResponse_GreetingValidationError is the validation error returned by
+// SayHelloResponse_Greeting.Validate if the designated constraints aren't met.
+type SayHelloResponse_GreetingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SayHelloResponse_GreetingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SayHelloResponse_GreetingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SayHelloResponse_GreetingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SayHelloResponse_GreetingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SayHelloResponse_GreetingValidationError) ErrorName() string {
+	return "SayHelloResponse_GreetingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SayHelloResponse_GreetingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSayHelloResponse_Greeting.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SayHelloResponse_GreetingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SayHelloResponse_GreetingValidationError{}
+
+// Validate checks the field values on SayHelloResponse_Recipient with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SayHelloResponse_Recipient) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SayHelloResponse_Recipient with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SayHelloResponse_RecipientMultiError, or nil if none found.
+func (m *SayHelloResponse_Recipient) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SayHelloResponse_Recipient) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return SayHelloResponse_RecipientMultiError(errors)
+	}
+
+	return nil
+}
+
+// SayHelloResponse_RecipientMultiError is an error wrapping multiple
+// validation errors returned by SayHelloResponse_Recipient.ValidateAll() if
+// the designated constraints aren't met.
+type SayHelloResponse_RecipientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SayHelloResponse_RecipientMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SayHelloResponse_RecipientMultiError) AllErrors() []error { return m }
+
+// SayHelloResponse_RecipientValidationError is the validation error returned
+// by SayHelloResponse_Recipient.Validate if the designated constraints aren't met.
+type SayHelloResponse_RecipientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SayHelloResponse_RecipientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SayHelloResponse_RecipientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SayHelloResponse_RecipientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SayHelloResponse_RecipientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SayHelloResponse_RecipientValidationError) ErrorName() string {
+	return "SayHelloResponse_RecipientValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SayHelloResponse_RecipientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSayHelloResponse_Recipient.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SayHelloResponse_RecipientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SayHelloResponse_RecipientValidationError{}
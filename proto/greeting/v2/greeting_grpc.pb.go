@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/greeting/v2/greeting.proto
+
+package greetingv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GreetingServiceV2_SayHello_FullMethodName        = "/greeting.v2.GreetingServiceV2/SayHello"
+	GreetingServiceV2_StreamGreetings_FullMethodName = "/greeting.v2.GreetingServiceV2/StreamGreetings"
+	GreetingServiceV2_ResumeGreetings_FullMethodName = "/greeting.v2.GreetingServiceV2/ResumeGreetings"
+)
+
+// GreetingServiceV2Client is the client API for GreetingServiceV2 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Version 2 of the greeting service. It is served next to the v1
+// GreetingService so existing clients keep working while new clients move
+// over; the server answers v1 SayHello, SayHelloMultiple and ResumeStream by
+// translating them to this API.
+type GreetingServiceV2Client interface {
+	// Sends a greeting
+	SayHello(ctx context.Context, in *SayHelloRequest, opts ...grpc.CallOption) (*SayHelloResponse, error)
+	// Sends multiple greetings. Called SayHelloMultiple in v1.
+	StreamGreetings(ctx context.Context, in *SayHelloRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SayHelloResponse], error)
+	// Continues a StreamGreetings stream after the greeting whose
+	// resume_token it is given, e.g. once a client has reconnected after
+	// the stream broke off. Called ResumeStream in v1.
+	ResumeGreetings(ctx context.Context, in *ResumeGreetingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SayHelloResponse], error)
+}
+
+type greetingServiceV2Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGreetingServiceV2Client(cc grpc.ClientConnInterface) GreetingServiceV2Client {
+	return &greetingServiceV2Client{cc}
+}
+
+func (c *greetingServiceV2Client) SayHello(ctx context.Context, in *SayHelloRequest, opts ...grpc.CallOption) (*SayHelloResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SayHelloResponse)
+	err := c.cc.Invoke(ctx, GreetingServiceV2_SayHello_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greetingServiceV2Client) StreamGreetings(ctx context.Context, in *SayHelloRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SayHelloResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingServiceV2_ServiceDesc.Streams[0], GreetingServiceV2_StreamGreetings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SayHelloRequest, SayHelloResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingServiceV2_StreamGreetingsClient = grpc.ServerStreamingClient[SayHelloResponse]
+
+func (c *greetingServiceV2Client) ResumeGreetings(ctx context.Context, in *ResumeGreetingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SayHelloResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GreetingServiceV2_ServiceDesc.Streams[1], GreetingServiceV2_ResumeGreetings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ResumeGreetingsRequest, SayHelloResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingServiceV2_ResumeGreetingsClient = grpc.ServerStreamingClient[SayHelloResponse]
+
+// GreetingServiceV2Server is the server API for GreetingServiceV2 service.
+// All implementations must embed UnimplementedGreetingServiceV2Server
+// for forward compatibility.
+//
+// Version 2 of the greeting service. It is served next to the v1
+// GreetingService so existing clients keep working while new clients move
+// over; the server answers v1 SayHello, SayHelloMultiple and ResumeStream by
+// translating them to this API.
+type GreetingServiceV2Server interface {
+	// Sends a greeting
+	SayHello(context.Context, *SayHelloRequest) (*SayHelloResponse, error)
+	// Sends multiple greetings. Called SayHelloMultiple in v1.
+	StreamGreetings(*SayHelloRequest, grpc.ServerStreamingServer[SayHelloResponse]) error
+	// Continues a StreamGreetings stream after the greeting whose
+	// resume_token it is given, e.g. once a client has reconnected after
+	// the stream broke off. Called ResumeStream in v1.
+	ResumeGreetings(*ResumeGreetingsRequest, grpc.ServerStreamingServer[SayHelloResponse]) error
+	mustEmbedUnimplementedGreetingServiceV2Server()
+}
+
+// UnimplementedGreetingServiceV2Server must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGreetingServiceV2Server struct{}
+
+func (UnimplementedGreetingServiceV2Server) SayHello(context.Context, *SayHelloRequest) (*SayHelloResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SayHello not implemented")
+}
+func (UnimplementedGreetingServiceV2Server) StreamGreetings(*SayHelloRequest, grpc.ServerStreamingServer[SayHelloResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGreetings not implemented")
+}
+func (UnimplementedGreetingServiceV2Server) ResumeGreetings(*ResumeGreetingsRequest, grpc.ServerStreamingServer[SayHelloResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ResumeGreetings not implemented")
+}
+func (UnimplementedGreetingServiceV2Server) mustEmbedUnimplementedGreetingServiceV2Server() {}
+func (UnimplementedGreetingServiceV2Server) testEmbeddedByValue()                           {}
+
+// UnsafeGreetingServiceV2Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GreetingServiceV2Server will
+// result in compilation errors.
+type UnsafeGreetingServiceV2Server interface {
+	mustEmbedUnimplementedGreetingServiceV2Server()
+}
+
+func RegisterGreetingServiceV2Server(s grpc.ServiceRegistrar, srv GreetingServiceV2Server) {
+	// If the following call pancis, it indicates UnimplementedGreetingServiceV2Server was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GreetingServiceV2_ServiceDesc, srv)
+}
+
+func _GreetingServiceV2_SayHello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SayHelloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreetingServiceV2Server).SayHello(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreetingServiceV2_SayHello_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreetingServiceV2Server).SayHello(ctx, req.(*SayHelloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreetingServiceV2_StreamGreetings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SayHelloRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreetingServiceV2Server).StreamGreetings(m, &grpc.GenericServerStream[SayHelloRequest, SayHelloResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingServiceV2_StreamGreetingsServer = grpc.ServerStreamingServer[SayHelloResponse]
+
+func _GreetingServiceV2_ResumeGreetings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeGreetingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreetingServiceV2Server).ResumeGreetings(m, &grpc.GenericServerStream[ResumeGreetingsRequest, SayHelloResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GreetingServiceV2_ResumeGreetingsServer = grpc.ServerStreamingServer[SayHelloResponse]
+
+// GreetingServiceV2_ServiceDesc is the grpc.ServiceDesc for GreetingServiceV2 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GreetingServiceV2_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "greeting.v2.GreetingServiceV2",
+	HandlerType: (*GreetingServiceV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SayHello",
+			Handler:    _GreetingServiceV2_SayHello_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGreetings",
+			Handler:       _GreetingServiceV2_StreamGreetings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ResumeGreetings",
+			Handler:       _GreetingServiceV2_ResumeGreetings_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/greeting/v2/greeting.proto",
+}
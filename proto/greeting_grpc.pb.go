@@ -1,171 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             v6.33.1
-// source: proto/greeting.proto
-
-package greeting
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	GreetingService_SayHello_FullMethodName         = "/greeting.GreetingService/SayHello"
-	GreetingService_SayHelloMultiple_FullMethodName = "/greeting.GreetingService/SayHelloMultiple"
-)
-
-// GreetingServiceClient is the client API for GreetingService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// The greeting service definition
-type GreetingServiceClient interface {
-	// Sends a greeting
-	SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error)
-	// Sends multiple greetings
-	SayHelloMultiple(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HelloResponse], error)
-}
-
-type greetingServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewGreetingServiceClient(cc grpc.ClientConnInterface) GreetingServiceClient {
-	return &greetingServiceClient{cc}
-}
-
-func (c *greetingServiceClient) SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(HelloResponse)
-	err := c.cc.Invoke(ctx, GreetingService_SayHello_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *greetingServiceClient) SayHelloMultiple(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HelloResponse], error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &GreetingService_ServiceDesc.Streams[0], GreetingService_SayHelloMultiple_FullMethodName, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &grpc.GenericClientStream[HelloRequest, HelloResponse]{ClientStream: stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type GreetingService_SayHelloMultipleClient = grpc.ServerStreamingClient[HelloResponse]
-
-// GreetingServiceServer is the server API for GreetingService service.
-// All implementations must embed UnimplementedGreetingServiceServer
-// for forward compatibility.
-//
-// The greeting service definition
-type GreetingServiceServer interface {
-	// Sends a greeting
-	SayHello(context.Context, *HelloRequest) (*HelloResponse, error)
-	// Sends multiple greetings
-	SayHelloMultiple(*HelloRequest, grpc.ServerStreamingServer[HelloResponse]) error
-	mustEmbedUnimplementedGreetingServiceServer()
-}
-
-// UnimplementedGreetingServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGreetingServiceServer struct{}
-
-func (UnimplementedGreetingServiceServer) SayHello(context.Context, *HelloRequest) (*HelloResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SayHello not implemented")
-}
-func (UnimplementedGreetingServiceServer) SayHelloMultiple(*HelloRequest, grpc.ServerStreamingServer[HelloResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method SayHelloMultiple not implemented")
-}
-func (UnimplementedGreetingServiceServer) mustEmbedUnimplementedGreetingServiceServer() {}
-func (UnimplementedGreetingServiceServer) testEmbeddedByValue()                         {}
-
-// UnsafeGreetingServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GreetingServiceServer will
-// result in compilation errors.
-type UnsafeGreetingServiceServer interface {
-	mustEmbedUnimplementedGreetingServiceServer()
-}
-
-func RegisterGreetingServiceServer(s grpc.ServiceRegistrar, srv GreetingServiceServer) {
-	// If the following call pancis, it indicates UnimplementedGreetingServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&GreetingService_ServiceDesc, srv)
-}
-
-func _GreetingService_SayHello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HelloRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GreetingServiceServer).SayHello(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GreetingService_SayHello_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreetingServiceServer).SayHello(ctx, req.(*HelloRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GreetingService_SayHelloMultiple_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(HelloRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GreetingServiceServer).SayHelloMultiple(m, &grpc.GenericServerStream[HelloRequest, HelloResponse]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type GreetingService_SayHelloMultipleServer = grpc.ServerStreamingServer[HelloResponse]
-
-// GreetingService_ServiceDesc is the grpc.ServiceDesc for GreetingService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var GreetingService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "greeting.GreetingService",
-	HandlerType: (*GreetingServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SayHello",
-			Handler:    _GreetingService_SayHello_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "SayHelloMultiple",
-			Handler:       _GreetingService_SayHelloMultiple_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "proto/greeting.proto",
-}
@@ -0,0 +1,175 @@
+// The proxy is a middle tier in front of a greeting server: it answers
+// SayHello by calling the backend's SayHello, so a call makes two hops.
+// The backend call carries on the caller's call: it gets the caller's
+// deadline, so the backend gives up when the caller does, the caller's
+// metadata (request id, tenant, user, baggage, locale, credentials) and the
+// trace context, so both hops show up in one trace. The backend's headers
+// and trailers are relayed to the caller, with the proxy added to the via
+// trailer. Proxies chain:
+//
+//	go run ./server
+//	go run ./proxy -addr :50052 -backend localhost:50051 -name edge
+//	go run ./client hello -addr localhost:50052
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+func main() {
+	addr := flag.String("addr", ":50052", "address to listen on")
+	backend := flag.String("backend", "localhost:50051", "address of the greeting server calls are forwarded to")
+	name := flag.String("name", "proxy", "name the proxy adds to the via trailer")
+	timeout := flag.Duration("timeout", 10*time.Second, "deadline of forwarded calls whose caller set none")
+	logFormat := flag.String("log-format", "text", "log line format: text or json")
+	flag.Parse()
+	if err := logging.Setup(os.Stderr, *logFormat, "info"); err != nil {
+		log.Fatalf("Failed to configure logging: %v", err)
+	}
+
+	// Export traces when OTEL_* variables ask for it; no-op otherwise
+	shutdownTracing, err := tracing.Setup(context.Background(), "greeter-proxy")
+	if err != nil {
+		fatal("Failed to set up tracing", logging.Err(err))
+	}
+
+	conn, err := grpc.NewClient(*backend,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(
+			interceptors.UnaryClientRequestID(),
+			requestcontext.UnaryClientInterceptor(),
+		),
+	)
+	if err != nil {
+		fatal("Failed to create the backend client", "backend", *backend, logging.Err(err))
+	}
+	defer conn.Close()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fatal("Failed to listen", "addr", *addr, logging.Err(err))
+	}
+	s := grpc.NewServer(
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			interceptors.UnaryServerRequestID(),
+			requestcontext.UnaryServerInterceptor(),
+			interceptors.UnaryServerLogging(),
+		),
+	)
+	pb.RegisterGreetingServiceServer(s, &proxy{
+		name:    *name,
+		backend: pb.NewGreetingServiceClient(conn),
+		timeout: *timeout,
+	})
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			fatal("Failed to serve", logging.Err(err))
+		}
+	}()
+	slog.Info("🔀 Proxy listening", "addr", lis.Addr().String(), "backend", *backend, "name", *name)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+
+	slog.Info("Shutting down...")
+	s.GracefulStop()
+	if err := shutdownTracing(context.Background()); err != nil {
+		slog.Warn("Failed to flush traces", logging.Err(err))
+	}
+	slog.Info("👋 Proxy stopped")
+}
+
+// proxy serves SayHello by forwarding it to the backend. The other methods
+// are left unimplemented.
+type proxy struct {
+	pb.UnimplementedGreetingServiceServer
+	name    string
+	backend pb.GreetingServiceClient
+	timeout time.Duration
+}
+
+func (p *proxy) SayHello(ctx context.Context, req *pb.HelloRequest) (*pb.HelloResponse, error) {
+	// The caller's deadline, arriving in ctx, goes out with the backend
+	// call; calls without one get the proxy's own so none waits forever
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	deadline, _ := ctx.Deadline()
+	slog.DebugContext(ctx, "Forwarding SayHello", "time_left", time.Until(deadline).Round(time.Millisecond))
+
+	var md metadata.Response
+	resp, err := p.backend.SayHello(forwardMetadata(ctx), req, md.CallOptions()...)
+	if err == nil && len(md.Header) > 0 {
+		if err := grpc.SetHeader(ctx, md.Header); err != nil {
+			slog.WarnContext(ctx, "Failed to relay response headers", logging.Err(err))
+		}
+	}
+	grpc.SetTrailer(ctx, viaTrailer(md.Trailer, p.name))
+	return resp, err
+}
+
+// forwardMetadata returns ctx sending the incoming call's metadata on the
+// calls made with it, less the headers describing the incoming hop only:
+// HTTP/2 pseudo-headers, transport headers such as content-type and
+// grpc-timeout, which gRPC sets itself for the new call, and the trace
+// context, which the tracing handler replaces with the proxy's span
+func forwardMetadata(ctx context.Context) context.Context {
+	in, _ := grpcmd.FromIncomingContext(ctx)
+	out := make(grpcmd.MD, len(in))
+	for k, v := range in {
+		if hopHeader(k) {
+			continue
+		}
+		out[k] = v
+	}
+	return grpcmd.NewOutgoingContext(ctx, out)
+}
+
+func hopHeader(key string) bool {
+	switch key {
+	case "content-type", "user-agent", "te", "traceparent", "tracestate":
+		return true
+	}
+	return strings.HasPrefix(key, ":") || strings.HasPrefix(key, "grpc-")
+}
+
+// viaTrailer returns the backend's trailers with name put in front of the
+// via trailer, so a chain of proxies lists them in call order
+func viaTrailer(backend grpcmd.MD, name string) grpcmd.MD {
+	trailer := backend.Copy()
+	trailer.Set(metadata.ViaTrailer, append([]string{name}, backend.Get(metadata.ViaTrailer)...)...)
+	return trailer
+}
+
+// fatal logs msg at error level and exits, like log.Fatal
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
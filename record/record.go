@@ -0,0 +1,367 @@
+// Package record records the calls a client makes, to send them again
+// later: to reproduce a bug with the exact requests that hit it, or to
+// play back a load pattern. A Recorder's interceptors write one JSON line
+// per call, with when it started, the method, the metadata sent, the
+// request and response messages in the protojson mapping, and how it
+// ended:
+//
+//	{"time":"...","method":"/greeting.GreetingService/SayHello","metadata":{"x-tenant-id":["acme"]},
+//	 "requests":[{"name":"Alice"}],"responses":[{"message":"Hello, Alice!","count":1}],"code":"OK","duration_ms":0.8}
+//
+// A Replayer sends the calls of a recording again with their original
+// pacing, resolving their message types from the generated code linked
+// into the binary.
+package record
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// maxLine is the longest recorded call Read accepts, in bytes
+const maxLine = 64 << 20
+
+// Call is one recorded call
+type Call struct {
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+	// Metadata is the metadata the call was sent with; credentials added
+	// by the connection, such as bearer tokens, are not part of it
+	Metadata  map[string][]string `json:"metadata,omitempty"`
+	Requests  []json.RawMessage   `json:"requests"`
+	Responses []json.RawMessage   `json:"responses,omitempty"`
+	Code      string              `json:"code"`
+	Error     string              `json:"error,omitempty"`
+	// DurationMS is how long the call took, from its start until its
+	// status arrived
+	DurationMS float64 `json:"duration_ms"`
+}
+
+// Duration returns how long the call took
+func (c Call) Duration() time.Duration {
+	return time.Duration(c.DurationMS * float64(time.Millisecond))
+}
+
+// Recorder writes a Call for every call its interceptors see
+type Recorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// New creates a Recorder writing one JSON line per call to w
+func New(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w)}
+}
+
+// UnaryClientInterceptor records every unary call. Install it after the
+// request id and requestcontext interceptors, so the metadata they add is
+// recorded, and before retries, so each logical call is recorded once.
+func (r *Recorder) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		call := newCall(ctx, method)
+		call.Requests = appendMessage(call.Requests, req)
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil {
+			call.Responses = appendMessage(call.Responses, reply)
+		}
+		r.write(ctx, call, start, err)
+		return err
+	}
+}
+
+// StreamClientInterceptor records every stream once its status has been
+// read. Streams the client abandons without reading it are not recorded.
+func (r *Recorder) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		call := newCall(ctx, method)
+		start := time.Now()
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			r.write(ctx, call, start, err)
+			return nil, err
+		}
+		return &recordingStream{ClientStream: cs, r: r, call: call, start: start, serverStreams: desc.ServerStreams}, nil
+	}
+}
+
+func newCall(ctx context.Context, method string) Call {
+	md, _ := grpcmd.FromOutgoingContext(ctx)
+	return Call{Time: time.Now().UTC(), Method: method, Metadata: md.Copy()}
+}
+
+// appendMessage appends msg, in the protojson mapping, to messages
+func appendMessage(messages []json.RawMessage, msg any) []json.RawMessage {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return messages
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return messages
+	}
+	return append(messages, b)
+}
+
+func (r *Recorder) write(ctx context.Context, call Call, start time.Time, err error) {
+	st := status.Convert(err)
+	call.Code = st.Code().String()
+	call.Error = st.Message()
+	call.DurationMS = float64(time.Since(start).Microseconds()) / 1000
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.enc.Encode(call); err != nil {
+		slog.ErrorContext(ctx, "Failed to record the call", "method", call.Method, logging.Err(err))
+	}
+}
+
+// recordingStream collects the messages of a stream and records it once
+// it ends
+type recordingStream struct {
+	grpc.ClientStream
+	r             *Recorder
+	start         time.Time
+	serverStreams bool
+
+	mu   sync.Mutex
+	call Call
+	done bool
+}
+
+func (s *recordingStream) SendMsg(m any) error {
+	s.mu.Lock()
+	s.call.Requests = appendMessage(s.call.Requests, m)
+	s.mu.Unlock()
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *recordingStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done {
+		return err
+	}
+	if err == nil {
+		s.call.Responses = appendMessage(s.call.Responses, m)
+		// A stream with a single response has its status with it
+		if s.serverStreams {
+			return nil
+		}
+	}
+	s.done = true
+	end := err
+	if errors.Is(err, io.EOF) {
+		end = nil
+	}
+	s.r.write(s.Context(), s.call, s.start, end)
+	return err
+}
+
+// Read returns the calls recorded in r, oldest first
+func Read(r io.Reader) ([]Call, error) {
+	var calls []Call
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLine)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var call Call
+		if err := json.Unmarshal(scanner.Bytes(), &call); err != nil {
+			return nil, fmt.Errorf("record: line %d: %w", line, err)
+		}
+		calls = append(calls, call)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(calls, func(a, b Call) int { return a.Time.Compare(b.Time) })
+	return calls, nil
+}
+
+// Result is how a replayed call ended
+type Result struct {
+	// Call is the call as recorded
+	Call Call
+	// Err is the replayed call's error, nil if it succeeded
+	Err     error
+	Elapsed time.Duration
+	// Responses counts the messages the server sent
+	Responses int
+}
+
+// Code returns the replayed call's status code
+func (r Result) Code() codes.Code {
+	return status.Code(r.Err)
+}
+
+// Matches reports whether the replayed call ended with the status code
+// the recorded one did
+func (r Result) Matches() bool {
+	return r.Code().String() == r.Call.Code
+}
+
+// Replayer sends recorded calls again
+type Replayer struct {
+	Conn grpc.ClientConnInterface
+	// Speed scales the pace of the recording: 2 replays it twice as fast.
+	// Zero or less sends every call at once.
+	Speed float64
+	// Slack is how much longer than when it was recorded a call may take
+	// before it is cancelled; zero leaves calls to the context alone
+	Slack time.Duration
+}
+
+// Replay sends calls again, each as long after the first as it was made
+// after the first one recorded, so calls that overlapped overlap again.
+// fn is told how each ended, one at a time. Replay returns once every call
+// has, or when ctx is done.
+func (p Replayer) Replay(ctx context.Context, calls []Call, fn func(Result)) error {
+	if len(calls) == 0 {
+		return nil
+	}
+	first := calls[0].Time
+	start := time.Now()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for _, call := range calls {
+		if p.Speed > 0 {
+			at := start.Add(time.Duration(float64(call.Time.Sub(first)) / p.Speed))
+			select {
+			case <-ctx.Done():
+				wg.Wait()
+				return ctx.Err()
+			case <-time.After(time.Until(at)):
+			}
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := p.send(ctx, call)
+			mu.Lock()
+			defer mu.Unlock()
+			fn(result)
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// droppedHeaders are the recorded headers a replayed call doesn't send:
+// it gets a request id of its own
+var droppedHeaders = []string{metadata.RequestIDHeader}
+
+// send makes call again and returns how it ended
+func (p Replayer) send(ctx context.Context, call Call) (result Result) {
+	result.Call = call
+	method, err := findMethod(call.Method)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	md := grpcmd.MD{}
+	for k, v := range call.Metadata {
+		if !slices.Contains(droppedHeaders, k) {
+			md[k] = v
+		}
+	}
+	ctx = grpcmd.NewOutgoingContext(ctx, md)
+	if p.Slack > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, call.Duration()+p.Slack)
+		defer cancel()
+	}
+
+	start := time.Now()
+	defer func() { result.Elapsed = time.Since(start) }()
+	desc := &grpc.StreamDesc{ServerStreams: method.IsStreamingServer(), ClientStreams: method.IsStreamingClient()}
+	stream, err := p.Conn.NewStream(ctx, desc, call.Method)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	for i, raw := range call.Requests {
+		req := newMessage(method.Input())
+		if err := protojson.Unmarshal(raw, req); err != nil {
+			result.Err = status.Errorf(codes.InvalidArgument, "recorded request %d: %v", i+1, err)
+			return result
+		}
+		if err := stream.SendMsg(req); err != nil {
+			break // RecvMsg returns the status that ended the stream
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		result.Err = err
+		return result
+	}
+	for {
+		resp := newMessage(method.Output())
+		err := stream.RecvMsg(resp)
+		if errors.Is(err, io.EOF) {
+			return result
+		}
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		result.Responses++
+		if !desc.ServerStreams {
+			return result
+		}
+	}
+}
+
+// findMethod returns the descriptor of a full method name such as
+// /greeting.GreetingService/SayHello
+func findMethod(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	service, name, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "recorded method %q is not /service/method", fullMethod)
+	}
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, status.Errorf(codes.Unimplemented, "service %s is unknown to this client", service)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(name))
+	if md == nil {
+		return nil, status.Errorf(codes.Unimplemented, "service %s has no method %s", service, name)
+	}
+	return md, nil
+}
+
+// newMessage returns an empty message of type d, of its generated type
+// when the binary links one in
+func newMessage(d protoreflect.MessageDescriptor) proto.Message {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(d.FullName()); err == nil {
+		return mt.New().Interface()
+	}
+	return dynamicpb.NewMessage(d)
+}
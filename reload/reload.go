@@ -0,0 +1,259 @@
+// Package reload changes a running server's settings without a restart.
+// A Manager holds the settings that may change, the log level, the rate
+// limit and chaos, taking them from the server's flags overlaid by a JSON
+// file keyed by flag name:
+//
+//	{"log-level": "debug", "rate-limit": 20, "rate-burst": 5, "chaos": "latency=100ms"}
+//
+// Reload reads the file again, and the greeting templates with it, and
+// applies what changed; Watch reloads on SIGHUP and whenever the file is
+// written. A reload is all or nothing: if any setting in the file is
+// invalid, or the templates fail to parse, nothing changes.
+package reload
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/templates"
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDebounce is how long Watch waits after the file changes for more
+// changes before reloading, so an editor's several writes make one reload
+const reloadDebounce = 100 * time.Millisecond
+
+// Settings are the settings a reload may change, named as their flags
+type Settings struct {
+	LogLevel  string  `json:"log-level"`
+	RateLimit float64 `json:"rate-limit"`
+	RateBurst int     `json:"rate-burst"`
+	Chaos     string  `json:"chaos"`
+}
+
+// Map returns s keyed by flag name, formatted as the flags print them
+func (s Settings) Map() map[string]string {
+	return map[string]string{
+		"log-level":  s.LogLevel,
+		"rate-limit": strconv.FormatFloat(s.RateLimit, 'g', -1, 64),
+		"rate-burst": strconv.Itoa(s.RateBurst),
+		"chaos":      s.Chaos,
+	}
+}
+
+// Targets are the parts of a running server a reload acts on; nil ones are
+// left alone
+type Targets struct {
+	Limiter   *interceptors.RateLimiter
+	Chaos     *interceptors.ChaosSwitch
+	Templates *templates.Engine
+}
+
+// Manager reloads a server's settings
+type Manager struct {
+	path     string
+	defaults Settings
+	targets  Targets
+
+	// mu makes reloads, which may come from a signal and the file watcher
+	// at once, take turns
+	mu sync.Mutex
+}
+
+// New returns a Manager reading the settings file at path over defaults,
+// the settings the server's flags give; with an empty path the settings
+// stay the defaults and reloads only reload the templates
+func New(path string, defaults Settings, targets Targets) *Manager {
+	return &Manager{path: path, defaults: defaults, targets: targets}
+}
+
+// Current returns the settings in effect, which include changes made since
+// the last reload, e.g. through the AdminService; those last until the
+// next reload
+func (m *Manager) Current() Settings {
+	s := Settings{LogLevel: strings.ToLower(logging.Level().String())}
+	if m.targets.Limiter != nil {
+		s.RateLimit, s.RateBurst = m.targets.Limiter.Limit()
+	}
+	if m.targets.Chaos != nil {
+		if c := m.targets.Chaos.Current(); c != nil {
+			s.Chaos = c.String()
+		}
+	}
+	return s
+}
+
+// Reload reads the settings file and the templates again and applies them,
+// returning what changed as "name: from → to" lines. On error nothing is
+// changed.
+func (m *Manager) Reload() ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	next, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+	chaos, err := next.validate()
+	if err != nil {
+		return nil, fmt.Errorf("reload: %s: %w", m.path, err)
+	}
+	if m.targets.Templates != nil && m.targets.Templates.Dir() != "" {
+		if _, err := m.targets.Templates.Reload(); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := diff(m.Current(), next)
+	logging.SetLevel(next.LogLevel)
+	if m.targets.Limiter != nil {
+		m.targets.Limiter.SetLimit(next.RateLimit, next.RateBurst)
+	}
+	if m.targets.Chaos != nil {
+		m.targets.Chaos.Set(chaos)
+	}
+	return changes, nil
+}
+
+// load returns the defaults overlaid by the settings file
+func (m *Manager) load() (Settings, error) {
+	s := m.defaults
+	if m.path == "" {
+		return s, nil
+	}
+	b, err := os.ReadFile(m.path)
+	if err != nil {
+		return Settings{}, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&s); err != nil {
+		return Settings{}, fmt.Errorf("reload: parsing %s: %w", m.path, err)
+	}
+	return s, nil
+}
+
+// validate checks s, normalizing its log level and chaos spec, and returns
+// the faults to inject, nil for none
+func (s *Settings) validate() (*interceptors.Chaos, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(s.LogLevel)); err != nil {
+		return nil, fmt.Errorf("unknown log level %q", s.LogLevel)
+	}
+	s.LogLevel = strings.ToLower(level.String())
+	if s.RateLimit < 0 || s.RateBurst < 0 {
+		return nil, errors.New("negative rate limit")
+	}
+	if s.RateLimit > 0 && s.RateBurst == 0 {
+		return nil, errors.New("rate-burst must be at least 1 when rate-limit is set")
+	}
+	if s.Chaos == "" {
+		return nil, nil
+	}
+	chaos, err := interceptors.ParseChaos(s.Chaos)
+	if err != nil {
+		return nil, err
+	}
+	s.Chaos = chaos.String()
+	return &chaos, nil
+}
+
+// diff lists the settings that differ between from and to
+func diff(from, to Settings) []string {
+	before, after := from.Map(), to.Map()
+	var changes []string
+	for _, name := range []string{"log-level", "rate-limit", "rate-burst", "chaos"} {
+		if before[name] != after[name] {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", name, quoteEmpty(before[name]), quoteEmpty(after[name])))
+		}
+	}
+	return changes
+}
+
+func quoteEmpty(value string) string {
+	if value == "" {
+		return `""`
+	}
+	return value
+}
+
+// Watch reloads on SIGHUP and whenever the settings file changes, until ctx
+// is done, logging what changed. Failed reloads are logged and change
+// nothing.
+func (m *Manager) Watch(ctx context.Context) error {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	// Watch the file's directory rather than the file, so files replaced
+	// by renaming, as editors and config management do, are seen
+	var events <-chan fsnotify.Event
+	var watchErrors <-chan error
+	var watcher *fsnotify.Watcher
+	if m.path != "" {
+		var err error
+		watcher, err = fsnotify.NewWatcher()
+		if err != nil {
+			signal.Stop(hup)
+			return err
+		}
+		if err := watcher.Add(filepath.Dir(m.path)); err != nil {
+			signal.Stop(hup)
+			watcher.Close()
+			return err
+		}
+		events, watchErrors = watcher.Events, watcher.Errors
+	}
+
+	go func() {
+		defer signal.Stop(hup)
+		if watcher != nil {
+			defer watcher.Close()
+		}
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				m.reload("SIGHUP")
+			case event := <-events:
+				if filepath.Clean(event.Name) == filepath.Clean(m.path) && (event.Has(fsnotify.Write) || event.Has(fsnotify.Create)) {
+					debounce = time.After(reloadDebounce)
+				}
+			case err := <-watchErrors:
+				slog.Warn("Watching the settings file failed", "path", m.path, logging.Err(err))
+			case <-debounce:
+				debounce = nil
+				m.reload("file changed")
+			}
+		}
+	}()
+	return nil
+}
+
+func (m *Manager) reload(trigger string) {
+	changes, err := m.Reload()
+	if err != nil {
+		slog.Error("Failed to reload the settings; keeping the ones in use", "trigger", trigger, logging.Err(err))
+		return
+	}
+	if len(changes) == 0 {
+		slog.Info("🔄 Settings reloaded; nothing changed", "trigger", trigger)
+		return
+	}
+	slog.Warn("🔄 Settings reloaded", "trigger", trigger, "changes", changes)
+}
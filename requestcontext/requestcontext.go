@@ -0,0 +1,169 @@
+// Package requestcontext carries request-scoped values, the tenant and
+// user a call is made for and W3C trace baggage, in typed context values.
+// Its server interceptors take them from the incoming metadata, so
+// handlers read them with TenantID, UserID and Baggage, and its client
+// interceptors send the values of the call's context along, so a server
+// making calls of its own with the context it was handed passes them on
+// unchanged:
+//
+//	ctx = requestcontext.WithTenantID(ctx, "acme")
+//	resp, err := client.SayHello(ctx, req) // sends x-tenant-id: acme
+package requestcontext
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"go.opentelemetry.io/otel/baggage"
+	"google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+// Headers the values travel in
+const (
+	TenantHeader = "x-tenant-id"
+	UserHeader   = "user-id"
+	// BaggageHeader holds W3C baggage, e.g. "region=eu,plan=free"
+	BaggageHeader = "baggage"
+)
+
+// maxIDLength is the longest tenant or user id taken from a request;
+// longer ones are ignored
+const maxIDLength = 128
+
+type (
+	tenantKey struct{}
+	userKey   struct{}
+)
+
+// WithTenantID returns ctx carrying the tenant the call is made for
+func WithTenantID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, id)
+}
+
+// TenantID returns the tenant ctx carries, or "" if there is none
+func TenantID(ctx context.Context) string {
+	id, _ := ctx.Value(tenantKey{}).(string)
+	return id
+}
+
+// WithUserID returns ctx carrying the user the call is made for
+func WithUserID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, userKey{}, id)
+}
+
+// UserID returns the user ctx carries, or "" if there is none
+func UserID(ctx context.Context) string {
+	id, _ := ctx.Value(userKey{}).(string)
+	return id
+}
+
+// WithBaggage returns ctx carrying b, replacing any baggage it carried.
+// The baggage is OpenTelemetry's, so spans started from ctx see it too.
+func WithBaggage(ctx context.Context, b baggage.Baggage) context.Context {
+	return baggage.ContextWithBaggage(ctx, b)
+}
+
+// Baggage returns the baggage ctx carries
+func Baggage(ctx context.Context) baggage.Baggage {
+	return baggage.FromContext(ctx)
+}
+
+// FromIncoming returns ctx carrying the tenant, user and baggage of the
+// call's incoming metadata. Baggage members from the request are added to
+// any ctx already carries. The tenant and user are also added to every
+// line logged with the returned context.
+func FromIncoming(ctx context.Context) context.Context {
+	md, _ := grpcmd.FromIncomingContext(ctx)
+	var attrs []slog.Attr
+	if id := first(md, TenantHeader); id != "" && len(id) <= maxIDLength {
+		ctx = WithTenantID(ctx, id)
+		attrs = append(attrs, slog.String("tenant_id", id))
+	}
+	if id := first(md, UserHeader); id != "" && len(id) <= maxIDLength {
+		ctx = WithUserID(ctx, id)
+		attrs = append(attrs, slog.String("user_id", id))
+	}
+	if header := first(md, BaggageHeader); header != "" {
+		incoming, err := baggage.Parse(header)
+		if err != nil {
+			slog.DebugContext(ctx, "Ignoring invalid baggage", "baggage", header, logging.Err(err))
+		} else {
+			b := Baggage(ctx)
+			for _, member := range incoming.Members() {
+				b, _ = b.SetMember(member)
+			}
+			ctx = WithBaggage(ctx, b)
+		}
+	}
+	return logging.WithAttrs(ctx, attrs...)
+}
+
+// ToOutgoing returns ctx sending its tenant, user and baggage as metadata
+// on outgoing calls. Headers the outgoing metadata already sets are left
+// alone, so explicitly attached ones win.
+func ToOutgoing(ctx context.Context) context.Context {
+	md, _ := grpcmd.FromOutgoingContext(ctx)
+	var kv []string
+	add := func(header, value string) {
+		if value != "" && len(md.Get(header)) == 0 {
+			kv = append(kv, header, value)
+		}
+	}
+	add(TenantHeader, TenantID(ctx))
+	add(UserHeader, UserID(ctx))
+	add(BaggageHeader, Baggage(ctx).String())
+	if len(kv) == 0 {
+		return ctx
+	}
+	return grpcmd.AppendToOutgoingContext(ctx, kv...)
+}
+
+// UnaryServerInterceptor hands handlers a context carrying the call's
+// request-scoped values. Install it before the interceptors that log.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(FromIncoming(ctx), req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &stream{ServerStream: ss, ctx: FromIncoming(ss.Context())})
+	}
+}
+
+// UnaryClientInterceptor sends the request-scoped values of every call's
+// context along with it
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(ToOutgoing(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is the streaming counterpart of
+// UnaryClientInterceptor
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(ToOutgoing(ctx), desc, cc, method, opts...)
+	}
+}
+
+type stream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func first(md grpcmd.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
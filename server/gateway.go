@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/transport"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// serveGateway starts the REST/JSON gateway on addr in the background. It
+// translates HTTP calls into gRPC calls to grpcTarget, so they pass through
+// the same interceptors as native clients. The returned function shuts it
+// down.
+func serveGateway(ctx context.Context, addr, grpcTarget string) (func(context.Context) error, error) {
+	mux, err := gatewayHandler(ctx, grpcTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("REST gateway failed", logging.Err(err))
+		}
+	}()
+	slog.Info("🌐 REST gateway listening", "addr", lis.Addr().String(), "grpc_target", grpcTarget)
+	return srv.Shutdown, nil
+}
+
+// gatewayHandler returns the REST/JSON handlers, which call the gRPC server
+// at grpcTarget, as returned by transport.Target
+func gatewayHandler(ctx context.Context, grpcTarget string) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(gatewayHeader))
+	target, dialOpts := transport.DialTarget(grpcTarget)
+	dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err := pb.RegisterGreetingServiceHandlerFromEndpoint(ctx, mux, target, dialOpts); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// gatewayHeader forwards Accept-Language as the locale header, so REST
+// callers get greetings in their browser's language, X-Request-Id as the
+// request-id header, so their ids show up in the server's logs, and
+// X-Tenant-Id, X-User-Id and Baggage as the requestcontext headers. Every
+// other header keeps the gateway's default handling.
+func gatewayHeader(key string) (string, bool) {
+	switch http.CanonicalHeaderKey(key) {
+	case "Accept-Language":
+		return metadata.LocaleHeader, true
+	case "X-Request-Id":
+		return metadata.RequestIDHeader, true
+	case "X-Tenant-Id":
+		return requestcontext.TenantHeader, true
+	case "X-User-Id":
+		return requestcontext.UserHeader, true
+	case "Baggage":
+		return requestcontext.BaggageHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
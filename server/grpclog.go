@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"google.golang.org/grpc/grpclog"
+)
+
+// configureGRPCLogger routes gRPC's internal logs through the standard
+// logger, which writes to the slog logger once logging.Setup has run.
+// severity is the lowest level shown ("off", "error", "warning" or
+// "info"), and verbosity controls how chatty info logs are, matching
+// GRPC_GO_LOG_VERBOSITY_LEVEL. It must be called before any gRPC activity.
+func configureGRPCLogger(severity string, verbosity int) error {
+	out := log.Writer()
+	infoW, warningW, errorW := io.Discard, io.Discard, io.Discard
+
+	switch severity {
+	case "off":
+	case "error":
+		errorW = out
+	case "warning":
+		warningW, errorW = out, out
+	case "info":
+		infoW, warningW, errorW = out, out, out
+	default:
+		return fmt.Errorf("unknown gRPC log severity %q", severity)
+	}
+
+	grpclog.SetLoggerV2(grpclog.NewLoggerV2WithVerbosity(infoW, warningW, errorW, verbosity))
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/grpclog"
+)
+
+// recordingHandler keeps the messages of the records it handles
+type recordingHandler struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler       { return h }
+func (h *recordingHandler) WithGroup(string) slog.Handler            { return h }
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.messages = append(h.messages, r.Message)
+	return nil
+}
+
+// containing returns the recorded messages containing s
+func (h *recordingHandler) containing(s string) []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var found []string
+	for _, m := range h.messages {
+		if strings.Contains(m, s) {
+			found = append(found, m)
+		}
+	}
+	return found
+}
+
+// recordLogs sends slog's and the standard logger's output to a
+// recordingHandler until the test ends
+func recordLogs(t *testing.T) *recordingHandler {
+	h := &recordingHandler{}
+	prev, flags := slog.Default(), log.Flags()
+	slog.SetDefault(slog.New(h))
+	t.Cleanup(func() {
+		slog.SetDefault(prev)
+		log.SetFlags(flags)
+		configureGRPCLogger("error", 0)
+	})
+	return h
+}
+
+// dialAndClose makes gRPC log a channel's life; nothing needs to listen
+func dialAndClose(t *testing.T) {
+	conn, err := grpc.NewClient("passthrough:///127.0.0.1:1", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	conn.Connect()
+	time.Sleep(50 * time.Millisecond)
+	conn.Close()
+}
+
+func TestConfigureGRPCLoggerReachesSlog(t *testing.T) {
+	h := recordLogs(t)
+	if err := configureGRPCLogger("info", 2); err != nil {
+		t.Fatalf("configureGRPCLogger: %v", err)
+	}
+	if !grpclog.V(2) || grpclog.V(3) {
+		t.Errorf("V(2), V(3) = %t, %t, want verbosity 2", grpclog.V(2), grpclog.V(3))
+	}
+
+	dialAndClose(t)
+	if len(h.containing("[core]")) == 0 {
+		t.Errorf("no gRPC core log lines reached slog; got %q", h.messages)
+	}
+}
+
+func TestConfigureGRPCLoggerSeverity(t *testing.T) {
+	h := recordLogs(t)
+	if err := configureGRPCLogger("error", 0); err != nil {
+		t.Fatalf("configureGRPCLogger: %v", err)
+	}
+
+	dialAndClose(t)
+	grpclog.Error("broken on purpose")
+	if got := h.containing("[core]"); len(got) != 0 {
+		t.Errorf("info lines reached slog at severity error: %q", got)
+	}
+	if len(h.containing("broken on purpose")) != 1 {
+		t.Errorf("the error line didn't reach slog; got %q", h.messages)
+	}
+}
+
+func TestConfigureGRPCLoggerUnknownSeverity(t *testing.T) {
+	if err := configureGRPCLogger("loud", 0); err == nil {
+		t.Error("configureGRPCLogger accepted an unknown severity")
+	}
+}
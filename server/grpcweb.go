@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+)
+
+// grpcWebPage is a browser client calling SayHello over gRPC-Web with
+// nothing but fetch, served at / next to the gRPC-Web endpoint
+//
+//go:embed grpcweb.html
+var grpcWebPage []byte
+
+// serveGRPCWeb serves s to browsers over gRPC-Web on addr in the
+// background, with no proxy such as Envoy in between. Calls go straight
+// into s, through the same interceptors as native clients. Pages from
+// origins, or any origin if it holds "*", may call it; same-origin pages,
+// like the demo page at /, always may. The returned function shuts it down.
+func serveGRPCWeb(addr string, s *grpc.Server, origins []string) (func(context.Context) error, error) {
+	wrapped := grpcweb.WrapServer(s,
+		grpcweb.WithOriginFunc(func(origin string) bool {
+			return slices.Contains(origins, "*") || slices.Contains(origins, origin)
+		}),
+		grpcweb.WithAllowedRequestHeaders([]string{
+			"authorization", metadata.LocaleHeader, metadata.RequestIDHeader,
+			requestcontext.TenantHeader, requestcontext.UserHeader, requestcontext.BaggageHeader,
+			"x-user-agent", "x-grpc-web",
+		}),
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrapped.IsGrpcWebRequest(r) || wrapped.IsAcceptableGrpcCorsRequest(r) {
+			wrapped.ServeHTTP(w, r)
+			return
+		}
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(grpcWebPage)
+	})
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	srv := &http.Server{Handler: handler, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("gRPC-Web server failed", logging.Err(err))
+		}
+	}()
+	slog.Info("🖥️ gRPC-Web listening", "addr", lis.Addr().String(), "origins", origins, "page", "http://"+lis.Addr().String()+"/")
+	return srv.Shutdown, nil
+}
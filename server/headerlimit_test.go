@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// bigHeader is a metadata value of about 8KiB
+var bigHeader = strings.Repeat("x", 8<<10)
+
+func alice() *pb.HelloRequest {
+	return &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}
+}
+
+func TestMaxHeaderListSizeServer(t *testing.T) {
+	tests := []struct {
+		desc    string
+		limit   uint32
+		wantErr bool
+	}{
+		{"under a small limit", 4 << 10, true},
+		{"under a larger limit", 64 << 10, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ts := greetertest.StartTestServer(t, greetertest.WithServerOptions(grpc.MaxHeaderListSize(tt.limit)))
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "x-big", bigHeader)
+			_, err := ts.Client.SayHello(ctx, alice())
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Errorf("SayHello with 8KiB of metadata = %v, want error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMaxHeaderListSizeClient(t *testing.T) {
+	sendBigHeader := grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		grpc.SetHeader(ctx, metadata.Pairs("x-big", bigHeader))
+		return handler(ctx, req)
+	})
+	tests := []struct {
+		desc    string
+		limit   uint32
+		wantErr bool
+	}{
+		{"under a small limit", 4 << 10, true},
+		{"under a larger limit", 64 << 10, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ts := greetertest.StartTestServer(t,
+				greetertest.WithServerOptions(sendBigHeader),
+				greetertest.WithDialOptions(grpc.WithMaxHeaderListSize(tt.limit)))
+			_, err := ts.Client.SayHello(context.Background(), alice())
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Errorf("SayHello answered with 8KiB of headers = %v, want error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// registerHealth registers the standard grpc.health.v1.Health service and
+// marks the server ("") and each named service as SERVING. Use
+// SetServingStatus on the returned server to flip an individual service,
+// e.g. to take it out of a load balancer's rotation.
+func registerHealth(s *grpc.Server, services ...string) *health.Server {
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(s, hs)
+
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	for _, name := range services {
+		hs.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	return hs
+}
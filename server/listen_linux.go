@@ -0,0 +1,59 @@
+//go:build linux
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// reusePortSupported reports whether -reuseport can be honored on this OS
+const reusePortSupported = true
+
+// listenConfig returns the ListenConfig used for the gRPC listener. With
+// reusePort set the socket gets SO_REUSEPORT, so several server processes
+// can bind the same port and the kernel spreads connections across them,
+// which allows blue-green restarts.
+func listenConfig(reusePort bool) net.ListenConfig {
+	if !reusePort {
+		return net.ListenConfig{}
+	}
+
+	return net.ListenConfig{
+		Control: func(network, address string, c syscall.RawConn) error {
+			var sockErr error
+			err := c.Control(func(fd uintptr) {
+				sockErr = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_REUSEPORT, 1)
+			})
+			if err != nil {
+				return err
+			}
+			return sockErr
+		},
+	}
+}
+
+// setBacklog sets how many connections lis queues before they are
+// accepted. Go listens with net.core.somaxconn; listening again on the
+// socket changes it, though the kernel still caps it at somaxconn.
+func setBacklog(lis net.Listener, backlog int) error {
+	sc, ok := lis.(syscall.Conn)
+	if !ok {
+		return fmt.Errorf("%s listeners have no accept backlog", lis.Addr().Network())
+	}
+	raw, err := sc.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var listenErr error
+	err = raw.Control(func(fd uintptr) {
+		listenErr = unix.Listen(int(fd), backlog)
+	})
+	if err != nil {
+		return err
+	}
+	return listenErr
+}
@@ -0,0 +1,101 @@
+//go:build linux
+
+package main
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// serveCounting serves the greeter on lis with a gRPC server that counts
+// the calls it handles in n
+func serveCounting(t *testing.T, lis net.Listener, n *atomic.Int32) {
+	count := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		n.Add(1)
+		return handler(ctx, req)
+	}
+	s := grpc.NewServer(grpc.UnaryInterceptor(count))
+	pb.RegisterGreetingServiceServer(s, service.New())
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+}
+
+func TestReusePortSharesThePort(t *testing.T) {
+	lc := listenConfig(true)
+	first, err := lc.Listen(context.Background(), "tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("first listen: %v", err)
+	}
+	addr := first.Addr().String()
+	second, err := lc.Listen(context.Background(), "tcp", addr)
+	if err != nil {
+		first.Close()
+		t.Fatalf("second listen on %s: %v", addr, err)
+	}
+
+	var firstServed, secondServed atomic.Int32
+	serveCounting(t, first, &firstServed)
+	serveCounting(t, second, &secondServed)
+
+	// The kernel spreads connections by their source port, so greet over
+	// new connections until both servers have answered some
+	req := &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}
+	deadline := time.Now().Add(5 * time.Second)
+	for firstServed.Load() == 0 || secondServed.Load() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("servers answered %d and %d calls, want both to answer some", firstServed.Load(), secondServed.Load())
+		}
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			t.Fatalf("NewClient %s: %v", addr, err)
+		}
+		_, err = pb.NewGreetingServiceClient(conn).SayHello(context.Background(), req)
+		conn.Close()
+		if err != nil {
+			t.Fatalf("SayHello on %s: %v", addr, err)
+		}
+	}
+}
+
+func TestWithoutReusePortThePortIsTaken(t *testing.T) {
+	lc := listenConfig(false)
+	first, err := lc.Listen(context.Background(), "tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer first.Close()
+	if second, err := lc.Listen(context.Background(), "tcp", first.Addr().String()); err == nil {
+		second.Close()
+		t.Fatalf("second listen on %s succeeded without -reuseport", first.Addr())
+	}
+}
+
+func TestSetBacklog(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer lis.Close()
+	if err := setBacklog(lis, 16); err != nil {
+		t.Fatalf("setBacklog: %v", err)
+	}
+	// The listener still accepts after listening again
+	go func() {
+		if conn, err := net.Dial("tcp", lis.Addr().String()); err == nil {
+			conn.Close()
+		}
+	}()
+	conn, err := lis.Accept()
+	if err != nil {
+		t.Fatalf("accept after setBacklog: %v", err)
+	}
+	conn.Close()
+}
@@ -0,0 +1,23 @@
+//go:build !linux
+
+package main
+
+import (
+	"errors"
+	"net"
+)
+
+// reusePortSupported reports whether -reuseport can be honored on this OS
+const reusePortSupported = false
+
+// listenConfig returns the ListenConfig used for the gRPC listener.
+// SO_REUSEPORT is only wired up on Linux.
+func listenConfig(reusePort bool) net.ListenConfig {
+	return net.ListenConfig{}
+}
+
+// setBacklog sets how many connections lis queues before they are
+// accepted; only supported on Linux
+func setBacklog(lis net.Listener, backlog int) error {
+	return errors.New("-listen-backlog is only supported on Linux")
+}
@@ -2,73 +2,664 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"io"
 	"log"
-	"net"
+	"log/slog"
+	"maps"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
-	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/admin"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/audit"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/auth"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/config"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/diagnostics"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/events"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metrics"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/pbjson"
+	adminpb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/admin"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+	userpb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/user"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/reload"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/sessions"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/stats"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/store"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/store/sqlite"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/templates"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/tenants"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/tracing"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/transport"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/users"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/workers"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip" // Register the gzip compressor
+	"google.golang.org/grpc/reflection"
 )
 
-// Server implements the GreetingService
-type server struct {
-	pb.UnimplementedGreetingServiceServer
-}
-
-// SayHello implements the simple RPC method
-func (s *server) SayHello(ctx context.Context, req *pb.HelloRequest) (*pb.HelloResponse, error) {
-	log.Printf("Received request from: %s", req.GetName())
+// version is reported to clients in the server-version response header
+const version = "1.0.0"
 
-	// Create response
-	response := &pb.HelloResponse{
-		Message: fmt.Sprintf("Hello, %s! Welcome to gRPC with Go!", req.GetName()),
-		Count:   1,
+func main() {
+	cfg, err := config.LoadServer(os.Args[1:])
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
-	return response, nil
-}
+	broker := service.NewBroker(service.DefaultBrokerBuffer)
+	opts := []service.Option{
+		service.WithBroker(broker),
+		service.WithStreamDelay(cfg.StreamDelay),
+		service.WithStreamRampUp(cfg.StreamRampUp),
+		service.WithStreamLimits(cfg.StreamMaxCount, cfg.StreamMaxInterval),
+		service.WithBatchConcurrency(cfg.BatchConcurrency),
+	}
+	uploadDir := cfg.UploadDir
+	if uploadDir == "" {
+		uploadDir = service.DefaultUploadDir()
+	}
+	opts = append(opts, service.WithUploads(uploadDir, cfg.MaxUploadSize))
+	conversations := sessions.New(cfg.SessionTTL, cfg.MaxSessions)
+	opts = append(opts, service.WithSessions(conversations))
+	var logOut io.Writer = os.Stderr
+	if cfg.Debug {
+		// Keep recent log lines around so StreamLogs can serve them
+		logs := service.NewLogBuffer(500)
+		logOut = io.MultiWriter(os.Stderr, logs)
+		opts = append(opts, service.WithLogStream(logs))
+	}
+	if err := logging.Setup(logOut, cfg.Logging.Format, cfg.Logging.Level); err != nil {
+		log.Fatalf("Failed to configure logging: %v", err)
+	}
 
-// SayHelloMultiple implements the server streaming RPC method
-func (s *server) SayHelloMultiple(req *pb.HelloRequest, stream pb.GreetingService_SayHelloMultipleServer) error {
-	log.Printf("Received streaming request from: %s", req.GetName())
+	// Record greetings in memory unless -store-path asks for a database
+	var history store.Store = store.NewMemory()
+	if cfg.StorePath != "" {
+		history, err = sqlite.Open(cfg.StorePath)
+		if err != nil {
+			fatal("Failed to open greeting store", logging.Err(err))
+		}
+		slog.Info("🗄️  Recording greetings", "path", cfg.StorePath)
+	}
+	opts = append(opts, service.WithStore(history))
 
-	// Send 5 greetings with a delay
-	for i := 1; i <= 5; i++ {
-		response := &pb.HelloResponse{
-			Message: fmt.Sprintf("Hello #%d, %s! Streaming response %d of 5", i, req.GetName(), i),
-			Count:   int32(i),
+	// Publish greetings to a message broker when -events-url names one
+	var eventBridge *events.Bridge
+	var eventConn events.Conn
+	if cfg.EventsURL != "" {
+		eventConn, err = events.Open(cfg.EventsURL)
+		if err != nil {
+			fatal("Failed to connect to the event broker", logging.Err(err))
 		}
+		eventBridge = events.NewBridge(eventConn, cfg.EventsTopic, events.DefaultBridgeBuffer)
+		opts = append(opts, service.WithEvents(eventBridge))
+		slog.Info("📣 Publishing greeting events", "broker", cfg.EventsURL, "topic", cfg.EventsTopic)
+	}
 
-		if err := stream.Send(response); err != nil {
-			return err
+	// Render greetings with the -templates-dir templates, reloading them
+	// whenever a file there changes
+	var greetingTemplates *templates.Engine
+	stopWatchingTemplates := func() {}
+	if cfg.TemplatesDir != "" {
+		greetingTemplates, err = templates.Load(cfg.TemplatesDir)
+		if err != nil {
+			fatal("Failed to load the templates", logging.Err(err))
 		}
+		var watchCtx context.Context
+		watchCtx, stopWatchingTemplates = context.WithCancel(context.Background())
+		if err := greetingTemplates.Watch(watchCtx); err != nil {
+			fatal("Failed to watch the templates", logging.Err(err))
+		}
+		opts = append(opts, service.WithTemplates(greetingTemplates))
+		slog.Info("🎨 Rendering greetings with templates", "dir", cfg.TemplatesDir)
+	}
 
-		log.Printf("Sent streaming response #%d to %s", i, req.GetName())
-		time.Sleep(1 * time.Second) // Simulate some processing time
+	if err := configureGRPCLogger(cfg.GRPCLogSeverity, cfg.GRPCLogVerbosity); err != nil {
+		fatal("Failed to configure gRPC logging", logging.Err(err))
 	}
 
-	return nil
-}
+	// Export traces when OTEL_* variables ask for it; no-op otherwise
+	shutdownTracing, err := tracing.Setup(context.Background(), "greeter-server")
+	if err != nil {
+		fatal("Failed to set up tracing", logging.Err(err))
+	}
 
-func main() {
-	// Listen on TCP port 50051
-	lis, err := net.Listen("tcp", ":50051")
+	if cfg.ReusePort && !reusePortSupported {
+		fatal("-reuseport is only supported on Linux")
+	}
+	if cfg.GatewayAddr != "" && cfg.TLS.Enabled {
+		fatal("-gateway-addr cannot be combined with -tls yet")
+	}
+	if cfg.GRPCWebAddr != "" && cfg.TLS.Enabled {
+		fatal("-grpc-web-addr cannot be combined with -tls yet")
+	}
+	if cfg.Multiplex && cfg.TLS.Enabled {
+		fatal("-multiplex cannot be combined with -tls yet")
+	}
+	if cfg.Admin && cfg.AuthSecret == "" {
+		fatal("-admin needs -auth-secret so only admins can call the AdminService")
+	}
+
+	// Listen on the configured address: TCP (port 50051 by default), a
+	// unix:// socket or a memory:// listener
+	if network, _ := transport.Parse(cfg.Addr); cfg.ReusePort && network != transport.TCP {
+		fatal("-reuseport only applies to TCP addresses")
+	}
+	lc := listenConfig(cfg.ReusePort)
+	lis, err := transport.Listen(context.Background(), lc, cfg.Addr)
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		fatal("Failed to listen", logging.Err(err))
+	}
+	if cfg.ListenBacklog > 0 {
+		if err := setBacklog(lis, cfg.ListenBacklog); err != nil {
+			fatal("Failed to set -listen-backlog", logging.Err(err))
+		}
 	}
 
-	// Create a new gRPC server
-	s := grpc.NewServer()
+	// Name this instance in every response so balanced clients can see
+	// which backend served them
+	backend := cfg.InstanceName
+	if backend == "" {
+		backend = lis.Addr().String()
+	}
 
-	// Register our service implementation
-	pb.RegisterGreetingServiceServer(s, &server{})
+	// Create a new gRPC server. Every call gets a request id first, so all
+	// its log lines name it, and then its tenant and user. The idempotency
+	// cache lets retried calls that carry the same idempotency key return
+	// the original response.
+	idempotency := interceptors.NewIdempotencyCache(cfg.IdempotencyTTL)
+	var active interceptors.ActiveStreams
+	registry := metrics.NewRegistry()
+	serverMetrics := metrics.NewServerMetrics(registry)
+	// Count calls in process too, for GetServerStats and /debug/vars
+	callStats := stats.NewRegistry()
+	callStats.Publish("grpc_server")
+	unary := []grpc.UnaryServerInterceptor{
+		interceptors.UnaryServerRequestID(),
+		requestcontext.UnaryServerInterceptor(),
+		active.UnaryServerInterceptor(),
+		serverMetrics.UnaryServerInterceptor(),
+		callStats.UnaryServerInterceptor(),
+		interceptors.UnaryServerLogging(),
+		interceptors.UnaryServerResponseCompression(),
+		interceptors.UnaryServerHeaders(version, backend),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		interceptors.StreamServerRequestID(),
+		requestcontext.StreamServerInterceptor(),
+		active.StreamServerInterceptor(),
+		serverMetrics.StreamServerInterceptor(),
+		callStats.StreamServerInterceptor(),
+		interceptors.StreamServerLogging(),
+		interceptors.StreamServerResponseCompression(),
+		interceptors.StreamServerHeaders(version, backend),
+	}
 
-	log.Printf("✅ gRPC Server is running on port 50051...")
-	log.Printf("Waiting for client connections...")
+	var authenticator *auth.Authenticator
+	if cfg.AuthSecret != "" {
+		authenticator = auth.NewAuthenticator(auth.NewIssuer(cfg.AuthSecret), auth.DefaultExemptions...).
+			RequireRole("/"+adminpb.AdminService_ServiceDesc.ServiceName+"/", auth.AdminRole)
+	}
+
+	// Record every call in the audit log, including those refused by
+	// authentication or ending in a recovered panic
+	var auditSink io.Closer
+	if cfg.AuditLog != "" {
+		auditor, sink, err := auditLogger(cfg, authenticator)
+		if err != nil {
+			fatal("Failed to set up the audit log", logging.Err(err))
+		}
+		auditSink = sink
+		unary = append(unary, auditor.UnaryServerInterceptor())
+		stream = append(stream, auditor.StreamServerInterceptor())
+		slog.Info("📝 Auditing every call", "sink", cfg.AuditLog, "redact", cfg.AuditRedact)
+	}
+
+	// Dump every message for debugging, including those of refused calls
+	if cfg.DebugJSON {
+		redactions, err := audit.ParseRedactions(cfg.DebugJSONRedact)
+		if err != nil {
+			fatal("Invalid -debug-json-redact", logging.Err(err))
+		}
+		dumper := pbjson.New(os.Stderr, pbjson.WithRedactions(redactions))
+		unary = append(unary, dumper.UnaryServerInterceptor())
+		stream = append(stream, dumper.StreamServerInterceptor())
+		slog.Info("🔎 Dumping every message as JSON", "redact", redactions.String())
+	}
+
+	// Turn panics from here on into Internal errors for the call alone,
+	// after logging and metrics so they record the failed call
+	panics := metrics.NewPanicMetrics(registry)
+	unary = append(unary, interceptors.UnaryServerRecovery(panics))
+	stream = append(stream, interceptors.StreamServerRecovery(panics))
+
+	// Cap how long each method may run, time spent queueing or in chaos
+	// delays included, and warn about slow calls
+	if cfg.MethodTimeouts != "" || cfg.SlowCallThreshold > 0 {
+		timeouts := interceptors.TimeoutPolicy{SlowAfter: cfg.SlowCallThreshold}
+		if cfg.MethodTimeouts != "" {
+			limits, err := interceptors.ParseTimeouts(cfg.MethodTimeouts)
+			if err != nil {
+				fatal("Invalid -method-timeouts", logging.Err(err))
+			}
+			timeouts.Limits = limits
+			slog.Info("⏱️ Method timeouts", "limits", timeouts.String())
+		}
+		unary = append(unary, timeouts.UnaryServerInterceptor())
+		stream = append(stream, timeouts.StreamServerInterceptor())
+	}
+
+	// Reject calls without a valid bearer token, except health checks and
+	// reflection, and AdminService calls without the admin role
+	if authenticator != nil {
+		unary = append(unary, authenticator.UnaryServerInterceptor())
+		stream = append(stream, authenticator.StreamServerInterceptor())
+	}
+
+	// Give each client its own token bucket; runs after authentication so
+	// authenticated callers are limited by identity rather than address.
+	// With -config-file the limiter is installed even while off, so a
+	// reload can turn it on.
+	var limiter *interceptors.RateLimiter
+	if cfg.RateLimit > 0 || cfg.ConfigFile != "" {
+		limiter = interceptors.NewRateLimiter(cfg.RateLimit, cfg.RateBurst)
+		unary = append(unary, limiter.UnaryServerInterceptor())
+		stream = append(stream, limiter.StreamServerInterceptor())
+	}
+
+	// Admit calls only for a configured tenant, within its rate limit and
+	// by callers it lists; after authentication so subjects are known
+	if cfg.TenantsFile != "" {
+		tenantSet, err := loadTenants(cfg.TenantsFile, metrics.NewTenantMetrics(registry))
+		if err != nil {
+			fatal("Failed to load the tenants", logging.Err(err))
+		}
+		unary = append(unary, tenantSet.UnaryServerInterceptor())
+		stream = append(stream, tenantSet.StreamServerInterceptor())
+		slog.Info("🏢 Serving tenants", "file", cfg.TenantsFile, "tenants", tenantSet.IDs())
+	}
+
+	// Reject requests breaking the (validate.rules) in the protos before
+	// they take a queue slot or reach a handler
+	unary = append(unary, interceptors.UnaryServerValidation())
+	stream = append(stream, interceptors.StreamServerValidation())
+
+	// Answer repeated SayHello calls from memory; hits need no queue slot
+	var cache *interceptors.ResponseCache
+	if cfg.CacheSize > 0 {
+		cache = interceptors.NewResponseCache(cfg.CacheSize, cfg.CacheTTL,
+			[]string{pb.GreetingService_SayHello_FullMethodName, pbv2.GreetingServiceV2_SayHello_FullMethodName},
+			[]string{metadata.LocaleHeader, requestcontext.TenantHeader, service.IfNoneMatchHeader},
+			metrics.NewCacheMetrics(registry))
+		unary = append(unary, cache.UnaryServerInterceptor())
+		slog.Info("🧊 Caching SayHello responses", "size", cfg.CacheSize, "ttl", cfg.CacheTTL)
+	}
+
+	// Queue requests beyond the concurrency limit, by x-priority, so load
+	// is observable through GetStats and high priority calls go first
+	if cfg.MaxConcurrentRequests > 0 {
+		weights, err := interceptors.ParsePriorityWeights(cfg.PriorityWeights)
+		if err != nil {
+			fatal("Invalid -priority-weights", logging.Err(err))
+		}
+		// GetStats reports the queue so must answer under load, and
+		// subscriptions, log tails and health watches last as long as their
+		// clients: queued, a few would hold every slot
+		exempt := append([]string{
+			pb.GreetingService_GetStats_FullMethodName,
+			pb.GreetingService_SubscribeGreetings_FullMethodName,
+			pb.GreetingService_StreamLogs_FullMethodName,
+			"/" + adminpb.AdminService_ServiceDesc.ServiceName + "/",
+		}, auth.DefaultExemptions...)
+		queue := interceptors.NewAdmissionQueue(cfg.MaxConcurrentRequests, cfg.QueueSize,
+			interceptors.WithPriorityWeights(weights), interceptors.WithAdmissionExemptions(exempt...))
+		metrics.RegisterAdmissionQueue(registry, queue)
+		unary = append(unary, queue.UnaryServerInterceptor())
+		stream = append(stream, queue.StreamServerInterceptor())
+		opts = append(opts, service.WithAdmissionQueue(queue))
+		slog.Info("🚥 Admission queue", "max_concurrent", cfg.MaxConcurrentRequests, "queue_size", cfg.QueueSize, "weights", weights.String())
+	}
+	// Generate streamed greetings on a bounded worker pool rather than each
+	// stream's own goroutine
+	var pool *workers.Pool
+	if cfg.Workers > 0 {
+		pool = workers.New(cfg.Workers, cfg.WorkerQueueSize)
+		metrics.RegisterWorkerPool(registry, pool)
+		opts = append(opts, service.WithWorkers(pool))
+		slog.Info("👷 Worker pool", "workers", cfg.Workers, "queue_size", cfg.WorkerQueueSize)
+	}
+	if cfg.FailRate > 0 {
+		unary = append(unary, interceptors.UnaryServerRandomFailures(cfg.FailRate))
+	}
+	// Inject the -chaos faults; with -admin they can be changed, or chaos
+	// turned on, while the server runs
+	chaos := interceptors.NewChaosSwitch("/" + adminpb.AdminService_ServiceDesc.ServiceName + "/")
+	if cfg.Chaos != "" {
+		faults, err := interceptors.ParseChaos(cfg.Chaos)
+		if err != nil {
+			fatal("Invalid -chaos", logging.Err(err))
+		}
+		slog.Info("🐒 Chaos mode", "faults", faults.String())
+		chaos.Set(&faults)
+	}
+	if cfg.Chaos != "" || cfg.Admin || cfg.ConfigFile != "" {
+		unary = append(unary, chaos.UnaryServerInterceptor())
+		stream = append(stream, chaos.StreamServerInterceptor())
+	}
+	unary = append(unary, idempotency.UnaryServerInterceptor())
+	if cfg.PanicOn != "" {
+		slog.Warn("💥 Panicking instead of handling calls", "method", cfg.PanicOn)
+		unary = append(unary, interceptors.UnaryServerPanicOn(cfg.PanicOn))
+		stream = append(stream, interceptors.StreamServerPanicOn(cfg.PanicOn))
+	}
+
+	// Reload the log level, rate limit, chaos and templates on SIGHUP, and
+	// whenever the -config-file settings change; the file's settings
+	// override the flags from the start
+	reloader := reload.New(cfg.ConfigFile, reload.Settings{
+		LogLevel:  cfg.Logging.Level,
+		RateLimit: cfg.RateLimit,
+		RateBurst: cfg.RateBurst,
+		Chaos:     cfg.Chaos,
+	}, reload.Targets{Limiter: limiter, Chaos: chaos, Templates: greetingTemplates})
+	if cfg.ConfigFile != "" {
+		changes, err := reloader.Reload()
+		if err != nil {
+			fatal("Failed to load the settings file", logging.Err(err))
+		}
+		slog.Info("🔄 Settings file applied", "path", cfg.ConfigFile, "changes", changes)
+	}
+	reloadCtx, stopReloading := context.WithCancel(context.Background())
+	if err := reloader.Watch(reloadCtx); err != nil {
+		fatal("Failed to watch the settings file", logging.Err(err))
+	}
+
+	// Free the memory of expired conversations; they expire when next used
+	// either way
+	metrics.RegisterSessions(registry, conversations)
+	sessionsCtx, stopSessions := context.WithCancel(context.Background())
+	go conversations.Run(sessionsCtx, max(cfg.SessionTTL/4, time.Second))
+
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+		tracing.ServerOption(),
+	}
+	if cfg.LogPayloads {
+		serverOpts = append(serverOpts, grpc.StatsHandler(interceptors.PayloadSizeLogger{}))
+	}
+	creds := insecure.NewCredentials()
+	if cfg.TLS.Enabled {
+		creds, err = serverCredentials(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.ClientCAFile, cfg.TLS.GenerateDir)
+		if err != nil {
+			fatal("Failed to set up TLS", logging.Err(err))
+		}
+	}
+	if cfg.LogPings {
+		creds = pingLogger{creds}
+	}
+	serverOpts = append(serverOpts, grpc.Creds(creds))
+	if cfg.MaxHeaderListSize > 0 {
+		serverOpts = append(serverOpts, grpc.MaxHeaderListSize(uint32(cfg.MaxHeaderListSize)))
+	}
+	serverOpts = append(serverOpts, cfg.Messages.ServerOptions()...)
+	serverOpts = append(serverOpts, cfg.Keepalive.ServerOptions()...)
+	// Cap streams per connection, advertised to clients in the HTTP/2
+	// settings, and connections per peer IP, closed on accept
+	if cfg.MaxStreamsPerConn > 0 {
+		serverOpts = append(serverOpts, grpc.MaxConcurrentStreams(uint32(cfg.MaxStreamsPerConn)))
+	}
+	if cfg.MaxConnsPerPeer > 0 {
+		lis = transport.LimitPerPeer(lis, cfg.MaxConnsPerPeer, metrics.NewConnMetrics(registry))
+	}
+	s := grpc.NewServer(serverOpts...)
+
+	// Register our service implementation. v1 and v2 share one
+	// implementation so old and new clients get consistent greetings.
+	greeter := service.New(opts...)
+	pb.RegisterGreetingServiceServer(s, greeter)
+	pbv2.RegisterGreetingServiceV2Server(s, greeter.V2())
+
+	// A second, unrelated service shares the server, its port and its
+	// interceptors
+	userpb.RegisterUserServiceServer(s, users.NewServer(users.NewMemory()))
+
+	// Let operators change the log level and chaos, drain the server and
+	// read its config without a restart. Drain starts the same shutdown as
+	// SIGTERM.
+	drainRequested := make(chan struct{})
+	if cfg.Admin {
+		var drainOnce sync.Once
+		adminpb.RegisterAdminServiceServer(s, admin.NewServer(admin.Controls{
+			Chaos: chaos,
+			Drain: func() int64 {
+				drainOnce.Do(func() { close(drainRequested) })
+				return active.Count()
+			},
+			Settings: func() map[string]string {
+				settings := cfg.Settings()
+				maps.Copy(settings, reloader.Current().Map())
+				return settings
+			},
+			Cache:     cache,
+			Workers:   pool,
+			Templates: greetingTemplates,
+			Stats:     callStats,
+		}))
+	}
+
+	// Let tools such as grpcurl discover the API without the .proto files
+	if cfg.Reflection {
+		reflection.Register(s)
+	}
+
+	// Report readiness per service so Kubernetes and load balancers can
+	// probe it
+	healthServer := registerHealth(s,
+		pb.GreetingService_ServiceDesc.ServiceName,
+		pbv2.GreetingServiceV2_ServiceDesc.ServiceName,
+		userpb.UserService_ServiceDesc.ServiceName,
+	)
+
+	// With -multiplex one HTTP server takes the port and hands gRPC
+	// requests to s, serving the REST gateway, /healthz, /metrics and a
+	// status page next to them
+	var web *http.Server
+	if cfg.Multiplex {
+		gateway, err := gatewayHandler(context.Background(), transport.Target(lis))
+		if err != nil {
+			fatal("Failed to set up REST gateway", logging.Err(err))
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/v1/", gateway)
+		mux.Handle("/healthz", healthz(healthServer))
+		mux.Handle("/metrics", metrics.Handler(registry))
+		mux.Handle("/", statusPage(s, backend, time.Now(), active.Count))
+		web = newMultiplexServer(multiplex(s, mux))
+		slog.Info("🔀 Serving gRPC, REST, /healthz, /metrics and a status page", "addr", lis.Addr().String())
+	}
+
+	slog.Info("✅ gRPC Server is running", "addr", lis.Addr().String())
+	slog.Info("Waiting for client connections...")
+
+	// Hooks run in reverse order, so pending spans are flushed last
+	var shutdown ShutdownManager
+	shutdown.Register("tracing", shutdownTracing)
+	shutdown.Register("store", func(context.Context) error { return history.Close() })
+	if eventBridge != nil {
+		// Runs after the drain, so greetings made during it are published
+		shutdown.Register("events", func(ctx context.Context) error {
+			err := eventBridge.Close(ctx)
+			published, dropped, failed := eventBridge.Stats()
+			slog.Info("📣 Greeting events", "published", published, "dropped", dropped, "failed", failed)
+			return errors.Join(err, eventConn.Close())
+		})
+	}
+	shutdown.Register("templates", func(context.Context) error {
+		stopWatchingTemplates()
+		return nil
+	})
+	shutdown.Register("settings reloads", func(context.Context) error {
+		stopReloading()
+		return nil
+	})
+	shutdown.Register("sessions", func(context.Context) error {
+		stopSessions()
+		return nil
+	})
+	if auditSink != nil {
+		shutdown.Register("audit log", func(context.Context) error { return auditSink.Close() })
+	}
+	if pool != nil {
+		shutdown.Register("workers", func(context.Context) error {
+			pool.Close()
+			return nil
+		})
+	}
+	if cfg.MetricsAddr != "" && !cfg.Multiplex {
+		shutdown.Register("metrics", metrics.Serve(cfg.MetricsAddr, registry))
+		slog.Info("📈 Metrics available", "url", "http://"+cfg.MetricsAddr+"/metrics")
+	}
+	if cfg.DebugAddr != "" {
+		stopDiagnostics, err := diagnostics.Serve(cfg.DebugAddr)
+		if err != nil {
+			fatal("Failed to start the diagnostics endpoint", logging.Err(err))
+		}
+		shutdown.Register("diagnostics", stopDiagnostics)
+		slog.Info("🩻 Diagnostics available", "url", "http://"+cfg.DebugAddr+"/debug/pprof/")
+	}
+	shutdown.Register("grpc server", func(ctx context.Context) error {
+		if web != nil {
+			return stopMultiplexed(ctx, web, s)
+		}
+		draining := active.Count()
+		slog.Info("Draining active streams...", "streams", draining)
+
+		stopped := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+			slog.Info("Drained streams", "streams", draining)
+			return nil
+		case <-ctx.Done():
+			remaining := active.Count()
+			s.Stop()
+			slog.Warn("Forced streams to close after the drain timeout", "drained", draining-remaining, "forced", remaining)
+			return ctx.Err()
+		}
+	})
+
+	// Runs before the drain: end SubscribeGreetings streams, which would
+	// otherwise stay open until the drain timeout
+	shutdown.Register("subscriptions", func(context.Context) error {
+		broker.Close()
+		return nil
+	})
+
+	// Serve REST clients through the gateway; it is stopped before the gRPC
+	// server drains since it forwards to it
+	if cfg.GatewayAddr != "" {
+		stopGateway, err := serveGateway(context.Background(), cfg.GatewayAddr, transport.Target(lis))
+		if err != nil {
+			fatal("Failed to start REST gateway", logging.Err(err))
+		}
+		shutdown.Register("gateway", stopGateway)
+	}
+
+	// Serve browsers over gRPC-Web; like the gateway it is stopped before
+	// the gRPC server drains
+	if cfg.GRPCWebAddr != "" {
+		stopGRPCWeb, err := serveGRPCWeb(cfg.GRPCWebAddr, s, cfg.GRPCWebOrigins)
+		if err != nil {
+			fatal("Failed to start gRPC-Web", logging.Err(err))
+		}
+		shutdown.Register("grpc-web", stopGRPCWeb)
+	}
+
+	// Runs before the drain above: report NOT_SERVING so health probes
+	// stop routing new traffic here while in-flight RPCs finish
+	shutdown.Register("health", func(ctx context.Context) error {
+		healthServer.Shutdown()
+		return nil
+	})
 
 	// Start serving requests
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	serveErr := make(chan error, 1)
+	go func() {
+		if web != nil {
+			serveErr <- web.Serve(lis)
+			return
+		}
+		serveErr <- s.Serve(lis)
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	select {
+	case err := <-serveErr:
+		fatal("Failed to serve", logging.Err(err))
+	case <-ctx.Done():
+	case <-drainRequested:
 	}
+
+	slog.Info("Shutting down...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.DrainTimeout)
+	defer cancel()
+	if err := shutdown.Run(shutdownCtx); err != nil {
+		slog.Error("Shutdown finished with errors", logging.Err(err))
+		return
+	}
+	slog.Info("👋 Server stopped")
+}
+
+// auditLogger opens the -audit-log sink and returns the audit logger
+// writing to it, naming callers by their token when authenticator is set
+func auditLogger(cfg *config.Server, authenticator *auth.Authenticator) (*audit.Logger, io.Closer, error) {
+	redactions, err := audit.ParseRedactions(cfg.AuditRedact)
+	if err != nil {
+		return nil, nil, err
+	}
+	sink, err := audit.OpenSink(cfg.AuditLog, cfg.AuditMaxSize, cfg.AuditMaxBackups)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := []audit.Option{audit.WithRedactions(redactions)}
+	if authenticator != nil {
+		opts = append(opts, audit.WithIdentity(authenticator.Identify))
+	}
+	return audit.New(sink, opts...), sink, nil
+}
+
+// loadTenants reads the -tenants-file tenants, reporting their calls to
+// rec. Health checks, reflection and the AdminService need no tenant.
+func loadTenants(path string, rec tenants.Recorder) (*tenants.Registry, error) {
+	configured, err := tenants.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return tenants.New(configured,
+		tenants.WithExemptions(auth.DefaultExemptions...),
+		tenants.WithExemptions("/"+adminpb.AdminService_ServiceDesc.ServiceName+"/"),
+		tenants.WithRecorder(rec))
+}
+
+// fatal logs msg at error level and exits, like log.Fatal
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// multiplex sends gRPC requests to grpcServer and every other request to
+// web, so both can share one port. gRPC is recognized by HTTP/2 and its
+// application/grpc content type.
+func multiplex(grpcServer *grpc.Server, web http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		web.ServeHTTP(w, r)
+	})
+}
+
+// newMultiplexServer returns an HTTP server speaking HTTP/1.1 and cleartext
+// HTTP/2 (h2c), which gRPC clients use without TLS
+func newMultiplexServer(h http.Handler) *http.Server {
+	var protocols http.Protocols
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	return &http.Server{Handler: h, Protocols: &protocols, ReadHeaderTimeout: 5 * time.Second}
+}
+
+// healthz answers 200 while the server reports SERVING and 503 otherwise,
+// for platforms that only probe over plain HTTP
+func healthz(hs *health.Server) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := hs.Check(r.Context(), &healthpb.HealthCheckRequest{})
+		if err != nil || resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			http.Error(w, "NOT_SERVING", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "SERVING")
+	}
+}
+
+// statusPage describes the running server in plain text
+func statusPage(s *grpc.Server, backend string, started time.Time, activeStreams func() int64) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		services := make([]string, 0)
+		for name := range s.GetServiceInfo() {
+			services = append(services, name)
+		}
+		sort.Strings(services)
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintf(w, "greeter-server %s\n\n", version)
+		fmt.Fprintf(w, "instance:       %s\n", backend)
+		fmt.Fprintf(w, "uptime:         %s\n", time.Since(started).Round(time.Second))
+		fmt.Fprintf(w, "active streams: %d\n", activeStreams())
+		fmt.Fprintf(w, "\ngRPC services:\n")
+		for _, name := range services {
+			fmt.Fprintf(w, "  %s\n", name)
+		}
+		fmt.Fprintf(w, "\nHTTP: /healthz, /metrics, /v1/... (REST gateway)\n")
+	}
+}
+
+// stopMultiplexed waits for in-flight requests, gRPC streams included, to
+// finish before closing the gRPC server. GracefulStop can't be used: it
+// can't drain connections served through ServeHTTP.
+func stopMultiplexed(ctx context.Context, srv *http.Server, grpcServer *grpc.Server) error {
+	err := srv.Shutdown(ctx)
+	if err != nil {
+		srv.Close()
+	}
+	grpcServer.Stop()
+	return err
+}
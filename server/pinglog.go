@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// http2Preface is what every HTTP/2 client sends before its first frame
+const http2Preface = "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n"
+
+// pingLogger wraps transport credentials so every accepted connection logs
+// the HTTP/2 PING frames flowing over it, making keepalive (and gRPC's own
+// bandwidth probing) pings visible. It sees the plaintext HTTP/2 stream, so
+// it works over TLS too.
+type pingLogger struct {
+	credentials.TransportCredentials
+}
+
+func (p pingLogger) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn, info, err := p.TransportCredentials.ServerHandshake(conn)
+	if err != nil {
+		return nil, nil, err
+	}
+	peer := conn.RemoteAddr().String()
+	return &pingConn{
+		Conn: conn,
+		in: frameSniffer{skip: len(http2Preface), onPing: func(ack bool) {
+			if ack {
+				slog.Info("🏓 ping ack received", "peer", peer)
+			} else {
+				slog.Info("🏓 ping received", "peer", peer)
+			}
+		}},
+		out: frameSniffer{onPing: func(ack bool) {
+			if ack {
+				slog.Info("🏓 ping ack sent", "peer", peer)
+			} else {
+				slog.Info("🏓 ping sent", "peer", peer)
+			}
+		}},
+	}, info, nil
+}
+
+func (p pingLogger) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return p.TransportCredentials.ClientHandshake(ctx, authority, conn)
+}
+
+func (p pingLogger) Clone() credentials.TransportCredentials {
+	return pingLogger{p.TransportCredentials.Clone()}
+}
+
+// pingConn feeds everything read and written through a frame sniffer.
+// gRPC reads and writes on separate goroutines, so each direction has its
+// own.
+type pingConn struct {
+	net.Conn
+	in, out frameSniffer
+}
+
+func (c *pingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.in.feed(b[:n])
+	return n, err
+}
+
+func (c *pingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.out.feed(b[:n])
+	return n, err
+}
+
+// frameSniffer follows the HTTP/2 frame boundaries of one direction of a
+// connection and reports PING frames
+type frameSniffer struct {
+	skip    int // bytes of the connection preface still to pass over
+	header  [9]byte
+	have    int // bytes of the current frame header collected so far
+	payload int // bytes of the current frame payload still to pass over
+	onPing  func(ack bool)
+}
+
+func (f *frameSniffer) feed(p []byte) {
+	const (
+		framePing = 0x6
+		flagAck   = 0x1
+	)
+	for len(p) > 0 {
+		switch {
+		case f.skip > 0:
+			n := min(f.skip, len(p))
+			f.skip -= n
+			p = p[n:]
+		case f.payload > 0:
+			n := min(f.payload, len(p))
+			f.payload -= n
+			p = p[n:]
+		default:
+			n := copy(f.header[f.have:], p)
+			f.have += n
+			p = p[n:]
+			if f.have == len(f.header) {
+				f.have = 0
+				f.payload = int(f.header[0])<<16 | int(f.header[1])<<8 | int(f.header[2])
+				if f.header[3] == framePing {
+					f.onPing(f.header[4]&flagAck != 0)
+				}
+			}
+		}
+	}
+}
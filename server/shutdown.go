@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+)
+
+type shutdownHook struct {
+	name string
+	fn   func(context.Context) error
+}
+
+// ShutdownManager runs cleanup callbacks when the server stops. Hooks run
+// in reverse registration order (like defer) and share one deadline; a
+// failing hook is logged and does not stop the remaining ones.
+type ShutdownManager struct {
+	mu    sync.Mutex
+	hooks []shutdownHook
+}
+
+// Register adds a named cleanup callback
+func (m *ShutdownManager) Register(name string, fn func(context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, shutdownHook{name: name, fn: fn})
+}
+
+// Run invokes every registered hook, last registered first, and returns
+// the combined errors
+func (m *ShutdownManager) Run(ctx context.Context) error {
+	m.mu.Lock()
+	hooks := append([]shutdownHook(nil), m.hooks...)
+	m.mu.Unlock()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hook := hooks[i]
+		start := time.Now()
+		err := hook.fn(ctx)
+		if err != nil {
+			slog.Error("Shutdown hook failed", "hook", hook.name, "after", time.Since(start), logging.Err(err))
+			errs = append(errs, fmt.Errorf("%s: %w", hook.name, err))
+			continue
+		}
+		slog.Info("Shutdown hook finished", "hook", hook.name, "took", time.Since(start))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestShutdownManagerRunsHooksLastFirst(t *testing.T) {
+	var logs bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	var ran []string
+	failed := errors.New("flush failed")
+	var m ShutdownManager
+	m.Register("store", func(context.Context) error {
+		ran = append(ran, "store")
+		return nil
+	})
+	m.Register("metrics", func(context.Context) error {
+		ran = append(ran, "metrics")
+		return failed
+	})
+
+	err := m.Run(context.Background())
+	if got := strings.Join(ran, ","); got != "metrics,store" {
+		t.Errorf("hooks ran in order %s, want metrics,store", got)
+	}
+	if !errors.Is(err, failed) || !strings.Contains(err.Error(), "metrics") {
+		t.Errorf("Run = %v, want the metrics hook's error", err)
+	}
+	if !strings.Contains(logs.String(), `msg="Shutdown hook failed" hook=metrics`) {
+		t.Errorf("the failing hook wasn't logged:\n%s", logs.String())
+	}
+	if !strings.Contains(logs.String(), `msg="Shutdown hook finished" hook=store`) {
+		t.Errorf("the hook after the failing one didn't finish:\n%s", logs.String())
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"log/slog"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/certs"
+	"google.golang.org/grpc/credentials"
+)
+
+// serverCredentials loads the server's TLS credentials, first generating
+// self-signed certificates into generateDir when it is set
+func serverCredentials(certFile, keyFile, clientCAFile, generateDir string) (credentials.TransportCredentials, error) {
+	if generateDir != "" {
+		generated, err := certs.GenerateSelfSigned(generateDir, "localhost", "127.0.0.1", "::1")
+		if err != nil {
+			return nil, err
+		}
+		certFile, keyFile = generated.ServerCertFile, generated.ServerKeyFile
+		slog.Info("🔐 Generated self-signed certificates", "dir", generateDir, "ca", generated.CAFile, "client_cert", generated.ClientCertFile)
+	}
+
+	cfg, err := certs.ServerConfig(certFile, keyFile, clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	if clientCAFile != "" {
+		slog.Info("🔐 Mutual TLS enabled; client certificates must be signed by the CA", "ca", clientCAFile)
+	}
+	return credentials.NewTLS(cfg), nil
+}
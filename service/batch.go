@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultBatchConcurrency is how many names of a SayHelloBatch are
+	// greeted at once unless WithBatchConcurrency says otherwise
+	DefaultBatchConcurrency = 8
+
+	// batchDeadlineReserve is the time SayHelloBatch keeps in hand before
+	// the call's deadline to send its response; names not started by then
+	// are skipped
+	batchDeadlineReserve = 50 * time.Millisecond
+)
+
+// WithBatchConcurrency sets how many names of a SayHelloBatch are greeted
+// at once
+func WithBatchConcurrency(n int) Option {
+	return func(s *Server) {
+		s.batchConcurrency = n
+	}
+}
+
+// SayHelloBatch implements the batch RPC method. The call itself only fails
+// if the request as a whole is invalid; every name gets a result of its
+// own.
+func (s *Server) SayHelloBatch(ctx context.Context, req *pb.SayHelloBatchRequest) (*pb.SayHelloBatchResponse, error) {
+	results := make([]*pb.HelloResult, len(req.GetNames()))
+	var g errgroup.Group
+	g.SetLimit(max(s.batchConcurrency, 1))
+	for i, name := range req.GetNames() {
+		g.Go(func() error {
+			results[i] = s.greetBatchEntry(ctx, name, req.GetLanguage())
+			return nil
+		})
+	}
+	g.Wait()
+
+	resp := &pb.SayHelloBatchResponse{Results: results}
+	for _, r := range results {
+		if r.GetError() != nil {
+			resp.Failed++
+		}
+	}
+	slog.InfoContext(ctx, "Greeted a batch", "names", len(results), "failed", resp.Failed)
+	return resp, nil
+}
+
+// greetBatchEntry greets one name of a batch as SayHello would, unless the
+// call's deadline is too close to start
+func (s *Server) greetBatchEntry(ctx context.Context, name, language string) *pb.HelloResult {
+	result := &pb.HelloResult{Name: name}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < batchDeadlineReserve {
+		st := status.New(codes.DeadlineExceeded, "not greeted: the call's deadline was too close")
+		result.Result = &pb.HelloResult_Error{Error: st.Proto()}
+		return result
+	}
+	resp, err := s.greet(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: name}, Language: language})
+	if err != nil {
+		result.Result = &pb.HelloResult_Error{Error: status.Convert(err).Proto()}
+		return result
+	}
+	result.Result = &pb.HelloResult_Response{Response: resp}
+	return result
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"sync"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultBrokerBuffer is how many events a subscriber may fall behind by
+// before the broker starts dropping its events
+const DefaultBrokerBuffer = 64
+
+// Broker fans greeting events out to every subscriber of the tenant they
+// were made for. Each subscriber has its own buffered channel; when a slow
+// subscriber's buffer is full its events are dropped and counted rather
+// than holding up SayHello or the other subscribers, and the count goes
+// out with the next event it gets.
+type Broker struct {
+	mu          sync.Mutex
+	buffer      int
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+// Subscription receives the events a Broker publishes
+type Subscription struct {
+	events chan *pb.GreetingEvent
+	// tenant is whose greetings the subscription gets
+	tenant string
+	// name, when set, limits the subscription to greetings to that name
+	name string
+
+	// missed counts events dropped since the last one delivered; guarded
+	// by the broker's mutex
+	missed int64
+}
+
+// Events returns the channel events arrive on; it is closed when the
+// broker shuts down
+func (s *Subscription) Events() <-chan *pb.GreetingEvent {
+	return s.events
+}
+
+// NewBroker creates a Broker buffering up to buffer events per subscriber
+func NewBroker(buffer int) *Broker {
+	return &Broker{
+		buffer:      buffer,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe registers a subscriber for the greetings made for tenant to
+// name, or to everyone when name is empty. Call the returned function to
+// unsubscribe.
+func (b *Broker) Subscribe(tenant, name string) (*Subscription, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &Subscription{events: make(chan *pb.GreetingEvent, b.buffer), tenant: tenant, name: name}
+	if b.closed {
+		close(sub.events)
+		return sub, func() {}
+	}
+	b.subscribers[sub] = struct{}{}
+
+	return sub, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers, sub)
+	}
+}
+
+// Publish hands e, a greeting made for tenant, to every matching
+// subscriber without blocking. Each subscriber gets its own copy, carrying
+// its missed count.
+func (b *Broker) Publish(tenant string, e *pb.GreetingEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers {
+		if sub.tenant != tenant || (sub.name != "" && sub.name != e.GetName()) {
+			continue
+		}
+		event := &pb.GreetingEvent{
+			Name:      e.GetName(),
+			Message:   e.GetMessage(),
+			Count:     e.GetCount(),
+			Language:  e.GetLanguage(),
+			GreetedAt: e.GetGreetedAt(),
+			Missed:    sub.missed,
+		}
+		select {
+		case sub.events <- event:
+			sub.missed = 0
+		default:
+			sub.missed++
+		}
+	}
+}
+
+// Subscribers returns the number of current subscribers
+func (b *Broker) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
+
+// Close ends every subscription and refuses new ones, so subscribers
+// don't hold up a graceful shutdown
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for sub := range b.subscribers {
+		close(sub.events)
+		delete(b.subscribers, sub)
+	}
+}
+
+// WithBroker sets the broker SayHello publishes to and SubscribeGreetings
+// reads from. The default is a broker of its own, so pass one to close
+// subscriptions on shutdown.
+func WithBroker(b *Broker) Option {
+	return func(s *Server) {
+		s.broker = b
+	}
+}
+
+// SubscribeGreetings implements the server streaming RPC pushing every
+// SayHello greeting made for the call's tenant to the client as it happens
+func (s *Server) SubscribeGreetings(req *pb.SubscribeGreetingsRequest, stream pb.GreetingService_SubscribeGreetingsServer) error {
+	sub, unsubscribe := s.broker.Subscribe(requestcontext.TenantID(stream.Context()), req.GetName())
+	defer unsubscribe()
+
+	// Send the headers right away so the client knows it is subscribed
+	// before the first greeting
+	if err := stream.SendHeader(nil); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return contextError(stream.Context())
+		case event, ok := <-sub.Events():
+			if !ok {
+				return status.Error(codes.Unavailable, "server is shutting down; subscribe again")
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
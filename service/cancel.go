@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// contextError returns the gRPC status for a finished ctx: Canceled when the
+// client went away, DeadlineExceeded when its deadline passed. It returns
+// nil while ctx is still live.
+func contextError(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
+
+// sleep pauses for d, returning early with the context's status when the
+// call is canceled or its deadline passes
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return contextError(ctx)
+	}
+}
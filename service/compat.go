@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+)
+
+// The v1 SayHello, SayHelloMultiple and ResumeStream are a compatibility
+// shim over the v2 API: requests are translated up to v2, served by
+// ServerV2 and the responses translated back down. v1 clients thus get
+// whatever v2 does, and the fields v1 lacks (recipient, greeted_at) are
+// simply dropped.
+
+// SayHello implements the simple RPC method
+func (s *Server) SayHello(ctx context.Context, req *pb.HelloRequest) (*pb.HelloResponse, error) {
+	resp, err := s.V2().SayHello(ctx, toV2Request(req))
+	if err != nil {
+		return nil, err
+	}
+	return conditional(ctx, toV1Response(resp)), nil
+}
+
+// SayHelloMultiple implements the server streaming RPC method. It is
+// StreamGreetings in v2.
+func (s *Server) SayHelloMultiple(req *pb.HelloRequest, stream pb.GreetingService_SayHelloMultipleServer) error {
+	return s.V2().streamGreetings(stream.Context(), toV2Request(req), func(resp *pbv2.SayHelloResponse) error {
+		return stream.Send(toV1Response(resp))
+	})
+}
+
+// ResumeStream implements the stream resumption RPC. It is ResumeGreetings
+// in v2.
+func (s *Server) ResumeStream(req *pb.ResumeStreamRequest, stream pb.GreetingService_ResumeStreamServer) error {
+	return s.V2().resumeGreetings(stream.Context(), req.GetResumeToken(), func(resp *pbv2.SayHelloResponse) error {
+		return stream.Send(toV1Response(resp))
+	})
+}
+
+func toV2Request(req *pb.HelloRequest) *pbv2.SayHelloRequest {
+	v2req := &pbv2.SayHelloRequest{Count: req.GetCount(), IntervalMs: req.GetIntervalMs(), Language: req.GetLanguage()}
+	switch id := req.GetIdentity().(type) {
+	case *pb.HelloRequest_UserId:
+		v2req.Identity = &pbv2.SayHelloRequest_UserId{UserId: id.UserId}
+	case *pb.HelloRequest_Name:
+		v2req.Identity = &pbv2.SayHelloRequest_Name{Name: id.Name}
+	}
+	return v2req
+}
+
+func toV1Response(resp *pbv2.SayHelloResponse) *pb.HelloResponse {
+	g := resp.GetGreeting()
+	return &pb.HelloResponse{
+		Message:     g.GetMessage(),
+		Count:       g.GetCount(),
+		Salutation:  g.GetSalutation(),
+		Subject:     g.GetSubject(),
+		Punctuation: g.GetPunctuation(),
+		Language:    g.GetLanguage(),
+		ResumeToken: resp.GetResumeToken(),
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// GreetingHashHeader is the response header carrying a hash of the
+	// SayHello response, like an HTTP ETag
+	GreetingHashHeader = "greeting-hash"
+
+	// IfNoneMatchHeader is the request header carrying the greeting hash of
+	// a response the client has cached
+	IfNoneMatchHeader = "if-none-match"
+)
+
+// greetingHash returns a stable hash of the response content. The count is
+// left out: it grows with every call, while the greeting itself doesn't.
+func greetingHash(resp *pb.HelloResponse) string {
+	content := proto.Clone(resp).(*pb.HelloResponse)
+	content.Count = 0
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(content)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// conditional sends the greeting hash header and replaces resp with a
+// not_modified response when the client already has it cached. That keeps
+// the current count, which the hash leaves out.
+func conditional(ctx context.Context, resp *pb.HelloResponse) *pb.HelloResponse {
+	hash := greetingHash(resp)
+	grpc.SetHeader(ctx, metadata.Pairs(GreetingHashHeader, hash))
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, known := range md.Get(IfNoneMatchHeader) {
+		if known == hash {
+			return &pb.HelloResponse{NotModified: true, Count: resp.GetCount()}
+		}
+	}
+	return resp
+}
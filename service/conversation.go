@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/sessions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WithSessions keeps conversations in m rather than in a Manager of the
+// default TTL and size
+func WithSessions(m *sessions.Manager) Option {
+	return func(s *Server) {
+		s.sessions = m
+	}
+}
+
+// StartConversation implements the RPC starting a conversation
+func (s *Server) StartConversation(ctx context.Context, req *pb.StartConversationRequest) (*pb.ConversationResponse, error) {
+	session, err := s.sessions.Start(requestcontext.TenantID(ctx), req.GetName(), req.GetLanguage(), func(session *sessions.Session) error {
+		return s.greetTurn(ctx, session)
+	})
+	if err != nil {
+		return nil, sessionError(err)
+	}
+	slog.InfoContext(ctx, "Started a conversation", "name", session.Name, "sessions", s.sessions.Len())
+	return conversationResponse(session), nil
+}
+
+// ContinueConversation implements the RPC greeting again within a
+// conversation. Concurrent calls for one conversation take turns.
+func (s *Server) ContinueConversation(ctx context.Context, req *pb.ContinueConversationRequest) (*pb.ConversationResponse, error) {
+	session, err := s.sessions.Update(requestcontext.TenantID(ctx), req.GetSessionToken(), func(session *sessions.Session) error {
+		if req.GetLanguage() != "" {
+			session.Language = req.GetLanguage()
+		}
+		return s.greetTurn(ctx, session)
+	})
+	if err != nil {
+		return nil, sessionError(err)
+	}
+	slog.InfoContext(ctx, "Continued a conversation", "name", session.Name, "turn", session.Turns)
+	return conversationResponse(session), nil
+}
+
+// EndConversation implements the RPC ending a conversation
+func (s *Server) EndConversation(ctx context.Context, req *pb.EndConversationRequest) (*pb.EndConversationResponse, error) {
+	session, err := s.sessions.End(requestcontext.TenantID(ctx), req.GetSessionToken())
+	if err != nil {
+		return nil, sessionError(err)
+	}
+	slog.InfoContext(ctx, "Ended a conversation", "name", session.Name, "turns", session.Turns)
+	return &pb.EndConversationResponse{Turns: int32(session.Turns)}, nil
+}
+
+// greetTurn greets session's name as SayHello would, in the session's
+// language, and records the greeting as its next turn
+func (s *Server) greetTurn(ctx context.Context, session *sessions.Session) error {
+	resp, err := s.greet(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: session.Name}, Language: session.Language})
+	if err != nil {
+		return err
+	}
+	session.Greeted(resp.GetMessage())
+	return nil
+}
+
+func conversationResponse(session sessions.Session) *pb.ConversationResponse {
+	return &pb.ConversationResponse{
+		SessionToken: session.Token,
+		Message:      session.History[len(session.History)-1],
+		Turn:         int32(session.Turns),
+		History:      session.History,
+		Language:     session.Language,
+		ExpiresAt:    timestamppb.New(session.ExpiresAt),
+	}
+}
+
+// sessionError maps a sessions error to its gRPC status, passing statuses
+// from the greeting through
+func sessionError(err error) error {
+	switch {
+	case errors.Is(err, sessions.ErrNotFound):
+		return status.Error(codes.NotFound, "conversation not found: the session token is unknown, ended or expired")
+	case errors.Is(err, sessions.ErrFull):
+		return status.Error(codes.ResourceExhausted, "server busy: too many conversations in progress")
+	}
+	return err
+}
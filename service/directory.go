@@ -0,0 +1,53 @@
+package service
+
+import (
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultDirectory seeds the user id lookup used for id-based greetings
+var DefaultDirectory = map[int64]string{
+	1: "Alice",
+	2: "Bob",
+	3: "Charlie",
+}
+
+// WithDirectory replaces the user id to name mapping used to resolve
+// requests that identify the caller by user_id
+func WithDirectory(dir map[int64]string) Option {
+	return func(s *Server) {
+		s.directory = copyDirectory(dir)
+	}
+}
+
+func copyDirectory(dir map[int64]string) map[int64]string {
+	c := make(map[int64]string, len(dir))
+	for id, name := range dir {
+		c[id] = name
+	}
+	return c
+}
+
+// resolve validates req and returns a copy identified by name, looking the
+// name up in the directory when the request carries a user_id
+func (s *Server) resolve(req *pb.HelloRequest) (*pb.HelloRequest, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	id, ok := req.GetIdentity().(*pb.HelloRequest_UserId)
+	if !ok {
+		return req, nil
+	}
+
+	name, found := s.directory[id.UserId]
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no user with id %d", id.UserId)
+	}
+
+	resolved := proto.Clone(req).(*pb.HelloRequest)
+	resolved.Identity = &pb.HelloRequest_Name{Name: name}
+	return resolved, nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDirectory(t *testing.T) {
+	s := New(WithDirectory(map[int64]string{7: "Grace"}))
+
+	tests := []struct {
+		desc    string
+		req     *pb.HelloRequest
+		want    string
+		wantErr codes.Code
+	}{
+		{"by name", &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}, "Hello, Alice!", codes.OK},
+		{"by known id", &pb.HelloRequest{Identity: &pb.HelloRequest_UserId{UserId: 7}}, "Hello, Grace!", codes.OK},
+		{"by unknown id", &pb.HelloRequest{Identity: &pb.HelloRequest_UserId{UserId: 8}}, "", codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			resp, err := s.SayHello(context.Background(), tt.req)
+			if got := status.Code(err); got != tt.wantErr {
+				t.Fatalf("SayHello: code = %v, want %v (%v)", got, tt.wantErr, err)
+			}
+			if got := resp.GetMessage(); got != tt.want {
+				t.Errorf("message = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithDirectoryCopies(t *testing.T) {
+	dir := map[int64]string{7: "Grace"}
+	s := New(WithDirectory(dir))
+	dir[7] = "Mallory"
+
+	resp, err := s.SayHello(context.Background(), &pb.HelloRequest{Identity: &pb.HelloRequest_UserId{UserId: 7}})
+	if err != nil {
+		t.Fatalf("SayHello: %v", err)
+	}
+	if got, want := resp.GetMessage(), "Hello, Grace!"; got != want {
+		t.Errorf("message = %q, want %q: changing the caller's map changed the directory", got, want)
+	}
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/events"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+)
+
+// WithEvents publishes a GreetingCreated event through b for every
+// SayHello that succeeds, v1 or v2
+func WithEvents(b *events.Bridge) Option {
+	return func(s *Server) {
+		s.events = b
+	}
+}
+
+// publishGreeting tells the subscribers and the event bridge, if any, about
+// a greeting
+func (s *Server) publishGreeting(ctx context.Context, e *pb.GreetingEvent) {
+	s.broker.Publish(requestcontext.TenantID(ctx), e)
+	if s.events == nil {
+		return
+	}
+	s.events.GreetingCreated(ctx, events.GreetingCreated{
+		Name:      e.GetName(),
+		Message:   e.GetMessage(),
+		Count:     e.GetCount(),
+		Language:  e.GetLanguage(),
+		TenantID:  requestcontext.TenantID(ctx),
+		UserID:    requestcontext.UserID(ctx),
+		RequestID: logging.RequestID(ctx),
+		GreetedAt: e.GetGreetedAt().AsTime(),
+	})
+}
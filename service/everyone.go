@@ -0,0 +1,119 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+)
+
+// SayHelloToEveryone implements the client streaming RPC method
+func (s *Server) SayHelloToEveryone(stream pb.GreetingService_SayHelloToEveryoneServer) error {
+	var names []string
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			// The client has sent everyone; reply once with the summary
+			message := greetEveryone(names)
+			slog.InfoContext(stream.Context(), "Greeting everyone", "names", strings.Join(names, ", "))
+			for _, name := range names {
+				if _, err := s.record(stream.Context(), name, message); err != nil {
+					return err
+				}
+			}
+			return stream.SendAndClose(&pb.HelloResponse{
+				Message: message,
+				Count:   int32(len(names)),
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		req, err = s.resolve(req)
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(stream.Context(), "Received client streaming request", "name", req.GetName())
+		names = append(names, req.GetName())
+	}
+}
+
+// greetEveryone renders one greeting for a list of names,
+// e.g. "Hello, Alice, Bob and Carol!"
+func greetEveryone(names []string) string {
+	switch len(names) {
+	case 0:
+		return "Hello, nobody!"
+	case 1:
+		return fmt.Sprintf("Hello, %s!", names[0])
+	default:
+		return fmt.Sprintf("Hello, %s and %s!", strings.Join(names[:len(names)-1], ", "), names[len(names)-1])
+	}
+}
+
+// GreetEveryone implements the bidirectional streaming RPC method. Requests
+// are read on their own goroutine so greetings go out as soon as each one
+// arrives, independently of what the client sends next.
+func (s *Server) GreetEveryone(stream pb.GreetingService_GreetEveryoneServer) error {
+	ctx := stream.Context()
+	requests := make(chan *pb.HelloRequest)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			// Close requests only on a clean end of stream: closed alongside
+			// recvErr, a select could pick either and report a broken stream
+			// as OK
+			if err == io.EOF {
+				close(requests)
+				return
+			}
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	count := int32(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return contextError(ctx)
+		case err := <-recvErr:
+			return err
+		case req, ok := <-requests:
+			if !ok {
+				// The client closed its side of the stream
+				return nil
+			}
+
+			req, err := s.resolve(req)
+			if err != nil {
+				return err
+			}
+			slog.InfoContext(ctx, "Received bidi streaming request", "name", req.GetName())
+
+			count++
+			message := fmt.Sprintf("Hello, %s! You are guest #%d", req.GetName(), count)
+			if _, err := s.record(ctx, req.GetName(), message); err != nil {
+				return err
+			}
+			if err := stream.Send(&pb.HelloResponse{
+				Message: message,
+				Count:   count,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// brokenEveryoneStream is a GreetEveryone stream that sends one name, then
+// breaks with err. Sending the greeting waits for the break, so the handler
+// only looks for the next request once the stream has failed.
+type brokenEveryoneStream struct {
+	grpc.ServerStream
+	err    error
+	recvs  int
+	broken chan struct{}
+}
+
+func (s *brokenEveryoneStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *brokenEveryoneStream) Recv() (*pb.HelloRequest, error) {
+	s.recvs++
+	if s.recvs == 1 {
+		return &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}, nil
+	}
+	close(s.broken)
+	return nil, s.err
+}
+
+func (s *brokenEveryoneStream) Send(*pb.HelloResponse) error {
+	<-s.broken
+	// Let the reading goroutine finish with the error
+	time.Sleep(time.Millisecond)
+	return nil
+}
+
+func TestGreetEveryoneRecvError(t *testing.T) {
+	s := New()
+	broken := status.Error(codes.Unavailable, "connection reset")
+	for range 50 {
+		stream := &brokenEveryoneStream{err: broken, broken: make(chan struct{})}
+		if err := s.GreetEveryone(stream); err != broken {
+			t.Fatalf("GreetEveryone = %v, want the Recv error", err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/store"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// WithStore sets where greetings are recorded. The default keeps them in
+// memory, so the history is lost when the server stops.
+func WithStore(st store.Store) Option {
+	return func(s *Server) {
+		s.store = st
+	}
+}
+
+// record adds a greeting to the history of the call's tenant and returns
+// how many times name has now been greeted for it
+func (s *Server) record(ctx context.Context, name, message string) (int64, error) {
+	g := store.Greeting{Tenant: requestcontext.TenantID(ctx), Name: name, Message: message, GreetedAt: time.Now()}
+	count, err := s.store.Add(ctx, g)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "record greeting: %v", err)
+	}
+	return count, nil
+}
+
+// ListGreetings implements the greeting history RPC, streaming one page of
+// the greetings made for the call's tenant
+func (s *Server) ListGreetings(req *pb.ListGreetingsRequest, stream pb.GreetingService_ListGreetingsServer) error {
+	ctx := stream.Context()
+
+	size := int(req.GetPageSize())
+	switch {
+	case size < 0:
+		return badRequest([]*errdetails.BadRequest_FieldViolation{{Field: "page_size", Description: "must not be negative"}})
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+	after, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return badRequest([]*errdetails.BadRequest_FieldViolation{{Field: "page_token", Description: "must be a token returned by ListGreetings"}})
+	}
+
+	// Fetch one extra greeting to learn whether another page follows
+	greetings, err := s.store.List(ctx, store.Query{Tenant: requestcontext.TenantID(ctx), Name: req.GetName(), AfterID: after, Limit: size + 1})
+	if err != nil {
+		return status.Errorf(codes.Internal, "read greeting history: %v", err)
+	}
+	more := len(greetings) > size
+	if more {
+		greetings = greetings[:size]
+	}
+
+	for i, g := range greetings {
+		resp := &pb.ListGreetingsResponse{Greeting: &pb.GreetingRecord{
+			Id:        g.ID,
+			Name:      g.Name,
+			Message:   g.Message,
+			GreetedAt: timestamppb.New(g.GreetedAt),
+		}}
+		if more && i == len(greetings)-1 {
+			resp.NextPageToken = encodePageToken(g.ID)
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetGreetingCount implements the greeting counter RPC, counting the
+// greetings made for the call's tenant
+func (s *Server) GetGreetingCount(ctx context.Context, req *pb.GetGreetingCountRequest) (*pb.GetGreetingCountResponse, error) {
+	stat, err := s.store.Stats(ctx, requestcontext.TenantID(ctx), req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read greeting history: %v", err)
+	}
+	return &pb.GetGreetingCountResponse{Name: req.GetName(), Count: stat.Count}, nil
+}
+
+// Page tokens are opaque to clients; they wrap the ID of the last greeting
+// sent so the next page starts after it
+func encodePageToken(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(b), 10, 64)
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListSupportedLanguages implements the language listing RPC
+func (s *Server) ListSupportedLanguages(ctx context.Context, req *pb.ListSupportedLanguagesRequest) (*pb.ListSupportedLanguagesResponse, error) {
+	lister, ok := s.provider.(LanguageLister)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the greeting provider does not report its languages")
+	}
+
+	resp := &pb.ListSupportedLanguagesResponse{}
+	for _, c := range lister.SupportedLanguages() {
+		resp.Languages = append(resp.Languages, &pb.Language{
+			Code:       c.Tag.String(),
+			Name:       c.Name,
+			NativeName: c.NativeName,
+		})
+	}
+	return resp, nil
+}
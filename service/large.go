@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+)
+
+// DefaultLargeChunk is the payload bytes per StreamHelloLarge message when
+// the request doesn't say
+const DefaultLargeChunk = 64 << 10
+
+// SayHelloLarge implements the single-message large greeting RPC
+func (s *Server) SayHelloLarge(ctx context.Context, req *pb.SayHelloLargeRequest) (*pb.SayHelloLargeResponse, error) {
+	slog.InfoContext(ctx, "Received large request", "name", req.GetName(), "size", req.GetSizeBytes())
+	return &pb.SayHelloLargeResponse{
+		Message: largeMessage(req),
+		Payload: largePayload(req.GetName(), 0, req.GetSizeBytes()),
+	}, nil
+}
+
+// StreamHelloLarge implements the chunked large greeting RPC
+func (s *Server) StreamHelloLarge(req *pb.SayHelloLargeRequest, stream pb.GreetingService_StreamHelloLargeServer) error {
+	ctx := stream.Context()
+	chunk := int64(req.GetChunkBytes())
+	if chunk == 0 {
+		chunk = DefaultLargeChunk
+	}
+	slog.InfoContext(ctx, "Received large streaming request", "name", req.GetName(), "size", req.GetSizeBytes(), "chunk", chunk)
+
+	size := req.GetSizeBytes()
+	resp := &pb.SayHelloLargeResponse{Message: largeMessage(req)}
+	for offset := int64(0); offset == 0 || offset < size; offset += chunk {
+		if err := contextError(ctx); err != nil {
+			return err
+		}
+		resp.Offset = offset
+		resp.Payload = largePayload(req.GetName(), offset, min(chunk, size-offset))
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		resp.Message = ""
+	}
+	return nil
+}
+
+func largeMessage(req *pb.SayHelloLargeRequest) string {
+	return fmt.Sprintf("Hello, %s! Here are %d bytes for you", req.GetName(), req.GetSizeBytes())
+}
+
+// largePayload returns n bytes of the payload starting at offset: the
+// greeting repeated over and over, so chunks join into the one payload
+// SayHelloLarge sends
+func largePayload(name string, offset, n int64) []byte {
+	pattern := []byte("Hello, " + name + "! ")
+	payload := make([]byte, max(n, 0))
+	for i := range payload {
+		payload[i] = pattern[(offset+int64(i))%int64(len(pattern))]
+	}
+	return payload
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"strings"
+	"sync"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LogBuffer is an io.Writer that keeps the most recent log lines in a ring
+// buffer and fans new lines out to subscribers. Use it as (part of) the
+// output of the logger to make server logs available to StreamLogs.
+type LogBuffer struct {
+	mu          sync.Mutex
+	lines       []string
+	next        int
+	full        bool
+	subscribers map[chan string]struct{}
+}
+
+// NewLogBuffer creates a LogBuffer holding up to size lines, and at least
+// one
+func NewLogBuffer(size int) *LogBuffer {
+	return &LogBuffer{
+		lines:       make([]string, max(size, 1)),
+		subscribers: make(map[chan string]struct{}),
+	}
+}
+
+// Write implements io.Writer
+func (b *LogBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		b.lines[b.next] = line
+		b.next = (b.next + 1) % len(b.lines)
+		if b.next == 0 {
+			b.full = true
+		}
+
+		for ch := range b.subscribers {
+			select {
+			case ch <- line:
+			default:
+				// Drop lines for subscribers that can't keep up rather
+				// than blocking the logger
+			}
+		}
+	}
+	return len(p), nil
+}
+
+// Subscribe returns up to tail buffered lines (all of them when tail <= 0)
+// and a channel receiving every line written afterwards. Call the returned
+// cancel function to stop receiving.
+func (b *LogBuffer) Subscribe(tail int) ([]string, <-chan string, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	recent := b.recent()
+	if tail > 0 && tail < len(recent) {
+		recent = recent[len(recent)-tail:]
+	}
+
+	ch := make(chan string, 64)
+	b.subscribers[ch] = struct{}{}
+
+	cancel := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers, ch)
+	}
+	return recent, ch, cancel
+}
+
+func (b *LogBuffer) recent() []string {
+	if !b.full {
+		return append([]string(nil), b.lines[:b.next]...)
+	}
+	return append(append([]string(nil), b.lines[b.next:]...), b.lines[:b.next]...)
+}
+
+// WithLogStream enables the StreamLogs RPC, serving lines from buf
+func WithLogStream(buf *LogBuffer) Option {
+	return func(s *Server) {
+		s.logs = buf
+	}
+}
+
+// StreamLogs implements the server streaming RPC that tails server logs
+func (s *Server) StreamLogs(req *pb.StreamLogsRequest, stream pb.GreetingService_StreamLogsServer) error {
+	if s.logs == nil {
+		return status.Error(codes.PermissionDenied, "log streaming is disabled; start the server with -debug")
+	}
+
+	recent, lines, cancel := s.logs.Subscribe(int(req.GetTail()))
+	defer cancel()
+
+	for _, line := range recent {
+		if err := stream.Send(&pb.LogLine{Line: line}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return contextError(stream.Context())
+		case line := <-lines:
+			if err := stream.Send(&pb.LogLine{Line: line}); err != nil {
+				return err
+			}
+		}
+	}
+}
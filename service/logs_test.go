@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+	"google.golang.org/grpc"
+)
+
+func TestStreamLogsStreamsAccessLog(t *testing.T) {
+	logs := service.NewLogBuffer(100)
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	ts := greetertest.StartTestServer(t,
+		greetertest.WithServiceOptions(service.WithLogStream(logs)),
+		greetertest.WithServerOptions(grpc.ChainUnaryInterceptor(interceptors.UnaryServerLogging())))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// The tail holds this line, so receiving it means the stream has
+	// subscribed and sees what is logged next
+	slog.Info("before the call")
+	stream, err := ts.Client.StreamLogs(ctx, &pb.StreamLogsRequest{Tail: 1})
+	if err != nil {
+		t.Fatalf("StreamLogs: %v", err)
+	}
+	if line, err := stream.Recv(); err != nil || !strings.Contains(line.GetLine(), "before the call") {
+		t.Fatalf("first line = %q, %v, want the tail", line.GetLine(), err)
+	}
+
+	if _, err := ts.Client.SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}); err != nil {
+		t.Fatalf("SayHello: %v", err)
+	}
+	for {
+		line, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("no access log line for SayHello before %v", err)
+		}
+		if strings.Contains(line.GetLine(), "msg=access") && strings.Contains(line.GetLine(), "method="+pb.GreetingService_SayHello_FullMethodName) {
+			if !strings.Contains(line.GetLine(), "code=OK") {
+				t.Errorf("access log line = %q, want code=OK", line.GetLine())
+			}
+			return
+		}
+	}
+}
+
+func TestLogBufferOfNoSize(t *testing.T) {
+	logs := service.NewLogBuffer(0)
+	logs.Write([]byte("first\nsecond\n"))
+	recent, _, cancel := logs.Subscribe(0)
+	defer cancel()
+	if len(recent) != 1 || recent[0] != "second" {
+		t.Errorf("recent = %q, want the last line only", recent)
+	}
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetNameStats implements the per-name statistics RPC over the greetings
+// made for the call's tenant
+func (s *Server) GetNameStats(ctx context.Context, req *pb.NameStatsRequest) (*pb.NameStatsResponse, error) {
+	stat, err := s.store.Stats(ctx, requestcontext.TenantID(ctx), req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read greeting history: %v", err)
+	}
+	if stat.Count == 0 {
+		return nil, status.Errorf(codes.NotFound, "%q has never been greeted", req.GetName())
+	}
+
+	return &pb.NameStatsResponse{
+		Name:           req.GetName(),
+		GreetCount:     stat.Count,
+		FirstGreetedAt: timestamppb.New(stat.First),
+		LastGreetedAt:  timestamppb.New(stat.Last),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetNameStats(t *testing.T) {
+	s := New()
+	ctx := context.Background()
+	before := time.Now()
+	for range 2 {
+		if _, err := s.SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}); err != nil {
+			t.Fatalf("SayHello: %v", err)
+		}
+		// Keep the two greetings' timestamps apart
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	stats, err := s.GetNameStats(ctx, &pb.NameStatsRequest{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("GetNameStats: %v", err)
+	}
+	if stats.GetGreetCount() != 2 {
+		t.Errorf("greet count = %d, want 2", stats.GetGreetCount())
+	}
+	first, last := stats.GetFirstGreetedAt().AsTime(), stats.GetLastGreetedAt().AsTime()
+	if first.Before(before) || !last.After(first) || last.After(time.Now()) {
+		t.Errorf("first, last greeted at = %s, %s, want both since %s and first before last", first, last, before)
+	}
+}
+
+func TestGetNameStatsNeverGreeted(t *testing.T) {
+	_, err := New().GetNameStats(context.Background(), &pb.NameStatsRequest{Name: "Nobody"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetNameStats = %v, want NotFound", err)
+	}
+}
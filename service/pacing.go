@@ -0,0 +1,74 @@
+package service
+
+import (
+	"time"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/workers"
+)
+
+const (
+	// defaultStreamCount is how many greetings SayHelloMultiple sends when
+	// the request doesn't say
+	defaultStreamCount = 5
+
+	// DefaultMaxStreamCount and DefaultMaxStreamInterval are the limits
+	// applied unless WithStreamLimits says otherwise
+	DefaultMaxStreamCount    = 100
+	DefaultMaxStreamInterval = 10 * time.Second
+)
+
+// WithStreamDelay sets the pause between SayHelloMultiple messages
+func WithStreamDelay(d time.Duration) Option {
+	return func(s *Server) {
+		s.streamDelay = d
+	}
+}
+
+// WithStreamRampUp sends the first n streaming messages with shorter gaps
+// that grow linearly up to the stream delay, avoiding an initial burst at
+// full pace. Zero disables the ramp.
+func WithStreamRampUp(n int) Option {
+	return func(s *Server) {
+		s.streamRampUp = n
+	}
+}
+
+// WithStreamLimits caps the count and interval_ms a client may ask
+// SayHelloMultiple for; larger requests fail with InvalidArgument
+func WithStreamLimits(maxCount int, maxInterval time.Duration) Option {
+	return func(s *Server) {
+		s.maxStreamCount = maxCount
+		s.maxStreamInterval = maxInterval
+	}
+}
+
+// streamPlan returns how many greetings to stream for req and the delay
+// they are paced at, falling back to the server's defaults
+func (s *Server) streamPlan(req *pb.HelloRequest) (int, time.Duration) {
+	count, delay := defaultStreamCount, s.streamDelay
+	if n := req.GetCount(); n > 0 {
+		count = int(n)
+	}
+	if ms := req.GetIntervalMs(); ms > 0 {
+		delay = time.Duration(ms) * time.Millisecond
+	}
+	return count, delay
+}
+
+// streamGap returns the pause after the i-th (1-based) streaming message
+// sent at delay
+func (s *Server) streamGap(i int, delay time.Duration) time.Duration {
+	if i > s.streamRampUp {
+		return delay
+	}
+	return delay * time.Duration(i) / time.Duration(s.streamRampUp+1)
+}
+
+// WithWorkers generates SayHelloMultiple greetings on p, bounding how many
+// are made at once; streams finding its queue full fail with Unavailable
+func WithWorkers(p *workers.Pool) Option {
+	return func(s *Server) {
+		s.workers = p
+	}
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStreamGapRampsUp(t *testing.T) {
+	s := New(WithStreamRampUp(3))
+	delay := time.Second
+
+	var gaps []time.Duration
+	for i := 1; i <= 6; i++ {
+		gaps = append(gaps, s.streamGap(i, delay))
+	}
+	for i := 1; i < 3; i++ {
+		if gaps[i] <= gaps[i-1] {
+			t.Errorf("gap after message %d = %s, want longer than the one before, %s", i+1, gaps[i], gaps[i-1])
+		}
+	}
+	for i, gap := range gaps {
+		if i < 3 && gap >= delay {
+			t.Errorf("ramped gap after message %d = %s, want shorter than the delay %s", i+1, gap, delay)
+		}
+		if i >= 3 && gap != delay {
+			t.Errorf("gap after message %d = %s, want the full delay %s", i+1, gap, delay)
+		}
+	}
+}
+
+func TestStreamGapWithoutRamp(t *testing.T) {
+	s := New()
+	for i := 1; i <= 3; i++ {
+		if gap := s.streamGap(i, time.Second); gap != time.Second {
+			t.Errorf("gap after message %d = %s, want the delay without a ramp", i, gap)
+		}
+	}
+}
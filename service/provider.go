@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greeter"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/tenants"
+)
+
+// GreetingProvider builds the greeting message returned by SayHello.
+// Implement it to plug in custom logic such as a database lookup or a call
+// to another service.
+type GreetingProvider interface {
+	Greet(ctx context.Context, req *pb.HelloRequest) (string, error)
+}
+
+// ComponentProvider is implemented by providers that can also return the
+// structured parts of a greeting. SayHello fills the salutation, subject
+// and punctuation response fields when the configured provider supports it.
+type ComponentProvider interface {
+	GreetComponents(ctx context.Context, req *pb.HelloRequest) (Greeting, error)
+}
+
+// LanguageLister is implemented by providers that greet in several
+// languages, so ListSupportedLanguages can report them
+type LanguageLister interface {
+	SupportedLanguages() []greeter.Catalog
+}
+
+// Greeting is a greeting split into its parts
+type Greeting struct {
+	Salutation string
+	// Separator joins salutation and subject; ", " when empty
+	Separator   string
+	Subject     string
+	Punctuation string
+	// Language is the BCP 47 tag of the greeting's language, if known
+	Language string
+}
+
+// String renders the full greeting message
+func (g Greeting) String() string {
+	return g.Salutation + g.separator() + g.Subject + g.Punctuation
+}
+
+// separator returns the separator the greeting is joined with
+func (g Greeting) separator() string {
+	if g.Separator == "" {
+		return ", "
+	}
+	return g.Separator
+}
+
+// DefaultProvider renders the built-in greeting in the caller's language:
+// the request's language field, else the locale header, else the tenant's
+// language, negotiated against Registry (greeter.Default when nil). A
+// tenant's own greeting replaces the language's.
+type DefaultProvider struct {
+	Registry *greeter.Registry
+}
+
+// Greet implements GreetingProvider
+func (p DefaultProvider) Greet(ctx context.Context, req *pb.HelloRequest) (string, error) {
+	g, err := p.GreetComponents(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return g.String(), nil
+}
+
+// GreetComponents implements ComponentProvider
+func (p DefaultProvider) GreetComponents(ctx context.Context, req *pb.HelloRequest) (Greeting, error) {
+	preferences := []string{req.GetLanguage(), metadata.FromIncoming(ctx).Locale}
+	tenant, ok := tenants.FromContext(ctx)
+	if ok {
+		preferences = append(preferences, tenant.Language)
+	}
+	c := p.registry().Match(preferences...)
+	parts := c.Greet(req.GetName())
+	if ok && tenant.Greeting != "" {
+		parts = tenant.Greet(req.GetName())
+	}
+	return Greeting{
+		Salutation:  parts.Salutation,
+		Separator:   parts.Separator,
+		Subject:     parts.Subject,
+		Punctuation: parts.Punctuation,
+		Language:    c.Tag.String(),
+	}, nil
+}
+
+// SupportedLanguages implements LanguageLister
+func (p DefaultProvider) SupportedLanguages() []greeter.Catalog {
+	return p.registry().Catalogs()
+}
+
+func (p DefaultProvider) registry() *greeter.Registry {
+	if p.Registry == nil {
+		return greeter.Default
+	}
+	return p.Registry
+}
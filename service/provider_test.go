@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/templates"
+)
+
+// fixedProvider greets everyone the same way
+type fixedProvider string
+
+func (p fixedProvider) Greet(context.Context, *pb.HelloRequest) (string, error) {
+	return string(p), nil
+}
+
+func TestWithProvider(t *testing.T) {
+	s := New(WithProvider(fixedProvider("Ahoy there!")))
+
+	resp, err := s.SayHello(context.Background(), &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}})
+	if err != nil {
+		t.Fatalf("SayHello: %v", err)
+	}
+	if got, want := resp.GetMessage(), "Ahoy there!"; got != want {
+		t.Errorf("message = %q, want %q", got, want)
+	}
+	// The stub can't split its greeting up, so the parts stay empty
+	if resp.GetSalutation() != "" || resp.GetSubject() != "" {
+		t.Errorf("components = %q, %q, want none from a plain provider", resp.GetSalutation(), resp.GetSubject())
+	}
+}
+
+func TestGreetingComponents(t *testing.T) {
+	s := New()
+	for _, c := range (DefaultProvider{}).SupportedLanguages() {
+		language := c.Tag.String()
+		t.Run(language, func(t *testing.T) {
+			req := &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}, Language: language}
+			resp, err := s.SayHello(context.Background(), req)
+			if err != nil {
+				t.Fatalf("SayHello: %v", err)
+			}
+			g, err := DefaultProvider{}.GreetComponents(context.Background(), req)
+			if err != nil {
+				t.Fatalf("GreetComponents: %v", err)
+			}
+			// Some languages add an honorific, as in "Aliceさん"
+			if !strings.Contains(resp.GetSubject(), "Alice") {
+				t.Errorf("subject = %q, want it to name Alice", resp.GetSubject())
+			}
+			joined := resp.GetSalutation() + g.separator() + resp.GetSubject() + resp.GetPunctuation()
+			if joined != resp.GetMessage() || g.String() != resp.GetMessage() {
+				t.Errorf("components join to %q and %q, want the message %q", joined, g.String(), resp.GetMessage())
+			}
+		})
+	}
+}
+
+func TestGreetingComponentsWithTemplate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		template  string
+		message   string
+		wantParts bool
+	}{
+		{"rewriting the message", `{{upper .Name}}{{.Punctuation}}`, "ALICE!", false},
+		{"keeping the message", `{{.Message}}`, "Hello, Alice!", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "greeting.tmpl"), []byte(tt.template), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			e, err := templates.Load(dir)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			s := New(WithTemplates(e))
+
+			resp, err := s.SayHello(context.Background(), &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}, Language: "en"})
+			if err != nil {
+				t.Fatalf("SayHello: %v", err)
+			}
+			if resp.GetMessage() != tt.message {
+				t.Errorf("message = %q, want %q", resp.GetMessage(), tt.message)
+			}
+			hasParts := resp.GetSalutation() != "" || resp.GetSubject() != "" || resp.GetPunctuation() != ""
+			if hasParts != tt.wantParts {
+				t.Errorf("parts = %q, %q, %q, want parts: %t", resp.GetSalutation(), resp.GetSubject(), resp.GetPunctuation(), tt.wantParts)
+			}
+			if resp.GetLanguage() != "en" {
+				t.Errorf("language = %q, want en whatever the template", resp.GetLanguage())
+			}
+		})
+	}
+}
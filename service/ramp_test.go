@@ -0,0 +1,51 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/service"
+)
+
+func TestStreamRampUpShortensEarlyGaps(t *testing.T) {
+	const delay = 100 * time.Millisecond
+	ts := greetertest.StartTestServer(t, greetertest.WithServiceOptions(
+		service.WithStreamDelay(delay),
+		service.WithStreamRampUp(3)))
+
+	stream, err := ts.Client.SayHelloMultiple(context.Background(), &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}, Count: 6})
+	if err != nil {
+		t.Fatalf("SayHelloMultiple: %v", err)
+	}
+	var received []time.Time
+	for {
+		if _, err := stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		received = append(received, time.Now())
+	}
+	if len(received) != 6 {
+		t.Fatalf("received %d messages, want 6", len(received))
+	}
+
+	var gaps []time.Duration
+	for i := 1; i < len(received); i++ {
+		gaps = append(gaps, received[i].Sub(received[i-1]))
+	}
+	// The ramp's first two gaps are a quarter and a half of the delay, and
+	// the last two are the full delay; the margin absorbs scheduling noise
+	for _, early := range gaps[:2] {
+		for _, late := range gaps[3:] {
+			if early > late*3/4 {
+				t.Errorf("gaps between messages = %v, want the early ones clearly shorter than the late ones", gaps)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// resumeCursor is what a resume token holds: the stream's request, with the
+// count and interval it was planned with so a resumed stream carries on
+// where it was, and how many greetings had been sent. Tokens are not
+// signed; a resumed stream is checked like a new one, so a forged token
+// gets nothing a request couldn't.
+type resumeCursor struct {
+	Name       string `json:"name,omitempty"`
+	UserID     int64  `json:"user_id,omitempty"`
+	Count      int32  `json:"count"`
+	IntervalMS int32  `json:"interval_ms"`
+	Language   string `json:"language,omitempty"`
+	Sent       int    `json:"sent"`
+}
+
+// encodeResumeToken returns the token resuming req's stream after its
+// sent-th greeting; req holds the planned count and interval
+func encodeResumeToken(req *pb.HelloRequest, sent int) string {
+	b, _ := json.Marshal(resumeCursor{
+		Name:       req.GetName(),
+		UserID:     req.GetUserId(),
+		Count:      req.GetCount(),
+		IntervalMS: req.GetIntervalMs(),
+		Language:   req.GetLanguage(),
+		Sent:       sent,
+	})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeResumeToken returns the request of the stream token resumes and
+// how many of its greetings were sent, or InvalidArgument
+func decodeResumeToken(token string) (*pb.HelloRequest, int, error) {
+	invalid := func(reason string) error {
+		return badRequest([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "resume_token",
+			Description: fmt.Sprintf("must be a token returned by a streamed greeting: %s", reason),
+		}})
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, 0, invalid("not base64")
+	}
+	var c resumeCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, 0, invalid("malformed")
+	}
+	if c.Count <= 0 || c.Sent < 0 || c.Sent > int(c.Count) {
+		return nil, 0, invalid(fmt.Sprintf("%d of %d greetings sent", c.Sent, c.Count))
+	}
+
+	req := &pb.HelloRequest{Count: c.Count, IntervalMs: c.IntervalMS, Language: c.Language}
+	if c.UserID != 0 {
+		req.Identity = &pb.HelloRequest_UserId{UserId: c.UserID}
+	} else {
+		req.Identity = &pb.HelloRequest_Name{Name: c.Name}
+	}
+	return req, c.Sent, nil
+}
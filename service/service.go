@@ -0,0 +1,240 @@
+// Package service contains the GreetingService implementation so it can be
+// embedded in other binaries and customized without forking the server.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/events"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/sessions"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/store"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/templates"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/workers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements the GreetingService
+type Server struct {
+	pb.UnimplementedGreetingServiceServer
+
+	provider     GreetingProvider
+	templates    *templates.Engine
+	directory    map[int64]string
+	logs         *LogBuffer
+	streamDelay  time.Duration
+	streamRampUp int
+
+	maxStreamCount    int
+	maxStreamInterval time.Duration
+	batchConcurrency  int
+
+	queue    *interceptors.AdmissionQueue
+	workers  *workers.Pool
+	store    store.Store
+	broker   *Broker
+	events   *events.Bridge
+	sessions *sessions.Manager
+
+	uploadDir     string
+	maxUploadSize int64
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithProvider sets the GreetingProvider used to build SayHello messages
+func WithProvider(p GreetingProvider) Option {
+	return func(s *Server) {
+		s.provider = p
+	}
+}
+
+// WithTemplates renders SayHello and SayHelloMultiple messages with e
+// instead of the built-in templates
+func WithTemplates(e *templates.Engine) Option {
+	return func(s *Server) {
+		s.templates = e
+	}
+}
+
+// New creates a Server with the default provider unless overridden by opts
+func New(opts ...Option) *Server {
+	s := &Server{
+		provider:    DefaultProvider{},
+		templates:   templates.New(),
+		directory:   copyDirectory(DefaultDirectory),
+		store:       store.NewMemory(),
+		broker:      NewBroker(DefaultBrokerBuffer),
+		sessions:    sessions.New(sessions.DefaultTTL, sessions.DefaultMaxSessions),
+		streamDelay: 1 * time.Second,
+
+		maxStreamCount:    DefaultMaxStreamCount,
+		maxStreamInterval: DefaultMaxStreamInterval,
+		batchConcurrency:  DefaultBatchConcurrency,
+
+		uploadDir:     DefaultUploadDir(),
+		maxUploadSize: DefaultMaxUploadSize,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// greet holds the SayHello business logic shared by every API version
+func (s *Server) greet(ctx context.Context, req *pb.HelloRequest) (*pb.HelloResponse, error) {
+	// Don't bother greeting a client that has already given up
+	if err := contextError(ctx); err != nil {
+		return nil, err
+	}
+	req, err := s.resolve(req)
+	if err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "Received request", "name", req.GetName())
+
+	// Create response, including the greeting's parts when the provider
+	// can supply them, with the message rendered by the Greeting template
+	response := &pb.HelloResponse{}
+	data := s.templateData(ctx, req.GetName())
+	if cp, ok := s.provider.(ComponentProvider); ok {
+		g, err := cp.GreetComponents(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		response.Salutation = g.Salutation
+		response.Subject = g.Subject
+		response.Punctuation = g.Punctuation
+		response.Language = g.Language
+		data.Message, data.Salutation, data.Separator = g.String(), g.Salutation, g.separator()
+		data.Subject, data.Punctuation, data.Language = g.Subject, g.Punctuation, g.Language
+	} else {
+		message, err := s.provider.Greet(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		data.Message = message
+	}
+	message, err := s.templates.Render(templates.Greeting, data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "render greeting: %v", err)
+	}
+	response.Message = message
+	// A custom Greeting template can rewrite the message, and the provider's
+	// parts join to the provider's message only, so they are left out then
+	if message != data.Message {
+		response.Salutation, response.Subject, response.Punctuation = "", "", ""
+	}
+
+	count, err := s.record(ctx, req.GetName(), response.Message)
+	if err != nil {
+		return nil, err
+	}
+	response.Count = int32(count)
+
+	s.publishGreeting(ctx, &pb.GreetingEvent{
+		Name:      req.GetName(),
+		Message:   response.Message,
+		Count:     response.Count,
+		Language:  response.Language,
+		GreetedAt: timestamppb.Now(),
+	})
+	return response, nil
+}
+
+// streamGreetings holds the SayHelloMultiple business logic shared by every
+// API version, handing each response to send. It stops as soon as ctx is
+// done so nothing is sent to a client that has gone away.
+func (s *Server) streamGreetings(ctx context.Context, req *pb.HelloRequest, send func(*pb.HelloResponse) error) error {
+	return s.streamFrom(ctx, req, 0, send)
+}
+
+// streamFrom streams req's greetings after the first sent ones, which a
+// resumed stream has already delivered. Every response carries the token
+// resuming the stream after it.
+func (s *Server) streamFrom(ctx context.Context, req *pb.HelloRequest, sent int, send func(*pb.HelloResponse) error) error {
+	resolved, err := s.resolve(req)
+	if err != nil {
+		return err
+	}
+	if err := s.validateStream(resolved); err != nil {
+		return err
+	}
+	count, delay := s.streamPlan(resolved)
+	if sent == 0 {
+		slog.InfoContext(ctx, "Received streaming request", "name", resolved.GetName(), "count", count, "interval", delay)
+	} else {
+		slog.InfoContext(ctx, "Resuming streaming request", "name", resolved.GetName(), "count", count, "interval", delay, "sent", sent)
+	}
+
+	// Pin the plan in the resume tokens, so resuming on a server with
+	// other defaults carries on the same stream
+	cursor := proto.Clone(req).(*pb.HelloRequest)
+	cursor.Count, cursor.IntervalMs = int32(count), int32(delay.Milliseconds())
+
+	// Send the greetings with a delay
+	for i := sent + 1; i <= count; i++ {
+		response, err := s.streamGreeting(ctx, resolved, i, count, delay)
+		if err != nil {
+			slog.InfoContext(ctx, "Stopped streaming", "name", resolved.GetName(), "sent", i-1, logging.Err(err))
+			return err
+		}
+		response.ResumeToken = encodeResumeToken(cursor, i)
+
+		if err := send(response); err != nil {
+			return err
+		}
+
+		slog.InfoContext(ctx, "Sent streaming response", "name", resolved.GetName(), "n", i)
+	}
+
+	return nil
+}
+
+// templateData returns what templates render for a greeting to name, apart
+// from the greeting itself
+func (s *Server) templateData(ctx context.Context, name string) templates.Data {
+	return templates.Data{Name: name, Tenant: requestcontext.TenantID(ctx), Time: time.Now()}
+}
+
+// streamGreeting builds the i-th of count streamed greetings after the
+// processing time the pacing calls for. With a worker pool the work runs
+// there, so only as many greetings as there are workers are made at once.
+func (s *Server) streamGreeting(ctx context.Context, req *pb.HelloRequest, i, count int, delay time.Duration) (*pb.HelloResponse, error) {
+	var response *pb.HelloResponse
+	generate := func(ctx context.Context) error {
+		// Simulate some processing time
+		if i > 1 {
+			if err := sleep(ctx, s.streamGap(i-1, delay)); err != nil {
+				return err
+			}
+		}
+		data := s.templateData(ctx, req.GetName())
+		data.N, data.Total = i, count
+		message, err := s.templates.Render(templates.Stream, data)
+		if err != nil {
+			return status.Errorf(codes.Internal, "render streamed greeting: %v", err)
+		}
+		response = &pb.HelloResponse{Message: message, Count: int32(i)}
+		return nil
+	}
+	var err error
+	if s.workers != nil {
+		err = s.workers.Do(ctx, generate)
+	} else {
+		err = generate(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
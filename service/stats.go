@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/interceptors"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+)
+
+// WithAdmissionQueue reports q's depth and rejections through GetStats
+func WithAdmissionQueue(q *interceptors.AdmissionQueue) Option {
+	return func(s *Server) {
+		s.queue = q
+	}
+}
+
+// GetStats implements the server load RPC
+func (s *Server) GetStats(ctx context.Context, req *pb.StatsRequest) (*pb.StatsResponse, error) {
+	resp := &pb.StatsResponse{}
+	if s.queue != nil {
+		resp.QueueDepth = int32(s.queue.Depth())
+		resp.QueueCapacity = int32(s.queue.Capacity())
+		resp.InFlight = int32(s.queue.InFlight())
+		resp.Rejected = s.queue.Rejected()
+	}
+	return resp, nil
+}
@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startTenantServer starts a test server whose calls carry the tenant of
+// the caller's context
+func startTenantServer(t *testing.T) *greetertest.TestServer {
+	return greetertest.StartTestServer(t,
+		greetertest.WithServerOptions(
+			grpc.UnaryInterceptor(requestcontext.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(requestcontext.StreamServerInterceptor())),
+		greetertest.WithDialOptions(
+			grpc.WithUnaryInterceptor(requestcontext.UnaryClientInterceptor()),
+			grpc.WithStreamInterceptor(requestcontext.StreamClientInterceptor())))
+}
+
+// listGreetings returns the names of the greetings ListGreetings lists
+func listGreetings(ctx context.Context, t *testing.T, client pb.GreetingServiceClient) []string {
+	t.Helper()
+	stream, err := client.ListGreetings(ctx, &pb.ListGreetingsRequest{})
+	if err != nil {
+		t.Fatalf("ListGreetings: %v", err)
+	}
+	var names []string
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return names
+		}
+		if err != nil {
+			t.Fatalf("ListGreetings: %v", err)
+		}
+		names = append(names, resp.GetGreeting().GetName())
+	}
+}
+
+func TestTenantsKeepTheirOwnHistory(t *testing.T) {
+	ts := startTenantServer(t)
+	acme := requestcontext.WithTenantID(context.Background(), "acme")
+	globex := requestcontext.WithTenantID(context.Background(), "globex")
+	greet := func(ctx context.Context, name string) int32 {
+		t.Helper()
+		resp, err := ts.Client.SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: name}})
+		if err != nil {
+			t.Fatalf("SayHello %s: %v", name, err)
+		}
+		return resp.GetCount()
+	}
+
+	greet(acme, "Alice")
+	greet(acme, "Alice")
+	greet(acme, "Bob")
+	if count := greet(globex, "Alice"); count != 1 {
+		t.Errorf("globex's first greeting to Alice has count %d, want 1", count)
+	}
+
+	tests := []struct {
+		tenant string
+		ctx    context.Context
+		alice  int64
+		names  []string
+	}{
+		{"acme", acme, 2, []string{"Alice", "Alice", "Bob"}},
+		{"globex", globex, 1, []string{"Alice"}},
+	}
+	for _, tt := range tests {
+		count, err := ts.Client.GetGreetingCount(tt.ctx, &pb.GetGreetingCountRequest{Name: "Alice"})
+		if err != nil {
+			t.Fatalf("GetGreetingCount for %s: %v", tt.tenant, err)
+		}
+		if count.GetCount() != tt.alice {
+			t.Errorf("%s's count for Alice = %d, want %d", tt.tenant, count.GetCount(), tt.alice)
+		}
+		stats, err := ts.Client.GetNameStats(tt.ctx, &pb.NameStatsRequest{Name: "Alice"})
+		if err != nil {
+			t.Fatalf("GetNameStats for %s: %v", tt.tenant, err)
+		}
+		if stats.GetGreetCount() != tt.alice {
+			t.Errorf("%s's stats for Alice count %d, want %d", tt.tenant, stats.GetGreetCount(), tt.alice)
+		}
+		if got := listGreetings(tt.ctx, t, ts.Client); len(got) != len(tt.names) {
+			t.Errorf("%s's history = %q, want %q", tt.tenant, got, tt.names)
+		}
+	}
+
+	// globex never greeted Bob
+	if _, err := ts.Client.GetNameStats(globex, &pb.NameStatsRequest{Name: "Bob"}); status.Code(err) != codes.NotFound {
+		t.Errorf("globex's stats for acme's Bob = %v, want NotFound", err)
+	}
+}
+
+func TestTenantsGetTheirOwnEvents(t *testing.T) {
+	ts := startTenantServer(t)
+	acme := requestcontext.WithTenantID(context.Background(), "acme")
+	ctx, cancel := context.WithCancel(requestcontext.WithTenantID(context.Background(), "globex"))
+	defer cancel()
+
+	stream, err := ts.Client.SubscribeGreetings(ctx, &pb.SubscribeGreetingsRequest{})
+	if err != nil {
+		t.Fatalf("SubscribeGreetings: %v", err)
+	}
+	// The headers come once the subscription is in place
+	if _, err := stream.Header(); err != nil {
+		t.Fatalf("SubscribeGreetings headers: %v", err)
+	}
+
+	if _, err := ts.Client.SayHello(acme, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}}); err != nil {
+		t.Fatalf("SayHello for acme: %v", err)
+	}
+	if _, err := ts.Client.SayHello(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Bob"}}); err != nil {
+		t.Fatalf("SayHello for globex: %v", err)
+	}
+
+	// Events arrive in order, so acme's would come first
+	event, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	if event.GetName() != "Bob" || event.GetMissed() != 0 {
+		t.Errorf("globex's first event = %v, want its own greeting to Bob", event)
+	}
+}
+
+func TestTenantsKeepTheirOwnConversations(t *testing.T) {
+	ts := startTenantServer(t)
+	acme := requestcontext.WithTenantID(context.Background(), "acme")
+	globex := requestcontext.WithTenantID(context.Background(), "globex")
+
+	started, err := ts.Client.StartConversation(acme, &pb.StartConversationRequest{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("StartConversation: %v", err)
+	}
+	token := started.GetSessionToken()
+
+	if _, err := ts.Client.ContinueConversation(globex, &pb.ContinueConversationRequest{SessionToken: token}); status.Code(err) != codes.NotFound {
+		t.Errorf("globex continuing acme's conversation = %v, want NotFound", err)
+	}
+	if _, err := ts.Client.EndConversation(globex, &pb.EndConversationRequest{SessionToken: token}); status.Code(err) != codes.NotFound {
+		t.Errorf("globex ending acme's conversation = %v, want NotFound", err)
+	}
+	resp, err := ts.Client.ContinueConversation(acme, &pb.ContinueConversationRequest{SessionToken: token})
+	if err != nil {
+		t.Fatalf("acme continuing its conversation: %v", err)
+	}
+	if resp.GetTurn() != 2 {
+		t.Errorf("turn = %d, want 2", resp.GetTurn())
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultMaxUploadSize is the largest document UploadDocument accepts
+// unless WithUploads says otherwise
+const DefaultMaxUploadSize = 32 << 20
+
+// DefaultUploadDir is where UploadDocument stores documents unless
+// WithUploads says otherwise
+func DefaultUploadDir() string {
+	return filepath.Join(os.TempDir(), "greeter-uploads")
+}
+
+// WithUploads stores uploaded documents in dir, refusing any larger than
+// maxSize bytes
+func WithUploads(dir string, maxSize int64) Option {
+	return func(s *Server) {
+		s.uploadDir = dir
+		s.maxUploadSize = maxSize
+	}
+}
+
+// UploadDocument implements the client streaming upload RPC. Chunks are
+// written to a temporary file as they arrive, so a document is never held
+// in memory whole, and the file only gets its final name once every byte
+// is in.
+func (s *Server) UploadDocument(stream pb.GreetingService_UploadDocumentServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "empty upload: send the document info first")
+	}
+	if err != nil {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "the first message of an upload must carry the document info")
+	}
+	name := filepath.Base(info.GetFilename())
+	if name == "." || name == ".." || name == string(filepath.Separator) {
+		return status.Errorf(codes.InvalidArgument, "invalid filename %q", info.GetFilename())
+	}
+	if info.GetSizeBytes() > s.maxUploadSize {
+		return status.Errorf(codes.ResourceExhausted, "%s is %d bytes, over the %d byte upload limit", name, info.GetSizeBytes(), s.maxUploadSize)
+	}
+	slog.InfoContext(ctx, "Receiving document", "filename", name, "size", info.GetSizeBytes(), "content_type", info.GetContentType())
+
+	if err := os.MkdirAll(s.uploadDir, 0o755); err != nil {
+		return status.Errorf(codes.Internal, "creating the upload directory: %v", err)
+	}
+	tmp, err := os.CreateTemp(s.uploadDir, ".upload-*")
+	if err != nil {
+		return status.Errorf(codes.Internal, "creating the upload file: %v", err)
+	}
+	// Until it is renamed, the file is only a partial upload to throw away
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	w := io.MultiWriter(tmp, hash)
+	var size int64
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.GetInfo() != nil {
+			return status.Error(codes.InvalidArgument, "the document info may only be sent first")
+		}
+		chunk := req.GetChunk()
+		if size += int64(len(chunk)); size > s.maxUploadSize {
+			return status.Errorf(codes.ResourceExhausted, "%s exceeds the %d byte upload limit", name, s.maxUploadSize)
+		}
+		if _, err := w.Write(chunk); err != nil {
+			return status.Errorf(codes.Internal, "writing the upload file: %v", err)
+		}
+	}
+	if want := info.GetSizeBytes(); want > 0 && size != want {
+		return status.Errorf(codes.InvalidArgument, "received %d bytes of %s, announced %d", size, name, want)
+	}
+
+	id, err := documentID()
+	if err != nil {
+		return status.Errorf(codes.Internal, "naming the document: %v", err)
+	}
+	path := filepath.Join(s.uploadDir, id+"-"+name)
+	if err := errors.Join(tmp.Close(), os.Rename(tmp.Name(), path)); err != nil {
+		return status.Errorf(codes.Internal, "storing the document: %v", err)
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	slog.InfoContext(ctx, "📄 Stored document", "id", id, "path", path, "size", size, "sha256", sum)
+	return stream.SendAndClose(&pb.UploadDocumentResponse{Id: id, SizeBytes: size, Sha256: sum})
+}
+
+// documentID returns a random id for a stored document
+func documentID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
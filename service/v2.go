@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ServerV2 implements GreetingServiceV2 on top of the same business logic as
+// the v1 Server, so both versions return consistent greetings
+type ServerV2 struct {
+	pbv2.UnimplementedGreetingServiceV2Server
+
+	core *Server
+}
+
+// V2 returns a GreetingServiceV2 implementation sharing s's configuration
+func (s *Server) V2() *ServerV2 {
+	return &ServerV2{core: s}
+}
+
+// SayHello implements the v2 simple RPC method
+func (s *ServerV2) SayHello(ctx context.Context, req *pbv2.SayHelloRequest) (*pbv2.SayHelloResponse, error) {
+	v1req, source := fromV2Request(req)
+	resolved, err := s.core.resolve(v1req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.core.greet(ctx, resolved)
+	if err != nil {
+		return nil, err
+	}
+	return toV2Response(resp, resolved.GetName(), source), nil
+}
+
+// StreamGreetings implements the v2 server streaming RPC method
+func (s *ServerV2) StreamGreetings(req *pbv2.SayHelloRequest, stream pbv2.GreetingServiceV2_StreamGreetingsServer) error {
+	return s.streamGreetings(stream.Context(), req, stream.Send)
+}
+
+// streamGreetings holds StreamGreetings apart from its stream, so the v1
+// SayHelloMultiple can send the greetings its own way
+func (s *ServerV2) streamGreetings(ctx context.Context, req *pbv2.SayHelloRequest, send func(*pbv2.SayHelloResponse) error) error {
+	v1req, _ := fromV2Request(req)
+	return s.streamFrom(ctx, v1req, 0, send)
+}
+
+// ResumeGreetings implements the v2 stream resumption RPC
+func (s *ServerV2) ResumeGreetings(req *pbv2.ResumeGreetingsRequest, stream pbv2.GreetingServiceV2_ResumeGreetingsServer) error {
+	return s.resumeGreetings(stream.Context(), req.GetResumeToken(), stream.Send)
+}
+
+// resumeGreetings holds ResumeGreetings apart from its stream, so the v1
+// ResumeStream can send the greetings its own way
+func (s *ServerV2) resumeGreetings(ctx context.Context, token string, send func(*pbv2.SayHelloResponse) error) error {
+	v1req, sent, err := decodeResumeToken(token)
+	if err != nil {
+		return err
+	}
+	return s.streamFrom(ctx, v1req, sent, send)
+}
+
+// streamFrom streams v1req's greetings after the first sent ones
+func (s *ServerV2) streamFrom(ctx context.Context, v1req *pb.HelloRequest, sent int, send func(*pbv2.SayHelloResponse) error) error {
+	resolved, err := s.core.resolve(v1req)
+	if err != nil {
+		return err
+	}
+	source := pbv2.IdentitySource_IDENTITY_SOURCE_NAME
+	if v1req.GetUserId() != 0 {
+		source = pbv2.IdentitySource_IDENTITY_SOURCE_DIRECTORY
+	}
+
+	return s.core.streamFrom(ctx, v1req, sent, func(resp *pb.HelloResponse) error {
+		return send(toV2Response(resp, resolved.GetName(), source))
+	})
+}
+
+func fromV2Request(req *pbv2.SayHelloRequest) (*pb.HelloRequest, pbv2.IdentitySource) {
+	v1req := &pb.HelloRequest{Count: req.GetCount(), IntervalMs: req.GetIntervalMs(), Language: req.GetLanguage()}
+	switch id := req.GetIdentity().(type) {
+	case *pbv2.SayHelloRequest_UserId:
+		v1req.Identity = &pb.HelloRequest_UserId{UserId: id.UserId}
+		return v1req, pbv2.IdentitySource_IDENTITY_SOURCE_DIRECTORY
+	default:
+		v1req.Identity = &pb.HelloRequest_Name{Name: req.GetName()}
+		return v1req, pbv2.IdentitySource_IDENTITY_SOURCE_NAME
+	}
+}
+
+func toV2Response(resp *pb.HelloResponse, name string, source pbv2.IdentitySource) *pbv2.SayHelloResponse {
+	return &pbv2.SayHelloResponse{
+		Greeting: &pbv2.SayHelloResponse_Greeting{
+			Message:     resp.GetMessage(),
+			Count:       resp.GetCount(),
+			Salutation:  resp.GetSalutation(),
+			Subject:     resp.GetSubject(),
+			Punctuation: resp.GetPunctuation(),
+			Language:    resp.GetLanguage(),
+		},
+		Recipient: &pbv2.SayHelloResponse_Recipient{
+			Name:   name,
+			Source: source,
+		},
+		GreetedAt:   timestamppb.Now(),
+		ResumeToken: resp.GetResumeToken(),
+	}
+}
@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greetertest"
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	pbv2 "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v2"
+)
+
+func TestV1AndV2Agree(t *testing.T) {
+	ts := greetertest.StartTestServer(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		desc string
+		v1   *pb.HelloRequest
+		v2   *pbv2.SayHelloRequest
+		// source is where v2 says the name came from
+		source pbv2.IdentitySource
+	}{
+		{
+			"by name",
+			&pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Alice"}, Language: "fr"},
+			&pbv2.SayHelloRequest{Identity: &pbv2.SayHelloRequest_Name{Name: "Alice"}, Language: "fr"},
+			pbv2.IdentitySource_IDENTITY_SOURCE_NAME,
+		},
+		{
+			"by user id",
+			&pb.HelloRequest{Identity: &pb.HelloRequest_UserId{UserId: 2}},
+			&pbv2.SayHelloRequest{Identity: &pbv2.SayHelloRequest_UserId{UserId: 2}},
+			pbv2.IdentitySource_IDENTITY_SOURCE_DIRECTORY,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			v1, err := ts.Client.SayHello(ctx, tt.v1)
+			if err != nil {
+				t.Fatalf("v1 SayHello: %v", err)
+			}
+			v2, err := ts.ClientV2.SayHello(ctx, tt.v2)
+			if err != nil {
+				t.Fatalf("v2 SayHello: %v", err)
+			}
+			g := v2.GetGreeting()
+			if g.GetMessage() != v1.GetMessage() || g.GetSalutation() != v1.GetSalutation() || g.GetLanguage() != v1.GetLanguage() {
+				t.Errorf("v2 greeting %q (%s) differs from v1's %q (%s)", g.GetMessage(), g.GetLanguage(), v1.GetMessage(), v1.GetLanguage())
+			}
+			// Both versions record in the one store
+			if g.GetCount() != v1.GetCount()+1 {
+				t.Errorf("v2 count = %d after v1's %d, want the next", g.GetCount(), v1.GetCount())
+			}
+			if v2.GetRecipient().GetName() != v1.GetSubject() || v2.GetRecipient().GetSource() != tt.source {
+				t.Errorf("v2 recipient = %v, want %s from %v", v2.GetRecipient(), v1.GetSubject(), tt.source)
+			}
+		})
+	}
+}
+
+func TestV1AndV2StreamsAgree(t *testing.T) {
+	ts := greetertest.StartTestServer(t)
+	ctx := context.Background()
+
+	v1, err := ts.Client.SayHelloMultiple(ctx, &pb.HelloRequest{Identity: &pb.HelloRequest_Name{Name: "Bob"}, Count: 3})
+	if err != nil {
+		t.Fatalf("v1 SayHelloMultiple: %v", err)
+	}
+	v2, err := ts.ClientV2.StreamGreetings(ctx, &pbv2.SayHelloRequest{Identity: &pbv2.SayHelloRequest_Name{Name: "Bob"}, Count: 3})
+	if err != nil {
+		t.Fatalf("v2 StreamGreetings: %v", err)
+	}
+	for i := 1; ; i++ {
+		r1, err1 := v1.Recv()
+		r2, err2 := v2.Recv()
+		if err1 == io.EOF && err2 == io.EOF {
+			if i != 4 {
+				t.Errorf("streams ended after %d greetings, want 3", i-1)
+			}
+			return
+		}
+		if err1 != nil || err2 != nil {
+			t.Fatalf("greeting %d: v1 %v, v2 %v", i, err1, err2)
+		}
+		if r1.GetMessage() != r2.GetGreeting().GetMessage() {
+			t.Errorf("greeting %d: v1 %q, v2 %q", i, r1.GetMessage(), r2.GetGreeting().GetMessage())
+		}
+	}
+}
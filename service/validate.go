@@ -0,0 +1,81 @@
+package service
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/greeting/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxNameLength is the longest name, in characters, the service will greet
+const MaxNameLength = 64
+
+// validate checks req's fields, returning InvalidArgument with an
+// errdetails.BadRequest listing every violation so clients can point at the
+// offending fields
+func validate(req *pb.HelloRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	switch id := req.GetIdentity().(type) {
+	case *pb.HelloRequest_UserId:
+		if id.UserId <= 0 {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "user_id",
+				Description: "must be a positive number",
+			})
+		}
+	default:
+		switch n := utf8.RuneCountInString(req.GetName()); {
+		case n == 0:
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "name",
+				Description: "must not be empty",
+			})
+		case n > MaxNameLength:
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "name",
+				Description: fmt.Sprintf("must be at most %d characters, got %d", MaxNameLength, n),
+			})
+		}
+	}
+	return badRequest(violations)
+}
+
+// validateStream checks the SayHelloMultiple pacing fields of req against
+// the server's limits
+func (s *Server) validateStream(req *pb.HelloRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	if n := req.GetCount(); n < 0 || int(n) > s.maxStreamCount {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "count",
+			Description: fmt.Sprintf("must be between 0 and %d, got %d", s.maxStreamCount, n),
+		})
+	}
+	if ms := req.GetIntervalMs(); ms < 0 || time.Duration(ms)*time.Millisecond > s.maxStreamInterval {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "interval_ms",
+			Description: fmt.Sprintf("must be between 0 and %d, got %d", s.maxStreamInterval.Milliseconds(), ms),
+		})
+	}
+	return badRequest(violations)
+}
+
+// badRequest returns nil without violations, otherwise InvalidArgument
+// carrying them as an errdetails.BadRequest
+func badRequest(violations []*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid greeting request")
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		// Only fails if the details can't be marshaled; the plain status
+		// still tells the client what went wrong
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,265 @@
+// Package sessions keeps server-side conversation state between calls. A
+// Manager hands out an unguessable token for each session it starts and
+// finds the session again by it, for the tenant that started it only;
+// sessions unused for the Manager's TTL expire. Many calls may use one Manager, and one session, at once: the
+// session map has its own lock, and each session another, held while a call
+// updates it, so concurrent calls of one conversation take turns without
+// holding up other conversations.
+package sessions
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultTTL is how long a session lives unused unless New is told
+	// otherwise
+	DefaultTTL = 10 * time.Minute
+	// DefaultMaxSessions is how many sessions may be live at once unless New
+	// is told otherwise
+	DefaultMaxSessions = 10000
+	// MaxHistory is how many of its latest greetings a session keeps
+	MaxHistory = 10
+)
+
+var (
+	// ErrNotFound is returned for tokens naming no live session: never
+	// issued, ended or expired
+	ErrNotFound = errors.New("sessions: no such session")
+	// ErrFull is returned by Start while the maximum number of sessions is
+	// live
+	ErrFull = errors.New("sessions: too many sessions")
+)
+
+// Session is one conversation's state
+type Session struct {
+	Token string
+	// Tenant is the tenant the session was started for, empty for none;
+	// other tenants can't find it by its token
+	Tenant string
+	Name   string
+	// Language is the conversation's preferred language, empty for the
+	// server's default
+	Language string
+	// Turns counts the conversation's greetings
+	Turns int
+	// History holds the latest MaxHistory greetings, oldest first
+	History   []string
+	StartedAt time.Time
+	// ExpiresAt is when the session expires unless used again
+	ExpiresAt time.Time
+}
+
+// Greeted records greeting as the session's next turn
+func (s *Session) Greeted(greeting string) {
+	s.Turns++
+	s.History = append(s.History, greeting)
+	if n := len(s.History); n > MaxHistory {
+		s.History = append(s.History[:0:0], s.History[n-MaxHistory:]...)
+	}
+}
+
+// entry is a live session and the lock its updates take
+type entry struct {
+	mu      sync.Mutex
+	session Session
+	// ended is set under mu once the session is removed, so a call that
+	// found it just before doesn't update it
+	ended bool
+}
+
+// key is what a live session is found by
+type key struct {
+	tenant, token string
+}
+
+// Manager keeps the live sessions
+type Manager struct {
+	ttl         time.Duration
+	maxSessions int
+
+	mu       sync.RWMutex
+	sessions map[key]*entry
+
+	evicted atomic.Int64
+}
+
+// New creates a Manager whose sessions expire after going unused for ttl,
+// with at most maxSessions live at once
+func New(ttl time.Duration, maxSessions int) *Manager {
+	return &Manager{
+		ttl:         ttl,
+		maxSessions: maxSessions,
+		sessions:    make(map[key]*entry),
+	}
+}
+
+// TTL returns how long sessions live unused
+func (m *Manager) TTL() time.Duration {
+	return m.ttl
+}
+
+// Start creates a session of tenant for name preferring language, then
+// runs fn on it, e.g. to greet for the first time. If fn fails the session
+// is dropped. Start returns the session as fn left it.
+func (m *Manager) Start(tenant, name, language string, fn func(*Session) error) (Session, error) {
+	now := time.Now()
+	e := &entry{session: Session{
+		Token:     rand.Text(),
+		Tenant:    tenant,
+		Name:      name,
+		Language:  language,
+		StartedAt: now,
+		ExpiresAt: now.Add(m.ttl),
+	}}
+	// Lock the session before it is reachable, so no other call can use
+	// it before fn is done
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	m.mu.Lock()
+	if len(m.sessions) >= m.maxSessions {
+		// Expired sessions the janitor hasn't swept yet don't count
+		m.sweepLocked(now)
+	}
+	if len(m.sessions) >= m.maxSessions {
+		m.mu.Unlock()
+		return Session{}, ErrFull
+	}
+	m.sessions[e.key()] = e
+	m.mu.Unlock()
+
+	if err := fn(&e.session); err != nil {
+		m.end(e)
+		return Session{}, err
+	}
+	return e.snapshot(), nil
+}
+
+// Update runs fn on the session of tenant token names, extending its life,
+// and returns the session as fn left it. Updates of one session take
+// turns; fn's changes are kept even if it fails.
+func (m *Manager) Update(tenant, token string, fn func(*Session) error) (Session, error) {
+	e, err := m.lock(key{tenant, token})
+	if err != nil {
+		return Session{}, err
+	}
+	defer e.mu.Unlock()
+	err = fn(&e.session)
+	e.session.ExpiresAt = time.Now().Add(m.ttl)
+	return e.snapshot(), err
+}
+
+// End removes the session of tenant token names and returns it as it
+// ended
+func (m *Manager) End(tenant, token string) (Session, error) {
+	e, err := m.lock(key{tenant, token})
+	if err != nil {
+		return Session{}, err
+	}
+	defer e.mu.Unlock()
+	m.end(e)
+	return e.snapshot(), nil
+}
+
+// lock returns the live session k names, locked
+func (m *Manager) lock(k key) (*entry, error) {
+	m.mu.RLock()
+	e, ok := m.sessions[k]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, ErrNotFound
+	}
+	e.mu.Lock()
+	if e.ended || !time.Now().Before(e.session.ExpiresAt) {
+		if !e.ended {
+			m.end(e)
+			m.evicted.Add(1)
+		}
+		e.mu.Unlock()
+		return nil, ErrNotFound
+	}
+	return e, nil
+}
+
+// end removes e, whose lock the caller holds
+func (m *Manager) end(e *entry) {
+	e.ended = true
+	m.mu.Lock()
+	delete(m.sessions, e.key())
+	m.mu.Unlock()
+}
+
+// key returns what e is found by
+func (e *entry) key() key {
+	return key{e.session.Tenant, e.session.Token}
+}
+
+// snapshot returns a copy of e's session that later updates leave alone;
+// e.mu must be held
+func (e *entry) snapshot() Session {
+	s := e.session
+	s.History = append([]string(nil), s.History...)
+	return s
+}
+
+// Len returns how many sessions are live, expired ones not yet evicted
+// included
+func (m *Manager) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.sessions)
+}
+
+// Evicted returns how many sessions expired
+func (m *Manager) Evicted() int64 {
+	return m.evicted.Load()
+}
+
+// Sweep evicts the expired sessions and returns how many there were
+func (m *Manager) Sweep() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sweepLocked(time.Now())
+}
+
+// sweepLocked evicts the sessions expired by now; m.mu must be held. A
+// session a call is using is left for the next sweep rather than waited
+// for, which would block every other call on m.mu.
+func (m *Manager) sweepLocked(now time.Time) int {
+	n := 0
+	for k, e := range m.sessions {
+		if !e.mu.TryLock() {
+			continue
+		}
+		if !now.Before(e.session.ExpiresAt) {
+			e.ended = true
+			delete(m.sessions, k)
+			n++
+		}
+		e.mu.Unlock()
+	}
+	m.evicted.Add(int64(n))
+	return n
+}
+
+// Run sweeps expired sessions every interval until ctx is done. Sessions
+// also expire without it, when next used, but only a sweep frees their
+// memory.
+func (m *Manager) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			m.Sweep()
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Package stats keeps in-process counters of the calls a server handles,
+// by method: how many completed, how many failed, their mean latency and
+// the streams open right now. They need no metrics stack: the AdminService
+// reports them and Publish exposes them as an expvar, served as JSON on
+// /debug/vars next to /metrics.
+package stats
+
+import (
+	"context"
+	"expvar"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Method is a snapshot of the calls made to one method
+type Method struct {
+	Method        string        `json:"method"`
+	Calls         int64         `json:"calls"`
+	Errors        int64         `json:"errors"`
+	AvgLatency    time.Duration `json:"avg_latency_ns"`
+	ActiveStreams int64         `json:"active_streams"`
+}
+
+// counters are one method's running totals
+type counters struct {
+	calls   atomic.Int64
+	errors  atomic.Int64
+	latency atomic.Int64 // nanoseconds, summed over completed calls
+	active  atomic.Int64
+}
+
+// Registry counts the calls its interceptors see
+type Registry struct {
+	started time.Time
+
+	mu      sync.RWMutex
+	methods map[string]*counters
+}
+
+// NewRegistry creates an empty Registry; uptime counts from now
+func NewRegistry() *Registry {
+	return &Registry{started: time.Now(), methods: make(map[string]*counters)}
+}
+
+// UnaryServerInterceptor counts unary calls
+func (r *Registry) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		r.method(info.FullMethod).done(start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor counts streams, both while they are open and
+// once they end
+func (r *Registry) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		c := r.method(info.FullMethod)
+		c.active.Add(1)
+		defer c.active.Add(-1)
+		start := time.Now()
+		err := handler(srv, ss)
+		c.done(start, err)
+		return err
+	}
+}
+
+func (r *Registry) method(name string) *counters {
+	r.mu.RLock()
+	c, ok := r.methods[name]
+	r.mu.RUnlock()
+	if ok {
+		return c
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c, ok = r.methods[name]; !ok {
+		c = &counters{}
+		r.methods[name] = c
+	}
+	return c
+}
+
+func (c *counters) done(start time.Time, err error) {
+	c.latency.Add(int64(time.Since(start)))
+	if status.Code(err) != codes.OK {
+		c.errors.Add(1)
+	}
+	c.calls.Add(1)
+}
+
+// Uptime returns how long ago the Registry was created
+func (r *Registry) Uptime() time.Duration {
+	return time.Since(r.started)
+}
+
+// Snapshot returns the counts of every method called so far, sorted by
+// method
+func (r *Registry) Snapshot() []Method {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	methods := make([]Method, 0, len(r.methods))
+	for name, c := range r.methods {
+		m := Method{
+			Method:        name,
+			Calls:         c.calls.Load(),
+			Errors:        c.errors.Load(),
+			ActiveStreams: c.active.Load(),
+		}
+		if m.Calls > 0 {
+			m.AvgLatency = time.Duration(c.latency.Load() / m.Calls)
+		}
+		methods = append(methods, m)
+	}
+	slices.SortFunc(methods, func(a, b Method) int { return strings.Compare(a.Method, b.Method) })
+	return methods
+}
+
+// Publish exposes the counts as the expvar called name, e.g. "grpc_server".
+// It panics if that name is published already, as expvar.Publish does.
+func (r *Registry) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		return map[string]any{
+			"uptime_seconds": r.Uptime().Seconds(),
+			"methods":        r.Snapshot(),
+		}
+	}))
+}
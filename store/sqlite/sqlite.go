@@ -0,0 +1,140 @@
+// Package sqlite is a store.Store persisting greetings in a SQLite
+// database file, so the history survives server restarts.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/store"
+	_ "modernc.org/sqlite" // Register the pure Go "sqlite" driver
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS greetings (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	tenant     TEXT    NOT NULL DEFAULT '',
+	name       TEXT    NOT NULL,
+	message    TEXT    NOT NULL,
+	greeted_at INTEGER NOT NULL
+);
+`
+
+// indexes are created once the tenant column exists, which databases
+// made before tenants were recorded lack until addTenant adds it
+const indexes = `
+DROP INDEX IF EXISTS greetings_name;
+CREATE INDEX IF NOT EXISTS greetings_tenant_name ON greetings (tenant, name, id);
+CREATE INDEX IF NOT EXISTS greetings_tenant ON greetings (tenant, id);
+`
+
+// Store records greetings in a SQLite database
+type Store struct {
+	db *sql.DB
+}
+
+var _ store.Store = (*Store)(nil)
+
+// Open opens (creating if needed) the database at path
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows one writer at a time; a single connection avoids
+	// "database is locked" errors under concurrent greetings
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("create schema: %w", err)
+	}
+	if err := addTenant(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("add tenant column: %w", err)
+	}
+	if _, err := db.Exec(indexes); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("create indexes: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// addTenant adds the tenant column to a greetings table made without it;
+// the greetings already there belong to no tenant
+func addTenant(db *sql.DB) error {
+	var n int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info('greetings') WHERE name = 'tenant'`).Scan(&n); err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	_, err := db.Exec(`ALTER TABLE greetings ADD COLUMN tenant TEXT NOT NULL DEFAULT ''`)
+	return err
+}
+
+// Add implements store.Store
+func (s *Store) Add(ctx context.Context, g store.Greeting) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO greetings (tenant, name, message, greeted_at) VALUES (?, ?, ?, ?)`,
+		g.Tenant, g.Name, g.Message, g.GreetedAt.UnixNano()); err != nil {
+		return 0, err
+	}
+	var count int64
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM greetings WHERE tenant = ? AND name = ?`, g.Tenant, g.Name).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, tx.Commit()
+}
+
+// List implements store.Store
+func (s *Store) List(ctx context.Context, q store.Query) ([]store.Greeting, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, tenant, name, message, greeted_at FROM greetings
+		 WHERE tenant = ? AND id > ? AND (? = '' OR name = ?)
+		 ORDER BY id LIMIT ?`,
+		q.Tenant, q.AfterID, q.Name, q.Name, q.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []store.Greeting
+	for rows.Next() {
+		var g store.Greeting
+		var at int64
+		if err := rows.Scan(&g.ID, &g.Tenant, &g.Name, &g.Message, &at); err != nil {
+			return nil, err
+		}
+		g.GreetedAt = time.Unix(0, at)
+		out = append(out, g)
+	}
+	return out, rows.Err()
+}
+
+// Stats implements store.Store
+func (s *Store) Stats(ctx context.Context, tenant, name string) (store.NameStats, error) {
+	var st store.NameStats
+	var first, last sql.NullInt64
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*), MIN(greeted_at), MAX(greeted_at) FROM greetings WHERE tenant = ? AND name = ?`, tenant, name).
+		Scan(&st.Count, &first, &last)
+	if err != nil {
+		return store.NameStats{}, err
+	}
+	if st.Count > 0 {
+		st.First, st.Last = time.Unix(0, first.Int64), time.Unix(0, last.Int64)
+	}
+	return st, nil
+}
+
+// Close implements store.Store
+func (s *Store) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/store"
+)
+
+func TestTenants(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "greetings.db"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer s.Close()
+	ctx := context.Background()
+
+	for _, g := range []store.Greeting{
+		{Tenant: "acme", Name: "Alice", Message: "Hello, Alice!"},
+		{Tenant: "acme", Name: "Alice", Message: "Hello, Alice!"},
+		{Tenant: "globex", Name: "Alice", Message: "Bonjour, Alice !"},
+	} {
+		g.GreetedAt = time.Now()
+		if _, err := s.Add(ctx, g); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+
+	for tenant, want := range map[string]int64{"acme": 2, "globex": 1, "": 0} {
+		st, err := s.Stats(ctx, tenant, "Alice")
+		if err != nil {
+			t.Fatalf("Stats: %v", err)
+		}
+		if st.Count != want {
+			t.Errorf("%q's count for Alice = %d, want %d", tenant, st.Count, want)
+		}
+		greetings, err := s.List(ctx, store.Query{Tenant: tenant, Limit: 10})
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if int64(len(greetings)) != want {
+			t.Errorf("%q's history has %d greetings, want %d", tenant, len(greetings), want)
+		}
+		for _, g := range greetings {
+			if g.Tenant != tenant {
+				t.Errorf("%q's history lists %v", tenant, g)
+			}
+		}
+	}
+}
+
+func TestOpenAddsTenantColumn(t *testing.T) {
+	// A database made before greetings were recorded per tenant
+	path := filepath.Join(t.TempDir(), "greetings.db")
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(`
+CREATE TABLE greetings (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	name       TEXT    NOT NULL,
+	message    TEXT    NOT NULL,
+	greeted_at INTEGER NOT NULL
+);
+CREATE INDEX greetings_name ON greetings (name, id);
+INSERT INTO greetings (name, message, greeted_at) VALUES ('Alice', 'Hello, Alice!', 1);
+`)
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer s.Close()
+	ctx := context.Background()
+	// The greeting already there belongs to no tenant
+	if count, err := s.Add(ctx, store.Greeting{Name: "Alice", Message: "Hello, Alice!", GreetedAt: time.Now()}); err != nil || count != 2 {
+		t.Errorf("Add without a tenant = %d, %v, want count 2", count, err)
+	}
+	if count, err := s.Add(ctx, store.Greeting{Tenant: "acme", Name: "Alice", Message: "Hello, Alice!", GreetedAt: time.Now()}); err != nil || count != 1 {
+		t.Errorf("Add for acme = %d, %v, want count 1", count, err)
+	}
+}
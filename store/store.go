@@ -0,0 +1,120 @@
+// Package store records every greeting the service sends, so clients can
+// list past greetings and each name gets a real greeting counter. Memory
+// keeps the history in process; the sqlite subpackage persists it.
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Greeting is one recorded greeting
+type Greeting struct {
+	// ID orders greetings and is assigned by the store
+	ID int64
+	// Tenant is the tenant the greeting was made for, empty for none.
+	// Tenants see only their own greetings and counts.
+	Tenant    string
+	Name      string
+	Message   string
+	GreetedAt time.Time
+}
+
+// NameStats summarizes the greetings sent to one name. Count is zero for
+// names never greeted.
+type NameStats struct {
+	Count int64
+	First time.Time
+	Last  time.Time
+}
+
+// Query selects greetings to list, oldest first
+type Query struct {
+	// Tenant is whose greetings are listed
+	Tenant string
+	// Name limits the results to one name; empty lists everyone
+	Name string
+	// AfterID skips greetings up to and including this ID, for paging
+	AfterID int64
+	// Limit caps the number of results
+	Limit int
+}
+
+// Store records greetings. Implementations must be safe for concurrent use.
+type Store interface {
+	// Add records g and returns how many times g.Name has now been greeted
+	// for g.Tenant
+	Add(ctx context.Context, g Greeting) (int64, error)
+	List(ctx context.Context, q Query) ([]Greeting, error)
+	// Stats summarizes the greetings to name made for tenant
+	Stats(ctx context.Context, tenant, name string) (NameStats, error)
+	Close() error
+}
+
+// Memory is a Store that keeps greetings in memory
+type Memory struct {
+	mu        sync.Mutex
+	greetings []Greeting
+	stats     map[statsKey]*NameStats
+}
+
+// statsKey is whose NameStats an entry of Memory.stats holds
+type statsKey struct {
+	tenant, name string
+}
+
+// NewMemory returns an empty in-memory store
+func NewMemory() *Memory {
+	return &Memory{stats: make(map[statsKey]*NameStats)}
+}
+
+// Add implements Store
+func (m *Memory) Add(_ context.Context, g Greeting) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g.ID = int64(len(m.greetings)) + 1
+	m.greetings = append(m.greetings, g)
+
+	key := statsKey{g.Tenant, g.Name}
+	st, ok := m.stats[key]
+	if !ok {
+		st = &NameStats{First: g.GreetedAt}
+		m.stats[key] = st
+	}
+	st.Count++
+	st.Last = g.GreetedAt
+	return st.Count, nil
+}
+
+// List implements Store
+func (m *Memory) List(_ context.Context, q Query) ([]Greeting, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var out []Greeting
+	// IDs are positions in the slice plus one, so paging can start there
+	for i := int(max(q.AfterID, 0)); i < len(m.greetings) && len(out) < q.Limit; i++ {
+		if g := m.greetings[i]; g.Tenant == q.Tenant && (q.Name == "" || g.Name == q.Name) {
+			out = append(out, g)
+		}
+	}
+	return out, nil
+}
+
+// Stats implements Store
+func (m *Memory) Stats(_ context.Context, tenant, name string) (NameStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if st, ok := m.stats[statsKey{tenant, name}]; ok {
+		return *st, nil
+	}
+	return NameStats{}, nil
+}
+
+// Close implements Store; there is nothing to release
+func (m *Memory) Close() error {
+	return nil
+}
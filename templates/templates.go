@@ -0,0 +1,244 @@
+// Package templates renders the messages the greeting service sends with
+// Go text/template, so they can be restyled without recompiling. An Engine
+// holds two templates: Greeting for SayHello and Stream for each streamed
+// greeting. Both start out as the built-in messages; Load replaces them
+// with the greeting.tmpl and stream.tmpl files of a directory, e.g.
+//
+//	{{emoji "wave"}} Good {{timeOfDay .Time}}, {{upper .Name}}{{.Punctuation}}
+//
+// Reload and Watch pick up edited files while the server runs. A file
+// that fails to parse leaves the templates in use alone.
+package templates
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/logging"
+	"github.com/fsnotify/fsnotify"
+)
+
+// The templates an Engine renders
+const (
+	Greeting = "greeting"
+	Stream   = "stream"
+)
+
+// fileExt is the extension of template files; greeting.tmpl holds the
+// Greeting template
+const fileExt = ".tmpl"
+
+// builtin are the templates used when a directory has no file for them
+var builtin = map[string]string{
+	Greeting: `{{.Message}}`,
+	Stream:   `Hello #{{.N}}, {{.Name}}! Streaming response {{.N}} of {{.Total}}`,
+}
+
+// reloadDebounce is how long Watch waits after a change for more before
+// reloading, so an editor's several writes make one reload
+const reloadDebounce = 100 * time.Millisecond
+
+// Data is what templates render: the greeting being sent and its context
+type Data struct {
+	// Name is who is greeted
+	Name string
+	// Message is the whole greeting as the greeting provider made it, and
+	// the rest its parts, when the provider reports them
+	Message     string
+	Salutation  string
+	Separator   string
+	Subject     string
+	Punctuation string
+	// Language is the BCP 47 tag of the greeting's language
+	Language string
+	// Tenant is the tenant the call is made for, if any
+	Tenant string
+	// N and Total number a streamed greeting: the N-th of Total
+	N     int
+	Total int
+	// Time is when the greeting is made, in the server's time zone
+	Time time.Time
+}
+
+// Funcs are the functions templates may call besides the built-in ones
+var Funcs = template.FuncMap{
+	"upper":     strings.ToUpper,
+	"lower":     strings.ToLower,
+	"timeOfDay": timeOfDay,
+	"emoji":     emoji,
+}
+
+// timeOfDay names the part of the day t falls in: morning, afternoon,
+// evening or night
+func timeOfDay(t time.Time) string {
+	switch h := t.Hour(); {
+	case h >= 5 && h < 12:
+		return "morning"
+	case h >= 12 && h < 17:
+		return "afternoon"
+	case h >= 17 && h < 22:
+		return "evening"
+	default:
+		return "night"
+	}
+}
+
+var emojis = map[string]string{
+	"wave":    "👋",
+	"smile":   "😊",
+	"party":   "🎉",
+	"sun":     "☀️",
+	"moon":    "🌙",
+	"star":    "⭐",
+	"heart":   "❤️",
+	"rocket":  "🚀",
+	"coffee":  "☕",
+	"sparkle": "✨",
+}
+
+// emoji returns the emoji called name, such as "wave" or "party", or ""
+// for names it doesn't know
+func emoji(name string) string {
+	return emojis[name]
+}
+
+// Engine renders the service's messages. It is safe for concurrent use,
+// reloads included.
+type Engine struct {
+	dir     string
+	current atomic.Pointer[template.Template]
+}
+
+// New returns an Engine rendering the built-in messages
+func New() *Engine {
+	e := &Engine{}
+	set, err := parse(nil)
+	if err != nil {
+		panic(err)
+	}
+	e.current.Store(set)
+	return e
+}
+
+// Load returns an Engine rendering the templates in dir, falling back to
+// the built-in ones for the files dir lacks
+func Load(dir string) (*Engine, error) {
+	e := &Engine{dir: dir}
+	if _, err := e.Reload(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Dir returns the directory templates are loaded from, "" for an Engine
+// rendering the built-in ones
+func (e *Engine) Dir() string {
+	return e.dir
+}
+
+// Reload reads the templates in the Engine's directory again and returns
+// the names of those read from files. On error the templates in use are
+// kept.
+func (e *Engine) Reload() ([]string, error) {
+	if e.dir == "" {
+		return nil, errors.New("templates: not loaded from a directory")
+	}
+	sources := make(map[string]string)
+	var loaded []string
+	for _, name := range []string{Greeting, Stream} {
+		b, err := os.ReadFile(filepath.Join(e.dir, name+fileExt))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sources[name] = string(b)
+		loaded = append(loaded, name)
+	}
+	set, err := parse(sources)
+	if err != nil {
+		return nil, err
+	}
+	e.current.Store(set)
+	return loaded, nil
+}
+
+// parse parses sources, keyed by template name, over the built-in
+// templates, checking each renders
+func parse(sources map[string]string) (*template.Template, error) {
+	set := template.New("").Funcs(Funcs)
+	for name, text := range builtin {
+		if src, ok := sources[name]; ok {
+			text = strings.TrimRight(src, "\n")
+		}
+		if _, err := set.New(name).Parse(text); err != nil {
+			return nil, fmt.Errorf("templates: %w", err)
+		}
+		if err := set.ExecuteTemplate(new(bytes.Buffer), name, Data{Time: time.Now()}); err != nil {
+			return nil, fmt.Errorf("templates: %w", err)
+		}
+	}
+	return set, nil
+}
+
+// Render renders the template called name with data
+func (e *Engine) Render(name string, data Data) (string, error) {
+	var out bytes.Buffer
+	if err := e.current.Load().ExecuteTemplate(&out, name, data); err != nil {
+		return "", fmt.Errorf("templates: %w", err)
+	}
+	return out.String(), nil
+}
+
+// Watch reloads the templates whenever a file in the Engine's directory
+// changes, until ctx is done. Failed reloads are logged and the templates
+// in use kept.
+func (e *Engine) Watch(ctx context.Context) error {
+	if e.dir == "" {
+		return errors.New("templates: not loaded from a directory")
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(e.dir); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	go func() {
+		defer watcher.Close()
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-watcher.Events:
+				if filepath.Ext(event.Name) == fileExt {
+					debounce = time.After(reloadDebounce)
+				}
+			case err := <-watcher.Errors:
+				slog.Warn("Watching the templates failed", "dir", e.dir, logging.Err(err))
+			case <-debounce:
+				debounce = nil
+				loaded, err := e.Reload()
+				if err != nil {
+					slog.Error("Failed to reload the templates; keeping the ones in use", "dir", e.dir, logging.Err(err))
+					continue
+				}
+				slog.Info("🎨 Templates reloaded", "dir", e.dir, "templates", loaded)
+			}
+		}
+	}()
+	return nil
+}
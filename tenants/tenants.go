@@ -0,0 +1,266 @@
+// Package tenants lets one server greet for several tenants, each with its
+// own configuration: the greeting, the default language and a rate limit.
+// Callers name their tenant in the x-tenant-id header; the interceptors
+// refuse calls for no tenant or an unknown one, and calls by token subjects
+// the tenant doesn't list, so one tenant's callers can't act for another.
+// Tenants are read from a JSON file keyed by tenant id:
+//
+//	{
+//	  "acme":   {"greeting": "Welcome to Acme, %s!", "language": "de", "rate_limit": 5},
+//	  "globex": {"language": "fr", "subjects": ["alice", "bob"]}
+//	}
+package tenants
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/auth"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/greeter"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/metadata"
+	"github.com/KulbhushanBhalerao/grpc-proto-demo-golang/requestcontext"
+	"golang.org/x/text/language"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Unknown is the tenant calls refused for naming no known tenant are
+// recorded under
+const Unknown = "unknown"
+
+// Tenant is one tenant's greeter configuration
+type Tenant struct {
+	// ID is the tenant's key in the file
+	ID string `json:"-"`
+	// Greeting is a format for the whole greeting with one %s verb for the
+	// name, e.g. "Welcome to Acme, %s!"; the language's own greeting when
+	// empty
+	Greeting string `json:"greeting,omitempty"`
+	// Language is the BCP 47 tag greeted in when the caller asks for no
+	// language of its own
+	Language string `json:"language,omitempty"`
+	// RateLimit is the calls per second the tenant's callers may make
+	// together, in bursts of up to RateBurst; zero is unlimited
+	RateLimit float64 `json:"rate_limit,omitempty"`
+	RateBurst int     `json:"rate_burst,omitempty"`
+	// Subjects are the token subjects allowed to call for the tenant; any
+	// caller may when empty
+	Subjects []string `json:"subjects,omitempty"`
+
+	limiter *rate.Limiter
+}
+
+// Greet returns the tenant's greeting for name, split at the name
+func (t *Tenant) Greet(name string) greeter.Parts {
+	prefix, suffix, _ := strings.Cut(t.Greeting, "%s")
+	salutation := strings.TrimRightFunc(prefix, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	})
+	separator := prefix[len(salutation):]
+	if separator == "" && salutation != "" {
+		separator = " "
+	}
+	return greeter.Parts{Salutation: salutation, Separator: separator, Subject: name, Punctuation: suffix}
+}
+
+type tenantKey struct{}
+
+// FromContext returns the tenant a call is made for, once the interceptors
+// admitted it
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	t, ok := ctx.Value(tenantKey{}).(*Tenant)
+	return t, ok
+}
+
+// Recorder is told how every call ended for its tenant, e.g. to export
+// per-tenant metrics
+type Recorder interface {
+	TenantCall(tenant, method, code string, elapsed time.Duration)
+}
+
+// Registry holds the tenants a server greets for
+type Registry struct {
+	tenants map[string]*Tenant
+	exempt  []string
+	rec     Recorder
+}
+
+// Option configures a Registry
+type Option func(*Registry)
+
+// WithExemptions lets calls to methods through without a tenant: full
+// method names, or service names ending in "/" for all their methods
+func WithExemptions(methods ...string) Option {
+	return func(r *Registry) {
+		r.exempt = append(r.exempt, methods...)
+	}
+}
+
+// WithRecorder reports every call made for a tenant to rec
+func WithRecorder(rec Recorder) Option {
+	return func(r *Registry) {
+		r.rec = rec
+	}
+}
+
+// Load reads the tenants file at path
+func Load(path string) (map[string]Tenant, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tenants map[string]Tenant
+	if err := json.Unmarshal(b, &tenants); err != nil {
+		return nil, fmt.Errorf("tenants: parsing %s: %w", path, err)
+	}
+	return tenants, nil
+}
+
+// New returns a Registry of tenants, or an error if one is misconfigured
+func New(tenants map[string]Tenant, opts ...Option) (*Registry, error) {
+	if len(tenants) == 0 {
+		return nil, fmt.Errorf("tenants: no tenants configured")
+	}
+	r := &Registry{tenants: make(map[string]*Tenant, len(tenants))}
+	for id, t := range tenants {
+		if t.Greeting != "" && strings.Count(t.Greeting, "%s") != 1 {
+			return nil, fmt.Errorf("tenants: %s: greeting %q needs exactly one %%s for the name", id, t.Greeting)
+		}
+		if t.Language != "" {
+			if _, err := language.Parse(t.Language); err != nil {
+				return nil, fmt.Errorf("tenants: %s: language %q: %w", id, t.Language, err)
+			}
+		}
+		if t.RateLimit < 0 || t.RateBurst < 0 {
+			return nil, fmt.Errorf("tenants: %s: negative rate limit", id)
+		}
+		if t.RateLimit > 0 {
+			if t.RateBurst == 0 {
+				t.RateBurst = int(math.Ceil(t.RateLimit))
+			}
+			t.limiter = rate.NewLimiter(rate.Limit(t.RateLimit), t.RateBurst)
+		}
+		t.ID = id
+		r.tenants[id] = &t
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r, nil
+}
+
+// IDs returns the ids of the registry's tenants, sorted
+func (r *Registry) IDs() []string {
+	ids := make([]string, 0, len(r.tenants))
+	for id := range r.tenants {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// UnaryServerInterceptor admits unary calls for their tenant, handing
+// handlers a context FromContext finds it in. Install it after
+// requestcontext's interceptors, which read the header, and after
+// authentication, so callers' subjects can be checked.
+func (r *Registry) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if r.isExempt(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		t, err := r.admit(ctx)
+		if err != nil {
+			r.record(t, info.FullMethod, start, err)
+			return nil, err
+		}
+		resp, err := handler(context.WithValue(ctx, tenantKey{}, t), req)
+		r.record(t, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor admits streams for their tenant; rate limits
+// count the opening of streams, not their messages
+func (r *Registry) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if r.isExempt(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		start := time.Now()
+		t, err := r.admit(ss.Context())
+		if err != nil {
+			r.record(t, info.FullMethod, start, err)
+			return err
+		}
+		err = handler(srv, &tenantStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), tenantKey{}, t)})
+		r.record(t, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// admit returns the tenant ctx's call is for, and why it may not be made
+// if it may not; the tenant is nil when the call names no known one
+func (r *Registry) admit(ctx context.Context) (*Tenant, error) {
+	id := requestcontext.TenantID(ctx)
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing %s header naming the tenant", requestcontext.TenantHeader)
+	}
+	t, ok := r.tenants[id]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "unknown tenant %q", id)
+	}
+	if claims, ok := auth.ClaimsFromContext(ctx); ok && len(t.Subjects) > 0 && !slices.Contains(t.Subjects, claims.Subject) {
+		return t, status.Errorf(codes.PermissionDenied, "%s may not call for tenant %q", claims.Subject, id)
+	}
+	if t.limiter != nil {
+		res := t.limiter.Reserve()
+		if delay := res.Delay(); delay > 0 {
+			res.Cancel()
+			retryAfter := max(delay, time.Millisecond).Round(time.Millisecond)
+			grpc.SetTrailer(ctx, grpcmd.Pairs(metadata.RetryAfterTrailer, strconv.FormatInt(retryAfter.Milliseconds(), 10)))
+			return t, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for tenant %q; retry in %s", id, retryAfter)
+		}
+	}
+	return t, nil
+}
+
+func (r *Registry) isExempt(method string) bool {
+	for _, e := range r.exempt {
+		if method == e || (strings.HasSuffix(e, "/") && strings.HasPrefix(method, e)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Registry) record(t *Tenant, method string, start time.Time, err error) {
+	if r.rec == nil {
+		return
+	}
+	id := Unknown
+	if t != nil {
+		id = t.ID
+	}
+	r.rec.TenantCall(id, method, status.Code(err).String(), time.Since(start))
+}
+
+type tenantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantStream) Context() context.Context {
+	return s.ctx
+}
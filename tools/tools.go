@@ -0,0 +1,14 @@
+//go:build tools
+
+// Package tools pins the versions of the code generators behind
+// `go generate ./gen`. It is a module of its own so buf's dependencies stay
+// out of the main go.mod; the generators run with `go run -C tools`.
+package tools
+
+import (
+	_ "github.com/bufbuild/buf/cmd/buf"
+	_ "github.com/envoyproxy/protoc-gen-validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway"
+	_ "google.golang.org/grpc/cmd/protoc-gen-go-grpc"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go"
+)
@@ -0,0 +1,104 @@
+package transport
+
+import (
+	"log/slog"
+	"net"
+	"sync"
+)
+
+// ConnRecorder is told about the connections a PeerLimiter accepts and
+// rejects, e.g. to export them as metrics
+type ConnRecorder interface {
+	ConnOpened()
+	ConnClosed()
+	ConnRejected()
+}
+
+// PeerLimiter is a listener accepting at most a number of connections from
+// each peer IP at once. Further connections are closed as soon as they are
+// accepted, so one client opening connection after connection, or many
+// clients behind one address, can't hold all the server's file
+// descriptors. Connections over Unix sockets and in-memory pipes have no
+// peer IP and are never limited.
+type PeerLimiter struct {
+	net.Listener
+	max      int
+	recorder ConnRecorder
+
+	mu    sync.Mutex
+	peers map[string]int
+}
+
+// LimitPerPeer wraps lis to accept at most max connections from each peer
+// IP at once, telling rec, if not nil, about every connection
+func LimitPerPeer(lis net.Listener, max int, rec ConnRecorder) *PeerLimiter {
+	return &PeerLimiter{Listener: lis, max: max, recorder: rec, peers: make(map[string]int)}
+}
+
+// Accept returns the next connection within the limit
+func (l *PeerLimiter) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		ip := peerIP(conn.RemoteAddr())
+		if ip == "" {
+			return conn, nil
+		}
+		if !l.acquire(ip) {
+			slog.Warn("Rejected a connection over the per-peer limit", "peer", conn.RemoteAddr().String(), "limit", l.max)
+			conn.Close()
+			if l.recorder != nil {
+				l.recorder.ConnRejected()
+			}
+			continue
+		}
+		if l.recorder != nil {
+			l.recorder.ConnOpened()
+		}
+		return &limitedConn{Conn: conn, release: func() { l.release(ip) }}, nil
+	}
+}
+
+func (l *PeerLimiter) acquire(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.peers[ip] >= l.max {
+		return false
+	}
+	l.peers[ip]++
+	return true
+}
+
+func (l *PeerLimiter) release(ip string) {
+	l.mu.Lock()
+	if l.peers[ip]--; l.peers[ip] <= 0 {
+		delete(l.peers, ip)
+	}
+	l.mu.Unlock()
+	if l.recorder != nil {
+		l.recorder.ConnClosed()
+	}
+}
+
+func peerIP(addr net.Addr) string {
+	if tcp, ok := addr.(*net.TCPAddr); ok {
+		return tcp.IP.String()
+	}
+	return ""
+}
+
+// limitedConn gives its slot back once closed, however many times Close is
+// called
+type limitedConn struct {
+	net.Conn
+	once    sync.Once
+	release func()
+}
+
+func (c *limitedConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.release)
+	return err
+}
@@ -0,0 +1,182 @@
+// Package transport lets the server listen, and clients dial, over TCP, a
+// Unix domain socket or an in-memory pipe, chosen by the address:
+//
+//	:50051, localhost:50051     TCP
+//	unix:///tmp/greeter.sock    Unix domain socket, for sidecar-style local
+//	                            IPC without opening a network port
+//	memory://greeter            in-memory listener, dialable only from the
+//	                            same process, e.g. by the REST gateway or tests
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Networks an address may select
+const (
+	TCP    = "tcp"
+	Unix   = "unix"
+	Memory = "memory"
+)
+
+// memoryBufSize is the buffer size of each in-memory connection
+const memoryBufSize = 1 << 20
+
+// Parse splits addr into its network and the address within it, such as
+// "unix" and "/tmp/greeter.sock"; addresses without a scheme are TCP
+func Parse(addr string) (network, address string) {
+	if path, ok := strings.CutPrefix(addr, "unix://"); ok {
+		return Unix, path
+	}
+	if path, ok := strings.CutPrefix(addr, "unix:"); ok {
+		return Unix, path
+	}
+	if name, ok := strings.CutPrefix(addr, "memory://"); ok {
+		return Memory, name
+	}
+	return TCP, addr
+}
+
+// Listen listens on addr. lc configures TCP and Unix sockets. A Unix socket
+// file left behind by a server that crashed is removed first, and Close
+// removes the file.
+func Listen(ctx context.Context, lc net.ListenConfig, addr string) (net.Listener, error) {
+	network, address := Parse(addr)
+	switch network {
+	case Unix:
+		if address == "" {
+			return nil, errors.New("transport: unix address needs a socket path")
+		}
+		if err := removeStaleSocket(ctx, address); err != nil {
+			return nil, err
+		}
+		return lc.Listen(ctx, Unix, address)
+	case Memory:
+		return listenMemory(address)
+	default:
+		return lc.Listen(ctx, TCP, address)
+	}
+}
+
+// removeStaleSocket removes the socket file at path unless a server still
+// accepts connections on it
+func removeStaleSocket(ctx context.Context, path string) error {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("transport: %s exists and is not a socket", path)
+	}
+	var d net.Dialer
+	if conn, err := d.DialContext(ctx, Unix, path); err == nil {
+		conn.Close()
+		return fmt.Errorf("transport: %s is already being served", path)
+	}
+	return os.Remove(path)
+}
+
+// Target returns the gRPC target a client in this process dials to reach
+// lis, a listener from Listen
+func Target(lis net.Listener) string {
+	switch addr := lis.Addr(); addr.Network() {
+	case Unix:
+		return "unix://" + addr.String()
+	case Memory:
+		return "memory://" + addr.String()
+	default:
+		return loopback(addr)
+	}
+}
+
+// loopback turns a TCP listener address such as [::]:50051 into one a
+// client can dial
+func loopback(addr net.Addr) string {
+	host, port, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	if ip := net.ParseIP(host); ip == nil || ip.IsUnspecified() {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// DialTarget returns the target and dial options grpc.NewClient needs to
+// dial target. gRPC dials TCP and unix: targets itself; memory:// targets
+// are rewritten to reach the in-memory listener of that name.
+func DialTarget(target string) (string, []grpc.DialOption) {
+	network, name := Parse(target)
+	if network != Memory {
+		return target, nil
+	}
+	dialer := grpc.WithContextDialer(func(ctx context.Context, name string) (net.Conn, error) {
+		return dialMemory(ctx, name)
+	})
+	return "passthrough:///" + name, []grpc.DialOption{dialer}
+}
+
+// memoryListeners holds the open in-memory listeners by name
+var memoryListeners = struct {
+	sync.Mutex
+	byName map[string]*bufconn.Listener
+}{byName: make(map[string]*bufconn.Listener)}
+
+// memoryListener is an in-memory listener registered under name until it
+// is closed
+type memoryListener struct {
+	*bufconn.Listener
+	name string
+}
+
+func listenMemory(name string) (net.Listener, error) {
+	memoryListeners.Lock()
+	defer memoryListeners.Unlock()
+	if _, ok := memoryListeners.byName[name]; ok {
+		return nil, fmt.Errorf("transport: memory://%s is already listening", name)
+	}
+	lis := bufconn.Listen(memoryBufSize)
+	memoryListeners.byName[name] = lis
+	return &memoryListener{Listener: lis, name: name}, nil
+}
+
+func dialMemory(ctx context.Context, name string) (net.Conn, error) {
+	memoryListeners.Lock()
+	lis, ok := memoryListeners.byName[name]
+	memoryListeners.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("transport: nothing listens on memory://%s", name)
+	}
+	return lis.DialContext(ctx)
+}
+
+func (l *memoryListener) Close() error {
+	memoryListeners.Lock()
+	if memoryListeners.byName[l.name] == l.Listener {
+		delete(memoryListeners.byName, l.name)
+	}
+	memoryListeners.Unlock()
+	return l.Listener.Close()
+}
+
+func (l *memoryListener) Addr() net.Addr {
+	return memoryAddr(l.name)
+}
+
+// memoryAddr is the address of an in-memory listener: its name
+type memoryAddr string
+
+func (memoryAddr) Network() string  { return Memory }
+func (a memoryAddr) String() string { return string(a) }
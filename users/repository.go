@@ -0,0 +1,116 @@
+// Package users implements the UserService, a profile CRUD service served
+// next to the GreetingService, backed by a pluggable Repository.
+package users
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	userpb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/user"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrNotFound is returned by a Repository for an unknown user id
+var ErrNotFound = errors.New("user not found")
+
+// Repository stores users. Implementations must be safe for concurrent use
+// and must not keep or hand out references to callers' messages.
+type Repository interface {
+	// Create stores u under a new id and returns the stored user
+	Create(ctx context.Context, u *userpb.User) (*userpb.User, error)
+	Get(ctx context.Context, id int64) (*userpb.User, error)
+	// Update applies apply to the stored user atomically; an error from
+	// apply leaves the user unchanged
+	Update(ctx context.Context, id int64, apply func(u *userpb.User) error) (*userpb.User, error)
+	Delete(ctx context.Context, id int64) error
+	// List returns up to limit users with ids above afterID, by id
+	List(ctx context.Context, afterID int64, limit int) ([]*userpb.User, error)
+}
+
+// Memory is a Repository keeping users in memory
+type Memory struct {
+	mu     sync.Mutex
+	nextID int64
+	users  map[int64]*userpb.User
+}
+
+// NewMemory returns an empty in-memory repository
+func NewMemory() *Memory {
+	return &Memory{nextID: 1, users: make(map[int64]*userpb.User)}
+}
+
+// Create implements Repository
+func (m *Memory) Create(_ context.Context, u *userpb.User) (*userpb.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := proto.Clone(u).(*userpb.User)
+	stored.Id = m.nextID
+	m.nextID++
+	now := timestamppb.New(time.Now())
+	stored.CreateTime, stored.UpdateTime = now, now
+	m.users[stored.Id] = stored
+	return proto.Clone(stored).(*userpb.User), nil
+}
+
+// Get implements Repository
+func (m *Memory) Get(_ context.Context, id int64) (*userpb.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(u).(*userpb.User), nil
+}
+
+// Update implements Repository
+func (m *Memory) Update(_ context.Context, id int64, apply func(u *userpb.User) error) (*userpb.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	updated := proto.Clone(u).(*userpb.User)
+	if err := apply(updated); err != nil {
+		return nil, err
+	}
+	// The id and creation time are the repository's to manage
+	updated.Id, updated.CreateTime = u.Id, u.CreateTime
+	updated.UpdateTime = timestamppb.New(time.Now())
+	m.users[id] = updated
+	return proto.Clone(updated).(*userpb.User), nil
+}
+
+// Delete implements Repository
+func (m *Memory) Delete(_ context.Context, id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[id]; !ok {
+		return ErrNotFound
+	}
+	delete(m.users, id)
+	return nil
+}
+
+// List implements Repository
+func (m *Memory) List(_ context.Context, afterID int64, limit int) ([]*userpb.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var out []*userpb.User
+	// Ids are handed out in order, so walking them finds users by id
+	for id := afterID + 1; id < m.nextID && len(out) < limit; id++ {
+		if u, ok := m.users[id]; ok {
+			out = append(out, proto.Clone(u).(*userpb.User))
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,204 @@
+package users
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strconv"
+
+	userpb "github.com/KulbhushanBhalerao/grpc-proto-demo-golang/proto/user"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// mutableFields are the update_mask paths UpdateUser accepts
+var mutableFields = []string{"name", "email", "display_name"}
+
+// Server implements the UserService
+type Server struct {
+	userpb.UnimplementedUserServiceServer
+
+	repo Repository
+}
+
+// NewServer returns a UserService storing users in repo
+func NewServer(repo Repository) *Server {
+	return &Server{repo: repo}
+}
+
+// CreateUser implements the UserService create RPC
+func (s *Server) CreateUser(ctx context.Context, req *userpb.CreateUserRequest) (*userpb.User, error) {
+	if err := validate(req.GetUser(), mutableFields); err != nil {
+		return nil, err
+	}
+	u, err := s.repo.Create(ctx, req.GetUser())
+	if err != nil {
+		return nil, repoError(err, 0)
+	}
+	slog.InfoContext(ctx, "Created user", "id", u.GetId(), "name", u.GetName())
+	return u, nil
+}
+
+// GetUser implements the UserService read RPC
+func (s *Server) GetUser(ctx context.Context, req *userpb.GetUserRequest) (*userpb.User, error) {
+	u, err := s.repo.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, repoError(err, req.GetId())
+	}
+	return u, nil
+}
+
+// UpdateUser implements the UserService partial update RPC. Only the fields
+// named by update_mask are copied from the request; an empty mask copies
+// every mutable field.
+func (s *Server) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequest) (*userpb.User, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = mutableFields
+	}
+	if err := validate(req.GetUser(), paths); err != nil {
+		return nil, err
+	}
+
+	in := req.GetUser()
+	u, err := s.repo.Update(ctx, in.GetId(), func(u *userpb.User) error {
+		for _, path := range paths {
+			switch path {
+			case "name":
+				u.Name = in.GetName()
+			case "email":
+				u.Email = in.GetEmail()
+			case "display_name":
+				u.DisplayName = in.GetDisplayName()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, repoError(err, in.GetId())
+	}
+	slog.InfoContext(ctx, "Updated user", "id", u.GetId(), "paths", paths)
+	return u, nil
+}
+
+// DeleteUser implements the UserService delete RPC
+func (s *Server) DeleteUser(ctx context.Context, req *userpb.DeleteUserRequest) (*emptypb.Empty, error) {
+	if err := s.repo.Delete(ctx, req.GetId()); err != nil {
+		return nil, repoError(err, req.GetId())
+	}
+	slog.InfoContext(ctx, "Deleted user", "id", req.GetId())
+	return &emptypb.Empty{}, nil
+}
+
+// ListUsers implements the UserService list RPC
+func (s *Server) ListUsers(ctx context.Context, req *userpb.ListUsersRequest) (*userpb.ListUsersResponse, error) {
+	size := int(req.GetPageSize())
+	switch {
+	case size < 0:
+		return nil, badRequest([]*errdetails.BadRequest_FieldViolation{{Field: "page_size", Description: "must not be negative"}})
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+	after, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, badRequest([]*errdetails.BadRequest_FieldViolation{{Field: "page_token", Description: "must be a token returned by ListUsers"}})
+	}
+
+	// Fetch one extra user to learn whether another page follows
+	list, err := s.repo.List(ctx, after, size+1)
+	if err != nil {
+		return nil, repoError(err, 0)
+	}
+	resp := &userpb.ListUsersResponse{Users: list}
+	if len(list) > size {
+		resp.Users = list[:size]
+		resp.NextPageToken = encodePageToken(list[size-1].GetId())
+	}
+	return resp, nil
+}
+
+// validate checks the fields of u named by paths, returning InvalidArgument
+// listing every violation. Unknown paths are violations too.
+func validate(u *userpb.User, paths []string) error {
+	if u == nil {
+		return badRequest([]*errdetails.BadRequest_FieldViolation{{Field: "user", Description: "is required"}})
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, path := range paths {
+		switch path {
+		case "name":
+			if u.GetName() == "" {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: "user.name", Description: "must not be empty"})
+			}
+		case "email":
+			if u.GetEmail() != "" {
+				if _, err := mail.ParseAddress(u.GetEmail()); err != nil {
+					violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: "user.email", Description: "must be an email address"})
+				}
+			}
+		case "display_name":
+		default:
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "update_mask",
+				Description: fmt.Sprintf("unknown or read-only field %q; use name, email or display_name", path),
+			})
+		}
+	}
+	return badRequest(violations)
+}
+
+// badRequest returns nil without violations, otherwise InvalidArgument
+// carrying them as an errdetails.BadRequest
+func badRequest(violations []*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid user request")
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// repoError turns a Repository error into a gRPC status
+func repoError(err error, id int64) error {
+	if errors.Is(err, ErrNotFound) {
+		return status.Errorf(codes.NotFound, "no user with id %d", id)
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.Internal, "user repository: %v", err)
+}
+
+// Page tokens are opaque to clients; they wrap the id of the last user on
+// the page so the next page starts after it
+func encodePageToken(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(b), 10, 64)
+}
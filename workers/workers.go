@@ -0,0 +1,160 @@
+// Package workers runs expensive greeting work on a fixed set of
+// goroutines fed by a bounded queue, so however many calls arrive only so
+// much of it runs at once. Work that finds the queue full is refused with
+// Unavailable at once rather than piling up behind it.
+package workers
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SaturatedReason is the errdetails.ErrorInfo reason of the error a full
+// pool refuses work with
+const SaturatedReason = "WORKER_POOL_SATURATED"
+
+// Pool runs jobs on a fixed number of workers, queueing up to a limit
+type Pool struct {
+	size int
+	jobs chan job
+	wg   sync.WaitGroup
+
+	// mu guards sending on jobs against Close closing it
+	mu     sync.RWMutex
+	closed bool
+
+	busy      atomic.Int64
+	completed atomic.Int64
+	rejected  atomic.Int64
+}
+
+type job struct {
+	ctx  context.Context
+	fn   func(context.Context) error
+	done chan error
+}
+
+// Stats is a snapshot of a pool's load
+type Stats struct {
+	// Workers is the number of jobs that can run at once
+	Workers int
+	// Busy is the number of workers running a job
+	Busy int
+	// Queued is the number of jobs waiting for a worker
+	Queued int
+	// QueueCapacity is how many jobs may wait before new ones are refused
+	QueueCapacity int
+	// Completed counts the jobs run to the end, failed ones included
+	Completed int64
+	// Rejected counts the jobs refused because the queue was full
+	Rejected int64
+}
+
+// New starts a pool of size workers with room for queueSize waiting jobs
+func New(size, queueSize int) *Pool {
+	p := &Pool{size: size, jobs: make(chan job, queueSize)}
+	p.wg.Add(size)
+	for range size {
+		go p.work()
+	}
+	return p
+}
+
+// Do queues fn and waits until a worker has run it, returning its error.
+// fn gets ctx, so it should return early once ctx is done. If the queue is
+// full Do fails at once with Unavailable, carrying an errdetails.ErrorInfo
+// with the queue depth; if ctx is done first Do returns its status error,
+// and fn is skipped if it has not started yet. Once the pool is closed Do
+// fails with Unavailable.
+func (p *Pool) Do(ctx context.Context, fn func(context.Context) error) error {
+	j := job{ctx: ctx, fn: fn, done: make(chan error, 1)}
+	if err := p.enqueue(j); err != nil {
+		return err
+	}
+	select {
+	case err := <-j.done:
+		return err
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// Stats returns the pool's current load
+func (p *Pool) Stats() Stats {
+	return Stats{
+		Workers:       p.size,
+		Busy:          int(p.busy.Load()),
+		Queued:        len(p.jobs),
+		QueueCapacity: cap(p.jobs),
+		Completed:     p.completed.Load(),
+		Rejected:      p.rejected.Load(),
+	}
+}
+
+// enqueue queues j unless the pool is closed or its queue is full
+func (p *Pool) enqueue(j job) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return status.Error(codes.Unavailable, "server shutting down: worker pool closed")
+	}
+	select {
+	case p.jobs <- j:
+		return nil
+	default:
+		p.rejected.Add(1)
+		return p.saturated()
+	}
+}
+
+// Close stops the workers once the jobs already queued have run and waits
+// for them. Work handed to Do afterwards is refused.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.jobs)
+	}
+	p.mu.Unlock()
+	p.wg.Wait()
+}
+
+func (p *Pool) work() {
+	defer p.wg.Done()
+	for j := range p.jobs {
+		// The caller has given up on jobs whose context ended while queued
+		if err := j.ctx.Err(); err != nil {
+			j.done <- status.FromContextError(err).Err()
+			continue
+		}
+		p.busy.Add(1)
+		j.done <- j.fn(j.ctx)
+		p.busy.Add(-1)
+		p.completed.Add(1)
+	}
+}
+
+// saturated returns the Unavailable error a full pool refuses work with
+func (p *Pool) saturated() error {
+	queued, capacity := len(p.jobs), cap(p.jobs)
+	st := status.Newf(codes.Unavailable, "server busy: worker pool saturated (%d of %d queued, %d workers busy)", queued, capacity, p.busy.Load())
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: SaturatedReason,
+		Domain: "greeter",
+		Metadata: map[string]string{
+			"queue_depth":    strconv.Itoa(queued),
+			"queue_capacity": strconv.Itoa(capacity),
+			"workers":        strconv.Itoa(p.size),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,31 @@
+package workers
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDoAfterClose(t *testing.T) {
+	p := New(1, 1)
+	ran := false
+	if err := p.Do(context.Background(), func(context.Context) error { ran = true; return nil }); err != nil || !ran {
+		t.Fatalf("Do before Close = %v, ran: %t", err, ran)
+	}
+	p.Close()
+	// Closing twice is harmless
+	p.Close()
+
+	err := p.Do(context.Background(), func(context.Context) error {
+		t.Error("a closed pool ran a job")
+		return nil
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Do after Close = %v, want Unavailable", err)
+	}
+	if got := p.Stats().Rejected; got != 0 {
+		t.Errorf("rejected = %d after Close, want 0: a closed pool isn't saturated", got)
+	}
+}